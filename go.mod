@@ -11,6 +11,7 @@ require (
 	github.com/jeremmfr/go-netconf v0.6.0
 	github.com/jeremmfr/go-utils v0.13.0
 	github.com/jeremmfr/junosdecode v1.1.1
+	github.com/pkg/sftp v1.13.10
 	github.com/quasilyte/go-ruleguard/dsl v0.3.23
 	golang.org/x/crypto v0.49.0
 )
@@ -42,6 +43,7 @@ require (
 	github.com/hashicorp/terraform-registry-address v0.4.0 // indirect
 	github.com/hashicorp/terraform-svchost v0.1.1 // indirect
 	github.com/hashicorp/yamux v0.1.2 // indirect
+	github.com/kr/fs v0.1.0 // indirect
 	github.com/mattn/go-colorable v0.1.14 // indirect
 	github.com/mattn/go-isatty v0.0.20 // indirect
 	github.com/mitchellh/copystructure v1.2.0 // indirect
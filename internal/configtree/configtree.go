@@ -0,0 +1,403 @@
+// Package configtree provides a tree of Junos configuration built from set lines
+// (output of `show configuration | display set`).
+//
+// The tree applies set and delete lines like a device, renders a subtree back to set lines
+// (or to curly-brace text) and handles the quotes around words and the <configuration-output> framing of command output.
+package configtree
+
+import (
+	"fmt"
+	"html"
+	"slices"
+	"strings"
+)
+
+const (
+	setLS    = "set "    // set line start
+	deleteLS = "delete " // delete line start
+
+	xmlStartTagConfigOut = "<configuration-output>"
+	xmlEndTagConfigOut   = "</configuration-output>"
+)
+
+// Tree is a configuration tree.
+//
+// Children of each node keep the order in which they were added.
+type Tree struct {
+	root node
+}
+
+type node struct {
+	word string
+	// set: the words up to this node are a set line
+	set      bool
+	children []*node
+	index    map[string]*node
+}
+
+// New returns an empty configuration tree.
+func New() *Tree {
+	return &Tree{}
+}
+
+// Parse returns a new configuration tree with set/delete lines of text applied.
+func Parse(text string) (*Tree, error) {
+	tree := New()
+	if err := tree.Apply(text); err != nil {
+		return nil, err
+	}
+
+	return tree, nil
+}
+
+// ParseOutput returns a new configuration tree from the output of a
+// `show configuration ... | display set` command.
+//
+// The lines are read inside the <configuration-output> framing (if present)
+// and XML entities are unescaped.
+// The `empty` output of a command without result is an empty tree.
+func ParseOutput(output string) (*Tree, error) {
+	if strings.TrimSpace(output) == "empty" {
+		return New(), nil
+	}
+
+	return Parse(html.UnescapeString(Unframe(output)))
+}
+
+// Unframe returns the lines inside the <configuration-output> framing of command output.
+//
+// Output without framing is returned unchanged.
+func Unframe(output string) string {
+	_, inside, ok := strings.Cut(output, xmlStartTagConfigOut)
+	if !ok {
+		return output
+	}
+	inside, _, _ = strings.Cut(inside, xmlEndTagConfigOut)
+
+	return strings.TrimPrefix(inside, "\n")
+}
+
+// Frame returns lines with XML special characters escaped
+// inside the <configuration-output> framing like the output of a command on a device.
+func Frame(lines string) string {
+	return "\n" + xmlStartTagConfigOut + "\n" + xmlEscaper.Replace(lines) + xmlEndTagConfigOut + "\n"
+}
+
+//nolint:gochecknoglobals
+var xmlEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// Apply applies set/delete lines of text on the tree.
+//
+// Empty lines and comments (lines starting with #) are ignored.
+func (tree *Tree) Apply(text string) error {
+	for line := range strings.SplitSeq(text, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "", strings.HasPrefix(line, "#"):
+			continue
+		case strings.HasPrefix(line, setLS):
+			tree.Set(SplitWords(strings.TrimPrefix(line, setLS))...)
+		case strings.HasPrefix(line, deleteLS):
+			tree.Delete(SplitWords(strings.TrimPrefix(line, deleteLS))...)
+		default:
+			return fmt.Errorf("syntax error: unsupported line %q", line)
+		}
+	}
+
+	return nil
+}
+
+// Set adds the set line with words.
+func (tree *Tree) Set(words ...string) {
+	if len(words) == 0 {
+		return
+	}
+	current := &tree.root
+	for _, word := range words {
+		child, ok := current.index[word]
+		if !ok {
+			child = &node{word: word}
+			if current.index == nil {
+				current.index = make(map[string]*node)
+			}
+			current.index[word] = child
+			current.children = append(current.children, child)
+		}
+		current = child
+	}
+	current.set = true
+}
+
+// Delete removes the words and all configuration under them
+// and returns if there was something to remove.
+//
+// The parent levels without configuration left are removed too.
+func (tree *Tree) Delete(words ...string) bool {
+	if len(words) == 0 {
+		return false
+	}
+
+	return tree.root.delete(words)
+}
+
+func (n *node) delete(words []string) bool {
+	child, ok := n.index[words[0]]
+	if !ok {
+		return false
+	}
+	if len(words) > 1 {
+		if !child.delete(words[1:]) {
+			return false
+		}
+		if child.set || len(child.children) > 0 {
+			return true
+		}
+	}
+	delete(n.index, child.word)
+	n.children = slices.DeleteFunc(n.children, func(v *node) bool { return v == child })
+
+	return true
+}
+
+// Has checks if there is configuration at or under words.
+func (tree *Tree) Has(words ...string) bool {
+	n := tree.root.find(words)
+
+	return n != nil && (n.set || len(n.children) > 0)
+}
+
+func (n *node) find(words []string) *node {
+	current := n
+	for _, word := range words {
+		child, ok := current.index[word]
+		if !ok {
+			return nil
+		}
+		current = child
+	}
+
+	return current
+}
+
+// Children returns the words directly under words in order.
+func (tree *Tree) Children(words ...string) []string {
+	n := tree.root.find(words)
+	if n == nil {
+		return []string{}
+	}
+	children := make([]string, len(n.children))
+	for i, child := range n.children {
+		children[i] = child.word
+	}
+
+	return children
+}
+
+// Subtree returns a copy of the configuration under words,
+// relative to words (like `| display set relative`).
+func (tree *Tree) Subtree(words ...string) *Tree {
+	subtree := New()
+	n := tree.root.find(words)
+	if n == nil {
+		return subtree
+	}
+	for _, child := range n.children {
+		subtree.root.addClone(child)
+	}
+
+	return subtree
+}
+
+func (n *node) addClone(child *node) {
+	newChild := &node{word: child.word, set: child.set}
+	if n.index == nil {
+		n.index = make(map[string]*node)
+	}
+	n.index[newChild.word] = newChild
+	n.children = append(n.children, newChild)
+	for _, v := range child.children {
+		newChild.addClone(v)
+	}
+}
+
+// Clone returns a copy of the tree.
+func (tree *Tree) Clone() *Tree {
+	return tree.Subtree()
+}
+
+// Empty checks if there is no configuration in the tree.
+func (tree *Tree) Empty() bool {
+	return len(tree.root.children) == 0
+}
+
+// Words returns the words of each set line under prefix (all lines without prefix).
+func (tree *Tree) Words(prefix ...string) [][]string {
+	words := make([][]string, 0)
+	n := tree.root.find(prefix)
+	if n == nil {
+		return words
+	}
+	if n.set && len(prefix) > 0 {
+		words = append(words, slices.Clone(prefix))
+	}
+	for _, child := range n.children {
+		child.walk(slices.Clone(prefix), func(line []string) {
+			words = append(words, line)
+		})
+	}
+
+	return words
+}
+
+func (n *node) walk(parent []string, fn func([]string)) {
+	words := append(parent, n.word)
+	if n.set {
+		fn(slices.Clone(words))
+	}
+	for _, child := range n.children {
+		child.walk(words[:len(words):len(words)], fn)
+	}
+}
+
+// Lines returns the set lines under prefix (all lines without prefix)
+// with words quoted like a device.
+func (tree *Tree) Lines(prefix ...string) []string {
+	words := tree.Words(prefix...)
+	lines := make([]string, len(words))
+	for i, v := range words {
+		lines[i] = setLS + JoinWords(v)
+	}
+
+	return lines
+}
+
+// Render returns the set lines under prefix (all lines without prefix),
+// each line ending with a newline.
+//
+// With relative, the words of prefix are removed from lines (like `| display set relative`).
+func (tree *Tree) Render(relative bool, prefix ...string) string {
+	var lines []string
+	if relative {
+		lines = tree.Subtree(prefix...).Lines()
+	} else {
+		lines = tree.Lines(prefix...)
+	}
+	var output strings.Builder
+	for _, line := range lines {
+		output.WriteString(line + "\n")
+	}
+
+	return output.String()
+}
+
+// String returns all set lines of the tree, each line ending with a newline.
+func (tree *Tree) String() string {
+	return tree.Render(false)
+}
+
+// RenderText returns the configuration of tree in curly-brace text format,
+// each line ending with a newline.
+//
+// The type of words (container, identifier or value) is not known without the schema of device
+// so a chain of words without branch is written as one statement
+// (like `unit 0 family inet address 192.0.2.1/24;`).
+// With tag (like `delete:`), the tag is added before each set line written as a statement
+// and the configuration under it is not written.
+func (tree *Tree) RenderText(tag string) string {
+	var output strings.Builder
+	for _, child := range tree.root.children {
+		child.writeText(&output, 0, tag)
+	}
+
+	return output.String()
+}
+
+func (n *node) writeText(output *strings.Builder, depth int, tag string) {
+	words := []string{n.word}
+	current := n
+	for !current.set && len(current.children) == 1 {
+		current = current.children[0]
+		words = append(words, current.word)
+	}
+	indent := strings.Repeat("    ", depth)
+	if len(current.children) == 0 || (current.set && tag != "") {
+		if tag != "" {
+			indent += tag + " "
+		}
+		output.WriteString(indent + JoinWords(words) + ";\n")
+
+		return
+	}
+	output.WriteString(indent + JoinWords(words) + " {\n")
+	for _, child := range current.children {
+		child.writeText(output, depth+1, tag)
+	}
+	output.WriteString(indent + "}\n")
+}
+
+// SplitWords splits a configuration line in words
+// and removes the quotes around words.
+//
+// In a quoted word, \" and \\ are unescaped.
+func SplitWords(line string) []string {
+	words := make([]string, 0)
+	for line = strings.TrimLeft(line, " \t"); line != ""; line = strings.TrimLeft(line, " \t") {
+		if line[0] != '"' {
+			end := strings.IndexAny(line, " \t")
+			if end == -1 {
+				end = len(line)
+			}
+			words = append(words, line[:end])
+			line = line[end:]
+
+			continue
+		}
+		var word strings.Builder
+		i := 1
+		for ; i < len(line); i++ {
+			if line[i] == '\\' && i+1 < len(line) && (line[i+1] == '"' || line[i+1] == '\\') {
+				i++
+				word.WriteByte(line[i])
+
+				continue
+			}
+			if line[i] == '"' {
+				break
+			}
+			word.WriteByte(line[i])
+		}
+		words = append(words, word.String())
+		line = line[min(i+1, len(line)):]
+	}
+
+	return words
+}
+
+// Quote adds quotes around word if needed like a device.
+func Quote(word string) string {
+	if word != "" && !strings.ContainsAny(word, " \t\n;{}#\"'") {
+		return word
+	}
+
+	return "\"" + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(word) + "\""
+}
+
+// JoinWords joins words of a configuration line
+// and adds quotes around words if needed.
+func JoinWords(words []string) string {
+	quoted := make([]string, len(words))
+	for i, word := range words {
+		quoted[i] = Quote(word)
+	}
+
+	return strings.Join(quoted, " ")
+}
+
+// HasPrefix checks if words of a configuration line start with the words of prefix.
+func HasPrefix(words, prefix []string) bool {
+	if len(words) < len(prefix) {
+		return false
+	}
+
+	return slices.Equal(words[:len(prefix)], prefix)
+}
@@ -0,0 +1,209 @@
+package configtree_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/configtree"
+)
+
+func TestSplitWords(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		line        string
+		expectWords []string
+	}
+	tests := map[string]testCase{
+		"simple": {
+			line:        "interfaces ge-0/0/0 unit 0",
+			expectWords: []string{"interfaces", "ge-0/0/0", "unit", "0"},
+		},
+		"quoted": {
+			line:        `interfaces ge-0/0/0 description "a description"`,
+			expectWords: []string{"interfaces", "ge-0/0/0", "description", "a description"},
+		},
+		"quoted_escaped": {
+			line:        `system login message "say \"hello\" with \\"`,
+			expectWords: []string{"system", "login", "message", `say "hello" with \`},
+		},
+		"quoted_empty": {
+			line:        `snmp description ""`,
+			expectWords: []string{"snmp", "description", ""},
+		},
+		"extra_spaces": {
+			line:        "  vlans  \"vlan 10\"   vlan-id 10 ",
+			expectWords: []string{"vlans", "vlan 10", "vlan-id", "10"},
+		},
+		"unterminated_quote": {
+			line:        `vlans "vlan 10`,
+			expectWords: []string{"vlans", "vlan 10"},
+		},
+		"empty": {
+			line:        "",
+			expectWords: []string{},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			words := configtree.SplitWords(tc.line)
+			if !slices.Equal(words, tc.expectWords) {
+				t.Errorf("got unexpected words %q, want %q", words, tc.expectWords)
+			}
+			if len(words) > 0 {
+				if rewords := configtree.SplitWords(configtree.JoinWords(words)); !slices.Equal(rewords, words) {
+					t.Errorf("got unexpected words %q after join, want %q", rewords, words)
+				}
+			}
+		})
+	}
+}
+
+func TestQuote(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"ge-0/0/0":     "ge-0/0/0",
+		"":             `""`,
+		"a b":          `"a b"`,
+		"a;b":          `"a;b"`,
+		`say "hi"`:     `"say \"hi\""`,
+		`path\ "x"`:    `"path\\ \"x\""`,
+		"192.0.2.0/25": "192.0.2.0/25",
+	}
+
+	for word, expect := range tests {
+		if v := configtree.Quote(word); v != expect {
+			t.Errorf("got unexpected quoted %q for %q, want %q", v, word, expect)
+		}
+	}
+}
+
+func TestTree(t *testing.T) {
+	t.Parallel()
+
+	tree, err := configtree.Parse(`# comment
+set vlans vlan10 vlan-id 10
+set vlans vlan10 description "vlan 10"
+set vlans "vlan 20" vlan-id 20
+set protocols lldp interface all
+set protocols lldp interface all disable
+set vlans vlan10 vlan-id 10
+`)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expect := `set vlans vlan10 vlan-id 10
+set vlans vlan10 description "vlan 10"
+set vlans "vlan 20" vlan-id 20
+set protocols lldp interface all
+set protocols lldp interface all disable
+`
+	if v := tree.String(); v != expect {
+		t.Errorf("got unexpected lines\n%s\nwant\n%s", v, expect)
+	}
+	if v := tree.Render(true, "vlans", "vlan 20"); v != "set vlan-id 20\n" {
+		t.Errorf("got unexpected relative lines %q", v)
+	}
+	if v := tree.Render(false, "protocols", "lldp", "interface", "all"); v != "set protocols lldp interface all\n"+
+		"set protocols lldp interface all disable\n" {
+		t.Errorf("got unexpected lines %q", v)
+	}
+	if v := tree.Render(true, "protocols", "lldp", "interface", "all"); v != "set disable\n" {
+		t.Errorf("got unexpected relative lines %q", v)
+	}
+
+	clone := tree.Clone()
+	if err := tree.Apply(`delete vlans vlan10 vlan-id
+delete vlans vlan10 description
+delete protocols lldp interface all disable
+delete system`); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expect = `set vlans "vlan 20" vlan-id 20
+set protocols lldp interface all
+`
+	if v := tree.String(); v != expect {
+		t.Errorf("got unexpected lines after delete\n%s\nwant\n%s", v, expect)
+	}
+	if tree.Has("vlans", "vlan10") {
+		t.Errorf("got unexpected vlan10 after delete")
+	}
+	if !clone.Has("vlans", "vlan10") {
+		t.Errorf("got unexpected clone changed by delete")
+	}
+	if tree.Delete("vlans", "vlan10") {
+		t.Errorf("got unexpected delete of vlan10 already deleted")
+	}
+
+	if _, err := configtree.Parse("show vlans"); err == nil {
+		t.Errorf("got unexpected no error with show line")
+	}
+}
+
+func TestTreeRenderText(t *testing.T) {
+	t.Parallel()
+
+	tree, err := configtree.Parse(`set interfaces ge-0/0/0 description "uplink 1"
+set interfaces ge-0/0/0 unit 0 family inet address 192.0.2.1/24
+set protocols lldp interface all
+set protocols lldp interface all disable
+set system host-name sim
+`)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expect := `interfaces ge-0/0/0 {
+    description "uplink 1";
+    unit 0 family inet address 192.0.2.1/24;
+}
+protocols lldp interface all {
+    disable;
+}
+system host-name sim;
+`
+	if v := tree.RenderText(""); v != expect {
+		t.Errorf("got unexpected text\n%s\nwant\n%s", v, expect)
+	}
+	expect = `interfaces ge-0/0/0 {
+    delete: description "uplink 1";
+    delete: unit 0 family inet address 192.0.2.1/24;
+}
+delete: protocols lldp interface all;
+delete: system host-name sim;
+`
+	if v := tree.RenderText("delete:"); v != expect {
+		t.Errorf("got unexpected text with tag\n%s\nwant\n%s", v, expect)
+	}
+}
+
+func TestParseOutput(t *testing.T) {
+	t.Parallel()
+
+	lines := "set policy-options prefix-list test apply-path \"interfaces <*>\"\n"
+	output := configtree.Frame(lines)
+	if output != "\n<configuration-output>\n"+
+		"set policy-options prefix-list test apply-path \"interfaces &lt;*&gt;\"\n"+
+		"</configuration-output>\n" {
+		t.Errorf("got unexpected framed output %q", output)
+	}
+	tree, err := configtree.ParseOutput(output)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if v := tree.String(); v != lines {
+		t.Errorf("got unexpected lines %q, want %q", v, lines)
+	}
+
+	tree, err = configtree.ParseOutput("empty")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !tree.Empty() {
+		t.Errorf("got unexpected not empty tree with empty output")
+	}
+}
@@ -0,0 +1,151 @@
+package configtree
+
+import (
+	"slices"
+	"strings"
+)
+
+// DiffLines returns the delete lines then the set lines
+// to change the configuration from stateLines to planLines (set lines).
+//
+// owned are the hierarchy levels deleted by the resource:
+// under them, a level without configuration left in plan is deleted as a whole
+// and a level with only values (like a description) is deleted before setting the new values.
+// Outside them, only the removed lines are deleted.
+//
+// Return false if a line is not a set line or if the order of configuration changes
+// (like terms in a firewall filter) as it can't be kept with only the difference.
+func DiffLines(stateLines, planLines []string, owned [][]string) ([]string, bool) {
+	stateTree := New()
+	stateWords := make([][]string, 0, len(stateLines))
+	stateSet := make(map[string]struct{})
+	for _, line := range stateLines {
+		for v := range strings.SplitSeq(line, "\n") {
+			itemTrim, ok := strings.CutPrefix(strings.TrimSpace(v), setLS)
+			if !ok {
+				return nil, false
+			}
+			words := SplitWords(itemTrim)
+			stateWords = append(stateWords, words)
+			stateSet[strings.Join(words, "\x00")] = struct{}{}
+			stateTree.Set(words...)
+		}
+	}
+	planTree := New()
+	planWords := make([][]string, 0, len(planLines))
+	planText := make([]string, 0, len(planLines))
+	planSet := make(map[string]struct{})
+	for _, line := range planLines {
+		for v := range strings.SplitSeq(line, "\n") {
+			v = strings.TrimSpace(v)
+			itemTrim, ok := strings.CutPrefix(v, setLS)
+			if !ok {
+				return nil, false
+			}
+			words := SplitWords(itemTrim)
+			planWords = append(planWords, words)
+			planText = append(planText, v)
+			planSet[strings.Join(words, "\x00")] = struct{}{}
+			planTree.Set(words...)
+		}
+	}
+	if !sameOrder(stateTree, planTree, []string{}) {
+		return nil, false
+	}
+
+	deletes := make([][]string, 0)
+	for _, words := range stateWords {
+		if _, ok := planSet[strings.Join(words, "\x00")]; ok {
+			continue
+		}
+		if slices.ContainsFunc(deletes, func(v []string) bool {
+			return HasPrefix(words, v)
+		}) {
+			continue
+		}
+		deleteWords := words
+		if ownedIndex := slices.IndexFunc(owned, func(v []string) bool {
+			return len(v) > 0 && len(words) > len(v) && HasPrefix(words, v)
+		}); ownedIndex != -1 {
+			// delete the highest level without configuration left in plan
+			for k := len(owned[ownedIndex]) + 1; k <= len(words); k++ {
+				if !planTree.Has(words[:k]...) {
+					deleteWords = words[:k]
+
+					break
+				}
+			}
+			// a level with only values (one more word for each line) is deleted as a whole
+			// to replace the values
+			if parent := words[:len(words)-1]; len(deleteWords) == len(words) &&
+				len(parent) > len(owned[ownedIndex]) &&
+				onlyValues(stateTree, parent) &&
+				onlyValues(planTree, parent) {
+				deleteWords = parent
+			}
+		}
+		deletes = slices.DeleteFunc(deletes, func(v []string) bool {
+			return HasPrefix(v, deleteWords)
+		})
+		deletes = append(deletes, deleteWords)
+	}
+
+	configSet := make([]string, 0, len(deletes)+len(planText))
+	for _, words := range deletes {
+		configSet = append(configSet, deleteLS+JoinWords(words))
+	}
+	for i, words := range planWords {
+		if _, ok := stateSet[strings.Join(words, "\x00")]; ok && !slices.ContainsFunc(deletes, func(v []string) bool {
+			return HasPrefix(words, v)
+		}) {
+			continue
+		}
+		configSet = append(configSet, planText[i])
+	}
+
+	return configSet, true
+}
+
+// onlyValues checks if all lines under words have only one more word.
+func onlyValues(tree *Tree, words []string) bool {
+	for _, v := range tree.Words(words...) {
+		if len(v) != len(words)+1 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sameOrder checks, under prefix and recursively,
+// that levels in both state and plan have the same order
+// and that new levels in plan are after them.
+func sameOrder(stateTree, planTree *Tree, prefix []string) bool {
+	stateChildren := stateTree.Children(prefix...)
+	planChildren := planTree.Children(prefix...)
+	common := make([]string, 0, len(planChildren))
+	newFound := false
+	for _, v := range planChildren {
+		if !slices.Contains(stateChildren, v) {
+			newFound = true
+
+			continue
+		}
+		if newFound {
+			return false
+		}
+		common = append(common, v)
+	}
+	if !slices.Equal(common, slices.DeleteFunc(slices.Clone(stateChildren), func(v string) bool {
+		return !slices.Contains(planChildren, v)
+	})) {
+		return false
+	}
+	for _, v := range common {
+		if !sameOrder(stateTree, planTree, append(slices.Clone(prefix), v)) {
+			return false
+		}
+	}
+
+	return true
+}
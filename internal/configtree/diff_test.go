@@ -0,0 +1,171 @@
+package configtree_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/configtree"
+)
+
+func TestDiffLines(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		stateLines  []string
+		planLines   []string
+		owned       [][]string
+		expectLines []string
+		expectOK    bool
+	}
+	owned := [][]string{{"firewall", "family", "inet", "filter", "filter1"}}
+	tests := map[string]testCase{
+		"no_change": {
+			stateLines: []string{
+				"set firewall family inet filter \"filter1\" term t1 then accept",
+			},
+			planLines: []string{
+				"set firewall family inet filter \"filter1\" term t1 then accept",
+			},
+			owned:       owned,
+			expectLines: []string{},
+			expectOK:    true,
+		},
+		"value_replaced": {
+			stateLines: []string{
+				"set firewall family inet filter \"filter1\" interface-specific",
+				"set firewall family inet filter \"filter1\" term t1 from protocol tcp",
+				"set firewall family inet filter \"filter1\" term t1 then accept",
+			},
+			planLines: []string{
+				"set firewall family inet filter \"filter1\" interface-specific",
+				"set firewall family inet filter \"filter1\" term t1 from protocol udp",
+				"set firewall family inet filter \"filter1\" term t1 then accept",
+			},
+			owned: owned,
+			expectLines: []string{
+				"delete firewall family inet filter filter1 term t1 from protocol",
+				"set firewall family inet filter \"filter1\" term t1 from protocol udp",
+			},
+			expectOK: true,
+		},
+		"values_list": {
+			stateLines: []string{
+				"set firewall family inet filter \"filter1\" term t1 from port 22",
+				"set firewall family inet filter \"filter1\" term t1 from port 80",
+			},
+			planLines: []string{
+				"set firewall family inet filter \"filter1\" term t1 from port 22",
+				"set firewall family inet filter \"filter1\" term t1 from port 443",
+			},
+			owned: owned,
+			expectLines: []string{
+				"delete firewall family inet filter filter1 term t1 from port",
+				"set firewall family inet filter \"filter1\" term t1 from port 22",
+				"set firewall family inet filter \"filter1\" term t1 from port 443",
+			},
+			expectOK: true,
+		},
+		"flag_removed": {
+			stateLines: []string{
+				"set firewall family inet filter \"filter1\" interface-specific",
+				"set firewall family inet filter \"filter1\" term t1 then accept",
+			},
+			planLines: []string{
+				"set firewall family inet filter \"filter1\" term t1 then accept",
+			},
+			owned: owned,
+			expectLines: []string{
+				"delete firewall family inet filter filter1 interface-specific",
+			},
+			expectOK: true,
+		},
+		"term_removed": {
+			stateLines: []string{
+				"set firewall family inet filter \"filter1\" term t1 then accept",
+				"set firewall family inet filter \"filter1\" term t2 from protocol tcp",
+				"set firewall family inet filter \"filter1\" term t2 then discard",
+			},
+			planLines: []string{
+				"set firewall family inet filter \"filter1\" term t1 then accept",
+			},
+			owned: owned,
+			expectLines: []string{
+				"delete firewall family inet filter filter1 term t2",
+			},
+			expectOK: true,
+		},
+		"term_added_at_end": {
+			stateLines: []string{
+				"set firewall family inet filter \"filter1\" term t1 then accept",
+			},
+			planLines: []string{
+				"set firewall family inet filter \"filter1\" term t1 then accept",
+				"set firewall family inet filter \"filter1\" term t2 then discard",
+			},
+			owned: owned,
+			expectLines: []string{
+				"set firewall family inet filter \"filter1\" term t2 then discard",
+			},
+			expectOK: true,
+		},
+		"term_added_before": {
+			stateLines: []string{
+				"set firewall family inet filter \"filter1\" term t1 then accept",
+			},
+			planLines: []string{
+				"set firewall family inet filter \"filter1\" term t0 then discard",
+				"set firewall family inet filter \"filter1\" term t1 then accept",
+			},
+			owned:    owned,
+			expectOK: false,
+		},
+		"terms_reordered": {
+			stateLines: []string{
+				"set firewall family inet filter \"filter1\" term t1 then accept",
+				"set firewall family inet filter \"filter1\" term t2 then discard",
+			},
+			planLines: []string{
+				"set firewall family inet filter \"filter1\" term t2 then discard",
+				"set firewall family inet filter \"filter1\" term t1 then accept",
+			},
+			owned:    owned,
+			expectOK: false,
+		},
+		"not_owned": {
+			stateLines: []string{
+				"set interfaces ge-0/0/0 description \"old desc\"",
+			},
+			planLines: []string{
+				"set interfaces ge-0/0/0 description \"new desc\"",
+			},
+			owned: [][]string{},
+			expectLines: []string{
+				"delete interfaces ge-0/0/0 description \"old desc\"",
+				"set interfaces ge-0/0/0 description \"new desc\"",
+			},
+			expectOK: true,
+		},
+		"not_set_line": {
+			stateLines: []string{
+				"delete interfaces ge-0/0/0",
+			},
+			planLines: []string{},
+			owned:     [][]string{},
+			expectOK:  false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			lines, ok := configtree.DiffLines(tc.stateLines, tc.planLines, tc.owned)
+			if ok != tc.expectOK {
+				t.Fatalf("got unexpected ok %t", ok)
+			}
+			if ok && !slices.Equal(lines, tc.expectLines) {
+				t.Errorf("got unexpected lines %q, want %q", lines, tc.expectLines)
+			}
+		})
+	}
+}
@@ -2,7 +2,10 @@ package junos
 
 import (
 	"errors"
+	"fmt"
+	"slices"
 	"sync"
+	"time"
 )
 
 const directoryPermission = 0o755
@@ -26,14 +29,31 @@ type Client struct {
 	junosSSHCiphers                 []string
 	junosSSHTimeoutToEstab          int
 	junosSSHRetryToEstab            int
+	rpcTimeout                      int
+	retryPolicy                     RetryPolicy
 	filePermission                  int64
 	logFileDst                      string
 	fakeCreateSetFile               string
 	fakeUpdateAlso                  bool
 	fakeDeleteAlso                  bool
+	fakeSetFileHeader               bool
+	fakeSetFileXML                  bool
+	fakeSetFileText                 bool
+	fakeSetFileMutex                sync.Mutex
+	offline                         *offlineConfig
+	offlineHardwareModel            string
 	useSingleSession                bool
+	planCheckReferences             string
 	sharedSession                   *Session
 	sessionMutex                    sync.Mutex
+	managedResources                map[string]map[string]struct{}
+	plannedResources                map[string]map[string]struct{}
+	referenceLookups                map[string]bool
+	managedResourcesMutex           sync.Mutex
+	configMutex                     sync.RWMutex
+	platformDetected                *Platform
+	platformDetectedKey             string
+	platformMutex                   sync.Mutex
 }
 
 func NewClient(ip string) *Client {
@@ -55,6 +75,8 @@ func NewClient(ip string) *Client {
 		junosSSHCiphers:                 DefaultSSHCiphers(),
 		junosSSHTimeoutToEstab:          0,
 		junosSSHRetryToEstab:            1,
+		rpcTimeout:                      0,
+		retryPolicy:                     DefaultRetryPolicy(),
 		filePermission:                  0o644,
 		logFileDst:                      "",
 		fakeCreateSetFile:               "",
@@ -169,6 +191,60 @@ func (clt *Client) WithSSHRetryToEstablish(retry int) (*Client, error) {
 	return clt, nil
 }
 
+// WithRPCTimeout sets the timeout (in seconds) to wait the reply of each RPC on device,
+// 0 to wait indefinitely.
+func (clt *Client) WithRPCTimeout(timeout int) (*Client, error) {
+	if timeout < 0 {
+		return clt, errors.New("bad value for timeout of RPC, must be positive")
+	}
+	clt.rpcTimeout = timeout
+
+	return clt, nil
+}
+
+// WithRetryMaxElapsedTime sets the time (in seconds) after which a RPC failed
+// with a transient error is no longer retried, 0 to disable retries.
+func (clt *Client) WithRetryMaxElapsedTime(maxElapsedTime int) (*Client, error) {
+	if maxElapsedTime < 0 {
+		return clt, errors.New("bad value for maximum elapsed time of retries, must be positive")
+	}
+	clt.retryPolicy.MaxElapsedTime = time.Duration(maxElapsedTime) * time.Second
+
+	return clt, nil
+}
+
+// WithRetryInitialInterval sets the wait (in seconds) before the first retry of a RPC.
+func (clt *Client) WithRetryInitialInterval(interval int) (*Client, error) {
+	if interval < 1 {
+		return clt, errors.New("bad value for initial interval of retries, must be greater than 0")
+	}
+	clt.retryPolicy.InitialInterval = time.Duration(interval) * time.Second
+
+	return clt, nil
+}
+
+// WithRetryMaxInterval sets the maximum wait (in seconds) between two retries of a RPC.
+func (clt *Client) WithRetryMaxInterval(interval int) (*Client, error) {
+	if interval < 1 {
+		return clt, errors.New("bad value for maximum interval of retries, must be greater than 0")
+	}
+	clt.retryPolicy.MaxInterval = time.Duration(interval) * time.Second
+
+	return clt, nil
+}
+
+// WithRetryErrors sets the kinds of transient errors retried (see RetryKinds).
+func (clt *Client) WithRetryErrors(kinds []string) (*Client, error) {
+	for _, kind := range kinds {
+		if !slices.Contains(RetryKinds(), kind) {
+			return clt, fmt.Errorf("unknown kind of error %q to retry", kind)
+		}
+	}
+	clt.retryPolicy.Kinds = slices.Clone(kinds)
+
+	return clt, nil
+}
+
 func (clt *Client) WithFilePermission(perm int64) (*Client, error) {
 	if perm > 0o777 || perm < 0 {
 		return clt, errors.New("bad value for file permision, must be three octal digits")
@@ -202,12 +278,47 @@ func (clt *Client) WithFakeDeleteAlso() *Client {
 	return clt
 }
 
+// WithFakeSetFileHeader enables the comment header (with resource and operation)
+// before the lines of each resource in the fake set file.
+func (clt *Client) WithFakeSetFileHeader() *Client {
+	clt.fakeSetFileHeader = true
+
+	return clt
+}
+
+// WithFakeSetFileXML enables the write of lines in XML <load-configuration> RPCs
+// in a second file, with the `.xml` extension appended to the fake set file.
+func (clt *Client) WithFakeSetFileXML() *Client {
+	clt.fakeSetFileXML = true
+
+	return clt
+}
+
+// WithFakeSetFileText enables the write of lines in curly-brace text format
+// in a third file, with the `.conf` extension appended to the fake set file.
+func (clt *Client) WithFakeSetFileText() *Client {
+	clt.fakeSetFileText = true
+
+	return clt
+}
+
 func (clt *Client) WithSingleSession() *Client {
 	clt.useSingleSession = true
 
 	return clt
 }
 
+// WithPlanCheckReferences enables the check, during plan, of configuration referenced by resources
+// with the diagnostic level (PlanCheckReferencesWarning or PlanCheckReferencesError).
+func (clt *Client) WithPlanCheckReferences(level string) (*Client, error) {
+	if level != PlanCheckReferencesWarning && level != PlanCheckReferencesError {
+		return clt, fmt.Errorf("bad value %q for level of plan check references", level)
+	}
+	clt.planCheckReferences = level
+
+	return clt, nil
+}
+
 func (clt *Client) FakeCreateSetFile() bool {
 	return clt.fakeCreateSetFile != ""
 }
@@ -220,6 +331,24 @@ func (clt *Client) FakeDeleteAlso() bool {
 	return clt.fakeDeleteAlso
 }
 
+func (clt *Client) FakeSetFileHeader() bool {
+	return clt.fakeSetFileHeader
+}
+
+func (clt *Client) FakeSetFileXML() bool {
+	return clt.fakeSetFileXML
+}
+
+func (clt *Client) FakeSetFileText() bool {
+	return clt.fakeSetFileText
+}
+
+// PlanCheckReferences returns the diagnostic level of the check of references during plan
+// or empty if disabled.
+func (clt *Client) PlanCheckReferences() string {
+	return clt.planCheckReferences
+}
+
 func (clt *Client) GroupInterfaceDelete() string {
 	return clt.groupIntDel
 }
@@ -1,30 +1,156 @@
 package junos
 
 import (
+	"bytes"
+	"encoding/xml"
 	"errors"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"path"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/configtree"
+)
+
+const (
+	// FakeSetFileXMLExtension: extension appended to the fake set file for the file with XML RPCs.
+	FakeSetFileXMLExtension = ".xml"
+	// FakeSetFileTextExtension: extension appended to the fake set file for the file in text format.
+	FakeSetFileTextExtension = ".conf"
 )
 
-func (clt *Client) appendFakeCreateSetFile(lines []string) error {
-	dirSetFile := path.Dir(clt.fakeCreateSetFile)
-	if _, err := os.Stat(dirSetFile); err != nil {
-		if err := os.MkdirAll(dirSetFile, os.FileMode(directoryPermission)); err != nil {
-			return fmt.Errorf("creating parent directory of '%s': %w", clt.fakeCreateSetFile, err)
+// appendFakeSetFile appends the lines of a section to the fake set file,
+// with a comment header for the section if enabled,
+// to the XML file in a <load-configuration> RPC if enabled
+// and to the text file in curly-brace text format if enabled.
+//
+// The write of a section is protected against the other writers of the client (resources in parallel)
+// and the files are locked against the other processes,
+// so that the lines of different sections are never interleaved.
+func (clt *Client) appendFakeSetFile(section string, lines []string) error {
+	if len(lines) == 0 {
+		return nil
+	}
+	// generate the text before any write to not write the section only in some files
+	var textContent strings.Builder
+	if clt.fakeSetFileText {
+		text, err := fakeSetFileText(lines)
+		if err != nil {
+			return err
+		}
+		if clt.fakeSetFileHeader {
+			textContent.WriteString("# " + section + "\n")
+		}
+		textContent.WriteString(text)
+	}
+	clt.fakeSetFileMutex.Lock()
+	defer clt.fakeSetFileMutex.Unlock()
+
+	var setContent strings.Builder
+	if clt.fakeSetFileHeader {
+		setContent.WriteString("# " + section + "\n")
+	}
+	for _, v := range lines {
+		setContent.WriteString(v + "\n")
+	}
+	if err := clt.appendLockedFile(clt.fakeCreateSetFile, setContent.String()); err != nil {
+		return err
+	}
+	if clt.fakeSetFileXML {
+		if err := clt.appendFakeSetFileXML(section, lines); err != nil {
+			return err
+		}
+	}
+	if clt.fakeSetFileText {
+		if err := clt.appendLockedFile(clt.fakeCreateSetFile+FakeSetFileTextExtension, textContent.String()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// appendFakeSetFileXML appends the lines of a section to the XML file in a <load-configuration> RPC
+// with action set.
+func (clt *Client) appendFakeSetFileXML(section string, lines []string) error {
+	var xmlContent strings.Builder
+	if clt.fakeSetFileHeader {
+		// "--" is not allowed in a XML comment
+		xmlContent.WriteString("<!-- " + strings.ReplaceAll(section, "--", "- -") + " -->\n")
+	}
+	xmlContent.WriteString("<load-configuration action=\"set\" format=\"text\">\n<configuration-set>\n")
+	for _, v := range lines {
+		if err := xml.EscapeText(&xmlContent, []byte(v)); err != nil {
+			return fmt.Errorf("escaping line %q in XML: %w", v, err)
+		}
+		xmlContent.WriteString("\n")
+	}
+	xmlContent.WriteString("</configuration-set>\n</load-configuration>\n")
+
+	return clt.appendLockedFile(clt.fakeCreateSetFile+FakeSetFileXMLExtension, xmlContent.String())
+}
+
+// fakeSetFileText returns the set/delete lines in curly-brace text format,
+// the consecutive set lines and the consecutive delete lines (with the `delete:` tag)
+// in a block to keep the order of lines.
+func fakeSetFileText(lines []string) (string, error) {
+	var text strings.Builder
+	var tree *configtree.Tree
+	treeTag := ""
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		tag := ""
+		var words string
+		switch {
+		case line == "", strings.HasPrefix(line, "#"):
+			continue
+		case strings.HasPrefix(line, SetLS):
+			words = strings.TrimPrefix(line, SetLS)
+		case strings.HasPrefix(line, DeleteLS):
+			words = strings.TrimPrefix(line, DeleteLS)
+			tag = "delete:"
+		default:
+			return "", fmt.Errorf("line %q can't be written in text format, only set/delete lines are supported", line)
+		}
+		if tree == nil || tag != treeTag {
+			if tree != nil {
+				text.WriteString(tree.RenderText(treeTag))
+			}
+			tree = configtree.New()
+			treeTag = tag
 		}
+		tree.Set(configtree.SplitWords(words)...)
 	}
-	f, err := os.OpenFile(clt.fakeCreateSetFile,
+	if tree != nil {
+		text.WriteString(tree.RenderText(treeTag))
+	}
+
+	return text.String(), nil
+}
+
+// appendLockedFile appends content to file (created with its parent directory if necessary)
+// with an exclusive lock on file during the write.
+func (clt *Client) appendLockedFile(file, content string) error {
+	dirFile := path.Dir(file)
+	if _, err := os.Stat(dirFile); err != nil {
+		if err := os.MkdirAll(dirFile, os.FileMode(directoryPermission)); err != nil {
+			return fmt.Errorf("creating parent directory of '%s': %w", file, err)
+		}
+	}
+	f, err := os.OpenFile(file,
 		os.O_APPEND|os.O_CREATE|os.O_WRONLY, os.FileMode(clt.filePermission))
 	if err != nil {
-		return fmt.Errorf("opening file '%s': %w", clt.fakeCreateSetFile, err)
+		return fmt.Errorf("opening file '%s': %w", file, err)
 	}
 	defer f.Close()
-	for _, v := range lines {
-		if _, err := f.WriteString(v + "\n"); err != nil {
-			return fmt.Errorf("writing in file '%s': %w", clt.fakeCreateSetFile, err)
-		}
+	if err := lockFile(f); err != nil {
+		return fmt.Errorf("locking file '%s': %w", file, err)
+	}
+	defer func() { _ = unlockFile(f) }()
+	if _, err := f.WriteString(content); err != nil {
+		return fmt.Errorf("writing in file '%s': %w", file, err)
 	}
 
 	return nil
@@ -52,3 +178,41 @@ func (clt *Client) logFile(message string) {
 func (clt *Client) FilePermission() int64 {
 	return clt.filePermission
 }
+
+// FakeSetFileXMLLines returns the set/delete lines in the <load-configuration action="set"> RPCs
+// of content (like the XML file written with the fake set file).
+func FakeSetFileXMLLines(content []byte) ([]string, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+	lines := make([]string, 0)
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("decoding XML: %w", err)
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "load-configuration" {
+			continue
+		}
+		var rpc struct {
+			Action           string `xml:"action,attr"`
+			ConfigurationSet string `xml:"configuration-set"`
+		}
+		if err := decoder.DecodeElement(&rpc, &start); err != nil {
+			return nil, fmt.Errorf("decoding XML <load-configuration>: %w", err)
+		}
+		if rpc.Action != LoadConfigActionSet {
+			return nil, fmt.Errorf("unsupported action %q in XML <load-configuration>, only %q is supported",
+				rpc.Action, LoadConfigActionSet)
+		}
+		for line := range strings.SplitSeq(rpc.ConfigurationSet, "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				lines = append(lines, line)
+			}
+		}
+	}
+
+	return lines, nil
+}
@@ -0,0 +1,15 @@
+//go:build !unix
+
+package junos
+
+import "os"
+
+// lockFile doesn't lock file on this system,
+// only the writers of the same client are protected against each other.
+func lockFile(_ *os.File) error {
+	return nil
+}
+
+func unlockFile(_ *os.File) error {
+	return nil
+}
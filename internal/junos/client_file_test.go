@@ -0,0 +1,151 @@
+package junos_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+)
+
+func TestSessionWriteFakeSetFile(t *testing.T) {
+	t.Parallel()
+
+	setFile := filepath.Join(t.TempDir(), "setfile", "config.txt")
+	client := junos.NewClient("").
+		WithFakeCreateSetFile(setFile).
+		WithFakeSetFileHeader().
+		WithFakeSetFileXML()
+
+	const resources = 20
+	var wg sync.WaitGroup
+	for i := range resources {
+		wg.Go(func() {
+			junSess := client.NewSessionWithoutNetconf(t.Context())
+			for j := range 5 {
+				if err := junSess.ConfigSet(t.Context(), []string{
+					fmt.Sprintf("set vlans vlan%d description \"line %d <&>\"", i, j),
+				}); err != nil {
+					t.Errorf("unexpected set error: %s", err)
+				}
+			}
+			if err := junSess.WriteFakeSetFile(fmt.Sprintf("junos_vlan.vlan%d", i), "create"); err != nil {
+				t.Errorf("unexpected write error: %s", err)
+			}
+			// lines already written
+			if err := junSess.WriteFakeSetFile(fmt.Sprintf("junos_vlan.vlan%d", i), "create"); err != nil {
+				t.Errorf("unexpected write error: %s", err)
+			}
+		})
+	}
+	wg.Wait()
+
+	setContent, err := os.ReadFile(setFile)
+	if err != nil {
+		t.Fatalf("unexpected read error: %s", err)
+	}
+	setLines := strings.Split(strings.TrimSuffix(string(setContent), "\n"), "\n")
+	if len(setLines) != resources*6 {
+		t.Fatalf("got %d lines in set file, want %d", len(setLines), resources*6)
+	}
+	expectLines := make([]string, 0, resources*5)
+	for section := range slices.Chunk(setLines, 6) {
+		var i int
+		if _, err := fmt.Sscanf(section[0], "# junos_vlan.vlan%d create", &i); err != nil {
+			t.Fatalf("got unexpected header %q: %s", section[0], err)
+		}
+		for j, line := range section[1:] {
+			if expect := fmt.Sprintf("set vlans vlan%d description \"line %d <&>\"", i, j); line != expect {
+				t.Errorf("got unexpected line %q in section of vlan%d, want %q", line, i, expect)
+			}
+		}
+		expectLines = append(expectLines, section[1:]...)
+	}
+
+	xmlContent, err := os.ReadFile(setFile + junos.FakeSetFileXMLExtension)
+	if err != nil {
+		t.Fatalf("unexpected read error: %s", err)
+	}
+	xmlLines, err := junos.FakeSetFileXMLLines(xmlContent)
+	if err != nil {
+		t.Fatalf("unexpected XML error: %s", err)
+	}
+	if !slices.Equal(xmlLines, expectLines) {
+		t.Errorf("got unexpected lines in XML file %q, want %q", xmlLines, expectLines)
+	}
+}
+
+func TestFakeSetFileXMLLines(t *testing.T) {
+	t.Parallel()
+
+	if _, err := junos.FakeSetFileXMLLines([]byte(
+		"<load-configuration action=\"merge\" format=\"text\">" +
+			"<configuration-text>vlans { foo; }</configuration-text></load-configuration>",
+	)); err == nil {
+		t.Errorf("expected error with unsupported action")
+	}
+	if _, err := junos.FakeSetFileXMLLines([]byte("<load-configuration action=\"set\">")); err == nil {
+		t.Errorf("expected error with unclosed element")
+	}
+}
+
+func TestSessionWriteFakeSetFileText(t *testing.T) {
+	t.Parallel()
+
+	setFile := filepath.Join(t.TempDir(), "config.txt")
+	client := junos.NewClient("").
+		WithFakeCreateSetFile(setFile).
+		WithFakeSetFileHeader().
+		WithFakeSetFileText()
+
+	junSess := client.NewSessionWithoutNetconf(t.Context())
+	if err := junSess.ConfigSet(t.Context(), []string{
+		"delete interfaces ge-0/0/0 description",
+		"delete interfaces ge-0/0/0 unit 0",
+		"set interfaces ge-0/0/0 description \"uplink 1\"",
+		"set interfaces ge-0/0/0 unit 0 family inet address 192.0.2.1/24",
+		"set interfaces ge-0/0/0 unit 0 family inet6",
+	}); err != nil {
+		t.Fatalf("unexpected set error: %s", err)
+	}
+	if err := junSess.WriteFakeSetFile("junos_interface_physical.ge000", "update"); err != nil {
+		t.Fatalf("unexpected write error: %s", err)
+	}
+	if err := junSess.ConfigSet(t.Context(), []string{"rename vlans vlan10 to vlan11"}); err != nil {
+		t.Fatalf("unexpected set error: %s", err)
+	}
+	if err := junSess.WriteFakeSetFile("junos_vlan.vlan10", "update"); err == nil {
+		t.Errorf("expected error with a line not supported in text format")
+	}
+	// section with error not written in set file
+	if setContent, err := os.ReadFile(setFile); err != nil {
+		t.Errorf("unexpected read error: %s", err)
+	} else if strings.Contains(string(setContent), "rename") {
+		t.Errorf("got unexpected section with error in set file %q", setContent)
+	}
+
+	textContent, err := os.ReadFile(setFile + junos.FakeSetFileTextExtension)
+	if err != nil {
+		t.Fatalf("unexpected read error: %s", err)
+	}
+	expect := `# junos_interface_physical.ge000 update
+interfaces ge-0/0/0 {
+    delete: description;
+    delete: unit 0;
+}
+interfaces ge-0/0/0 {
+    description "uplink 1";
+    unit 0 family {
+        inet address 192.0.2.1/24;
+        inet6;
+    }
+}
+`
+	if v := string(textContent); v != expect {
+		t.Errorf("got unexpected text file\n%s\nwant\n%s", v, expect)
+	}
+}
@@ -0,0 +1,103 @@
+package junos
+
+import "slices"
+
+// AddManagedResource records a resource (type and id) managed by Terraform with this client.
+func (clt *Client) AddManagedResource(typeName, id string) {
+	clt.managedResourcesMutex.Lock()
+	defer clt.managedResourcesMutex.Unlock()
+
+	clt.managedResources = addResourceID(clt.managedResources, typeName, id)
+}
+
+// RemoveManagedResource removes a resource (type and id) destroyed by Terraform
+// from the resources managed with this client.
+func (clt *Client) RemoveManagedResource(typeName, id string) {
+	clt.managedResourcesMutex.Lock()
+	defer clt.managedResourcesMutex.Unlock()
+
+	if ids, ok := clt.managedResources[typeName]; ok {
+		delete(ids, id)
+		if len(ids) == 0 {
+			delete(clt.managedResources, typeName)
+		}
+	}
+}
+
+// ManagedResources returns the sorted ids of resources managed by Terraform with this client
+// by resource type.
+func (clt *Client) ManagedResources() map[string][]string {
+	clt.managedResourcesMutex.Lock()
+	defer clt.managedResourcesMutex.Unlock()
+
+	return sortedResourceIDs(clt.managedResources)
+}
+
+// AddPlannedResource records a resource (type and id) planned by Terraform with this client
+// (created or updated in the current plan).
+func (clt *Client) AddPlannedResource(typeName, id string) {
+	clt.managedResourcesMutex.Lock()
+	defer clt.managedResourcesMutex.Unlock()
+
+	clt.plannedResources = addResourceID(clt.plannedResources, typeName, id)
+}
+
+// PlannedResources returns the sorted ids of resources planned by Terraform with this client
+// by resource type.
+func (clt *Client) PlannedResources() map[string][]string {
+	clt.managedResourcesMutex.Lock()
+	defer clt.managedResourcesMutex.Unlock()
+
+	return sortedResourceIDs(clt.plannedResources)
+}
+
+// ReferenceLookup returns the result of a previous lookup of referenced configuration
+// (with the key of the reference) on device with this client.
+func (clt *Client) ReferenceLookup(key string) (exists, ok bool) {
+	clt.managedResourcesMutex.Lock()
+	defer clt.managedResourcesMutex.Unlock()
+
+	exists, ok = clt.referenceLookups[key]
+
+	return exists, ok
+}
+
+// AddReferenceLookup records the result of a lookup of referenced configuration
+// (with the key of the reference) on device to not look it up again with this client
+// when planning the other resources.
+func (clt *Client) AddReferenceLookup(key string, exists bool) {
+	clt.managedResourcesMutex.Lock()
+	defer clt.managedResourcesMutex.Unlock()
+
+	if clt.referenceLookups == nil {
+		clt.referenceLookups = make(map[string]bool)
+	}
+	clt.referenceLookups[key] = exists
+}
+
+func addResourceID(
+	resources map[string]map[string]struct{}, typeName, id string,
+) map[string]map[string]struct{} {
+	if resources == nil {
+		resources = make(map[string]map[string]struct{})
+	}
+	if _, ok := resources[typeName]; !ok {
+		resources[typeName] = make(map[string]struct{})
+	}
+	resources[typeName][id] = struct{}{}
+
+	return resources
+}
+
+func sortedResourceIDs(registry map[string]map[string]struct{}) map[string][]string {
+	resources := make(map[string][]string, len(registry))
+	for typeName, ids := range registry {
+		resources[typeName] = make([]string, 0, len(ids))
+		for id := range ids {
+			resources[typeName] = append(resources[typeName], id)
+		}
+		slices.Sort(resources[typeName])
+	}
+
+	return resources
+}
@@ -0,0 +1,31 @@
+package junos_test
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+)
+
+func TestClientRemoveManagedResource(t *testing.T) {
+	t.Parallel()
+
+	client := junos.NewClient("")
+	client.AddManagedResource("junos_vlan", "vlan1")
+	client.AddManagedResource("junos_vlan", "vlan2")
+	client.AddManagedResource("junos_security_zone", "trust")
+
+	client.RemoveManagedResource("junos_vlan", "vlan1")
+	client.RemoveManagedResource("junos_security_zone", "trust")
+	// remove a resource not recorded
+	client.RemoveManagedResource("junos_application", "app1")
+
+	managed := client.ManagedResources()
+	if types := slices.Sorted(maps.Keys(managed)); !slices.Equal(types, []string{"junos_vlan"}) {
+		t.Errorf("unexpected types of managed resources: got %v, want [junos_vlan]", types)
+	}
+	if ids := managed["junos_vlan"]; !slices.Equal(ids, []string{"vlan2"}) {
+		t.Errorf("unexpected ids of managed junos_vlan: got %v, want [vlan2]", ids)
+	}
+}
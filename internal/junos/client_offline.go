@@ -0,0 +1,169 @@
+package junos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"os"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/configtree"
+)
+
+// offlineConfig: configuration of device read from a local file with set lines
+// (output of `show configuration | display set`) to be used instead of a device.
+type offlineConfig struct {
+	file           string
+	filePermission int64
+	loaded         bool
+	tree           *configtree.Tree
+	mutex          sync.Mutex
+}
+
+func (clt *Client) WithOfflineConfigFile(file string) *Client {
+	clt.offline = &offlineConfig{
+		file:           file,
+		filePermission: clt.filePermission,
+	}
+
+	return clt
+}
+
+func (clt *Client) WithOfflineHardwareModel(model string) *Client {
+	clt.offlineHardwareModel = model
+
+	return clt
+}
+
+func (clt *Client) OfflineConfigFile() bool {
+	return clt.offline != nil
+}
+
+func (clt *Client) newOfflineSession(ctx context.Context) (*Session, error) {
+	clt.offline.mutex.Lock()
+	defer clt.offline.mutex.Unlock()
+
+	clt.offline.filePermission = clt.filePermission
+	if err := clt.offline.load(); err != nil {
+		return nil, err
+	}
+
+	sess := Session{
+		offline:       clt.offline,
+		logFile:       clt.logFile,
+		decodeSecrets: clt.decodeSecrets,
+		configMutex:   &clt.configMutex,
+	}
+	sess.SystemInformation.HardwareModel = clt.offlineHardwareModel
+	sess.SystemInformation.OSName = "junos"
+	sess.SystemInformation.HostName = "offline"
+	sess.detectPlatform()
+	sess.logFile("[newOfflineSession] session opened on file " + clt.offline.file)
+
+	return &sess, nil
+}
+
+// load reads set lines in file if not already done.
+//
+// A file that doesn't exist is an empty configuration.
+func (off *offlineConfig) load() error {
+	if off.loaded {
+		return nil
+	}
+	content, err := os.ReadFile(off.file)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			off.tree = configtree.New()
+			off.loaded = true
+
+			return nil
+		}
+
+		return fmt.Errorf("reading file '%s': %w", off.file, err)
+	}
+	off.tree = configtree.New()
+	for line := range strings.SplitSeq(string(content), "\n") {
+		line, ok := strings.CutPrefix(strings.TrimSpace(line), SetLS)
+		if !ok {
+			continue
+		}
+		off.tree.Set(configtree.SplitWords(line)...)
+	}
+	off.loaded = true
+
+	return nil
+}
+
+// save writes all set lines in file.
+func (off *offlineConfig) save() error {
+	dirFile := path.Dir(off.file)
+	if _, err := os.Stat(dirFile); err != nil {
+		if err := os.MkdirAll(dirFile, os.FileMode(directoryPermission)); err != nil {
+			return fmt.Errorf("creating parent directory of '%s': %w", off.file, err)
+		}
+	}
+
+	if err := os.WriteFile(off.file, []byte(off.tree.String()), os.FileMode(off.filePermission)); err != nil {
+		return fmt.Errorf("writing file '%s': %w", off.file, err)
+	}
+
+	return nil
+}
+
+// configSet applies set/delete lines to configuration and save it in file.
+func (off *offlineConfig) configSet(cmd []string) error {
+	off.mutex.Lock()
+	defer off.mutex.Unlock()
+
+	for _, v := range cmd {
+		if err := off.tree.Apply(html.UnescapeString(v)); err != nil {
+			return fmt.Errorf("applying lines on offline configuration: %w", err)
+		}
+	}
+
+	return off.save()
+}
+
+// configGet returns configuration with set lines.
+func (off *offlineConfig) configGet(format string) (string, error) {
+	if format != ConfigFormatSet {
+		return "", fmt.Errorf("format %q to get configuration not supported with offline configuration", format)
+	}
+	off.mutex.Lock()
+	defer off.mutex.Unlock()
+
+	return off.tree.String(), nil
+}
+
+// command answers to `show configuration ... | display set [relative]` commands
+// with the same output as a device.
+func (off *offlineConfig) command(cmd string) (string, error) {
+	hierarchy, ok := strings.CutPrefix(cmd, CmdShowConfig)
+	if !ok {
+		return "", fmt.Errorf("command %q not supported with offline configuration", cmd)
+	}
+	relative := false
+	switch {
+	case strings.HasSuffix(hierarchy, PipeDisplaySetRelative):
+		hierarchy = strings.TrimSuffix(hierarchy, PipeDisplaySetRelative)
+		relative = true
+	case strings.HasSuffix(hierarchy, PipeDisplaySet):
+		hierarchy = strings.TrimSuffix(hierarchy, PipeDisplaySet)
+	default:
+		return "", fmt.Errorf("command %q not supported with offline configuration", cmd)
+	}
+	prefix := configtree.SplitWords(hierarchy)
+
+	off.mutex.Lock()
+	defer off.mutex.Unlock()
+
+	output := off.tree.Render(relative, prefix...)
+	if output == "" {
+		return EmptyW, nil
+	}
+
+	return configtree.Frame(output), nil
+}
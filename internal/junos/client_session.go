@@ -9,32 +9,15 @@ import (
 )
 
 func (clt *Client) internalStartNewSession(ctx context.Context) (*Session, error) {
-	var auth sshAuthMethod
-	auth.Username = clt.junosUserName
-	auth.Ciphers = clt.junosSSHCiphers
-	if clt.junosSSHKeyPEM != "" {
-		auth.PrivateKeyPEM = clt.junosSSHKeyPEM
-		if clt.junosSSHKeyPass != "" {
-			auth.Passphrase = clt.junosSSHKeyPass
-		}
-	}
-	if clt.junosSSHKeyFile != "" {
-		auth.PrivateKeyFile = clt.junosSSHKeyFile
-		if clt.junosSSHKeyPass != "" {
-			auth.Passphrase = clt.junosSSHKeyPass
-		}
-	}
-	if clt.junosPassword != "" {
-		auth.Password = clt.junosPassword
-	}
-	auth.Timeout = clt.junosSSHTimeoutToEstab
+	auth := clt.sshAuth()
 	sess, err := netconfNewSession(
 		ctx,
 		net.JoinHostPort(clt.junosIP, strconv.Itoa(clt.junosPort)),
-		&auth,
+		auth,
 		&openSSHOptions{
-			Retry:   clt.junosSSHRetryToEstab,
-			Timeout: clt.junosSSHTimeoutToEstab,
+			Retry:      clt.junosSSHRetryToEstab,
+			Timeout:    clt.junosSSHTimeoutToEstab,
+			RPCTimeout: clt.rpcTimeout,
 		},
 	)
 	if err != nil {
@@ -58,21 +41,49 @@ func (clt *Client) internalStartNewSession(ctx context.Context) (*Session, error
 	sess.sleepLock = clt.sleepLock
 	sess.sleepShort = clt.sleepShort
 	sess.sleepSSHClosed = clt.sleepSSHClosed
-	if clt.fakeCreateSetFile != "" {
-		sess.fakeSetFile = clt.appendFakeCreateSetFile
-	}
+	sess.configMutex = &clt.configMutex
+	sess.retryPolicy = clt.retryPolicy
 	if sess.SystemInformation.HardwareModel == "" {
 		_ = sess.closeNetconf(sess.sleepSSHClosed)
 
 		return nil, errors.New("can't read model of device with <get-system-information/> netconf command")
 	}
+	clt.detectSessionPlatform(ctx, sess)
 	sess.client = clt
 	sess.logFile("[internalStartNewSession] session opened")
 
 	return sess, nil
 }
 
+// sshAuth returns the authentication methods to connect to device.
+func (clt *Client) sshAuth() *sshAuthMethod {
+	auth := sshAuthMethod{}
+	auth.Username = clt.junosUserName
+	auth.Ciphers = clt.junosSSHCiphers
+	if clt.junosSSHKeyPEM != "" {
+		auth.PrivateKeyPEM = clt.junosSSHKeyPEM
+		if clt.junosSSHKeyPass != "" {
+			auth.Passphrase = clt.junosSSHKeyPass
+		}
+	}
+	if clt.junosSSHKeyFile != "" {
+		auth.PrivateKeyFile = clt.junosSSHKeyFile
+		if clt.junosSSHKeyPass != "" {
+			auth.Passphrase = clt.junosSSHKeyPass
+		}
+	}
+	if clt.junosPassword != "" {
+		auth.Password = clt.junosPassword
+	}
+	auth.Timeout = clt.junosSSHTimeoutToEstab
+
+	return &auth
+}
+
 func (clt *Client) StartNewSession(ctx context.Context) (*Session, error) {
+	if clt.offline != nil {
+		return clt.newOfflineSession(ctx)
+	}
 	if clt.useSingleSession {
 		clt.sessionMutex.Lock()
 		if clt.sharedSession != nil {
@@ -93,13 +104,16 @@ func (clt *Client) StartNewSession(ctx context.Context) (*Session, error) {
 	return clt.internalStartNewSession(ctx)
 }
 
+// NewSessionWithoutNetconf returns a session without connection to device
+// where the set/delete lines are kept to be written in the fake set file with WriteFakeSetFile.
 func (clt *Client) NewSessionWithoutNetconf(_ context.Context) *Session {
 	sess := Session{
 		logFile:       clt.logFile,
 		decodeSecrets: clt.decodeSecrets,
 	}
 	if clt.fakeCreateSetFile != "" {
-		sess.fakeSetFile = clt.appendFakeCreateSetFile
+		sess.fakeSetFile = clt.appendFakeSetFile
+		sess.fakeSetLines = new([]string)
 	}
 
 	return &sess
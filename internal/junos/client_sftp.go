@@ -0,0 +1,181 @@
+package junos
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net"
+	"os"
+	"strconv"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+)
+
+// SFTPClient: client to transfer files with the SFTP subsystem on device.
+type SFTPClient struct {
+	ssh     *ssh.Client
+	sftp    *sftp.Client
+	logFile func(string)
+}
+
+// NewSFTPClient opens a SSH connection to device, with the same parameters as the netconf sessions,
+// and starts the SFTP subsystem on it.
+//
+// The client need to be closed with Close.
+func (clt *Client) NewSFTPClient(ctx context.Context) (*SFTPClient, error) {
+	if clt.offline != nil {
+		return nil, errors.New("file transfer not supported with offline configuration")
+	}
+	clientConfig, err := genSSHClientConfig(clt.sshAuth())
+	if err != nil {
+		return nil, err
+	}
+	host := net.JoinHostPort(clt.junosIP, strconv.Itoa(clt.junosPort))
+	sftpClt := SFTPClient{
+		logFile: clt.logFile,
+	}
+	err = sshConnectWithRetry(ctx, host,
+		&sshOptions{
+			openSSHOptions: &openSSHOptions{
+				Retry:   clt.junosSSHRetryToEstab,
+				Timeout: clt.junosSSHTimeoutToEstab,
+			},
+			ClientConfig: clientConfig,
+		},
+		func(conn net.Conn) (bool, error) {
+			sshConn, chans, reqs, err := ssh.NewClientConn(conn, host, clientConfig)
+			if err != nil {
+				return false, err
+			}
+			sftpClt.ssh = ssh.NewClient(sshConn, chans, reqs)
+			sftpClt.sftp, err = sftp.NewClient(sftpClt.ssh, sftp.UseConcurrentWrites(true))
+			if err != nil {
+				_ = sftpClt.ssh.Close()
+
+				return true, fmt.Errorf("starting SFTP subsystem on %s: %w", host, err)
+			}
+
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	sftpClt.logFile("[NewSFTPClient] SFTP session opened to " + host)
+
+	return &sftpClt, nil
+}
+
+// Close closes the SFTP subsystem and the SSH connection.
+func (sftpClt *SFTPClient) Close() error {
+	err := sftpClt.sftp.Close()
+	if errSSH := sftpClt.ssh.Close(); errSSH != nil && err == nil {
+		err = errSSH
+	}
+	sftpClt.logFile("[SFTPClient.Close] SFTP session closed")
+
+	return err
+}
+
+// Upload copies the local file to the path on device,
+// sets its permissions if perm is not 0 and returns the SHA-256 checksum (in hex) of data copied.
+func (sftpClt *SFTPClient) Upload(ctx context.Context, localPath, remotePath string, perm os.FileMode) (string, error) {
+	src, err := os.Open(localPath)
+	if err != nil {
+		return "", fmt.Errorf("opening local file: %w", err)
+	}
+	defer src.Close()
+	dst, err := sftpClt.sftp.Create(remotePath)
+	if err != nil {
+		return "", fmt.Errorf("creating file %q on device: %w", remotePath, err)
+	}
+	defer dst.Close()
+
+	checksum := sha256.New()
+	size, err := dst.ReadFrom(io.TeeReader(&contextReader{ctx: ctx, r: src}, checksum))
+	if err != nil {
+		return "", fmt.Errorf("copying %q to %q on device: %w", localPath, remotePath, err)
+	}
+	if perm != 0 {
+		if err := dst.Chmod(perm); err != nil {
+			return "", fmt.Errorf("setting permissions of file %q on device: %w", remotePath, err)
+		}
+	}
+	if err := dst.Close(); err != nil {
+		return "", fmt.Errorf("closing file %q on device: %w", remotePath, err)
+	}
+	sftpClt.logFile(fmt.Sprintf("[SFTPClient.Upload] %q to %q: %d bytes", localPath, remotePath, size))
+
+	return hexSum(checksum), nil
+}
+
+// Download copies the file on device to the local path,
+// sets its permissions to perm (0644 if 0) and returns the SHA-256 checksum (in hex) of data copied.
+func (sftpClt *SFTPClient) Download(ctx context.Context, remotePath, localPath string, perm os.FileMode) (string, error) {
+	src, err := sftpClt.sftp.Open(remotePath)
+	if err != nil {
+		return "", fmt.Errorf("opening file %q on device: %w", remotePath, err)
+	}
+	defer src.Close()
+	if perm == 0 {
+		perm = 0o644
+	}
+	dst, err := os.OpenFile(localPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return "", fmt.Errorf("creating local file: %w", err)
+	}
+	defer dst.Close()
+
+	checksum := sha256.New()
+	size, err := src.WriteTo(&contextWriter{ctx: ctx, w: io.MultiWriter(dst, checksum)})
+	if err != nil {
+		return "", fmt.Errorf("copying %q on device to %q: %w", remotePath, localPath, err)
+	}
+	// permissions of created file are restricted by umask
+	if err := dst.Chmod(perm); err != nil {
+		return "", fmt.Errorf("setting permissions of local file: %w", err)
+	}
+	if err := dst.Close(); err != nil {
+		return "", fmt.Errorf("closing local file: %w", err)
+	}
+	sftpClt.logFile(fmt.Sprintf("[SFTPClient.Download] %q to %q: %d bytes", remotePath, localPath, size))
+
+	return hexSum(checksum), nil
+}
+
+// contextReader: reader that stops when context is done.
+type contextReader struct {
+	ctx context.Context //nolint:containedctx
+	r   io.Reader
+}
+
+func (cr *contextReader) Read(p []byte) (int, error) {
+	if err := cr.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	return cr.r.Read(p)
+}
+
+// contextWriter: writer that stops when context is done.
+type contextWriter struct {
+	ctx context.Context //nolint:containedctx
+	w   io.Writer
+}
+
+func (cw *contextWriter) Write(p []byte) (int, error) {
+	if err := cw.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	return cw.w.Write(p)
+}
+
+func hexSum(h hash.Hash) string {
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,151 @@
+package junos
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// readOnlyPipes: pipe commands allowed after a read-only command
+// (without `save`, `request`, `compare rollback` with a write, ...).
+//
+//nolint:gochecknoglobals
+var readOnlyPipes = []string{
+	"count",
+	"display",
+	"except",
+	"find",
+	"last",
+	"match",
+	"no-more",
+	"resolve",
+	"trim",
+}
+
+// readOnlyRPCs: RPCs (name of XML element) allowed as read-only RPCs.
+//
+// The RPCs are explicitly listed instead of accepting all RPCs with a name starting with `get-`:
+// the prefix is only a naming convention of Junos and doesn't guarantee
+// that the RPC doesn't change anything on device.
+//
+//nolint:gochecknoglobals
+var readOnlyRPCs = []string{
+	"get-alarm-information",
+	"get-arp-table-information",
+	"get-bfd-session-information",
+	"get-bgp-neighbor-information",
+	"get-bgp-summary-information",
+	"get-chassis-cluster-status",
+	"get-chassis-inventory",
+	"get-environment-information",
+	"get-ethernet-switching-table-information",
+	"get-firewall-filter-information",
+	"get-flow-session-information",
+	"get-forwarding-table-information",
+	"get-ike-security-associations-information",
+	"get-interface-information",
+	"get-isis-adjacency-information",
+	"get-lacp-interface-information",
+	"get-lldp-neighbors-information",
+	"get-ospf-interface-information",
+	"get-ospf-neighbor-information",
+	"get-route-engine-information",
+	"get-route-information",
+	"get-route-summary-information",
+	"get-security-associations-information",
+	"get-software-information",
+	"get-system-alarm-information",
+	"get-system-information",
+	"get-system-storage",
+	"get-system-uptime-information",
+	"get-system-users-information",
+	"get-virtual-chassis-information",
+	"get-vlan-information",
+	"get-zones-information",
+}
+
+// ReadOnlyRPCs returns the RPCs (name of XML element) accepted by CheckReadOnlyRPC.
+func ReadOnlyRPCs() []string {
+	return slices.Clone(readOnlyRPCs)
+}
+
+// CheckReadOnlyCommand checks that the operational command (CLI) can't change anything on device:
+// a `show` command with only pipes to filter or display the output.
+func CheckReadOnlyCommand(cmd string) error {
+	parts := splitPipes(cmd)
+	if words := strings.Fields(parts[0]); len(words) == 0 || words[0] != "show" {
+		return fmt.Errorf("command %q is not a read-only command, need to be a `show` command", cmd)
+	}
+	for _, pipe := range parts[1:] {
+		words := strings.Fields(pipe)
+		if len(words) == 0 || !slices.Contains(readOnlyPipes, words[0]) {
+			return fmt.Errorf("pipe %q in command %q is not allowed, need to be one of %s",
+				strings.TrimSpace(pipe), cmd, strings.Join(readOnlyPipes, ", "))
+		}
+	}
+
+	return nil
+}
+
+// CheckReadOnlyRPC checks that the RPC can't change anything on device:
+// a unique XML element with a name in the list of read-only RPCs (see ReadOnlyRPCs).
+func CheckReadOnlyRPC(rpc string) error {
+	decoder := xml.NewDecoder(strings.NewReader(rpc))
+	var name string
+	depth := 0
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("bad RPC %q, need to be a XML element: %w", rpc, err)
+		}
+		switch v := token.(type) {
+		case xml.StartElement:
+			if depth == 0 {
+				if name != "" {
+					return fmt.Errorf("bad RPC %q, need to be a unique XML element", rpc)
+				}
+				name = v.Name.Local
+			}
+			depth++
+		case xml.EndElement:
+			depth--
+		case xml.CharData:
+			if depth == 0 && strings.TrimSpace(string(v)) != "" {
+				return fmt.Errorf("bad RPC %q, need to be a XML element", rpc)
+			}
+		}
+	}
+	if name == "" {
+		return fmt.Errorf("bad RPC %q, need to be a XML element", rpc)
+	}
+	if !slices.Contains(readOnlyRPCs, name) {
+		return fmt.Errorf("RPC %q is not a read-only RPC, need to be one of %s",
+			name, strings.Join(readOnlyRPCs, ", "))
+	}
+
+	return nil
+}
+
+// splitPipes splits the command on each pipe not in a quoted string.
+func splitPipes(cmd string) []string {
+	parts := make([]string, 0, 1)
+	start := 0
+	quoted := false
+	for i, r := range cmd {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case r == '|' && !quoted:
+			parts = append(parts, cmd[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(parts, cmd[start:])
+}
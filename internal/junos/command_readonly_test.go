@@ -0,0 +1,119 @@
+package junos_test
+
+import (
+	"testing"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+)
+
+func TestCheckReadOnlyCommand(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		cmd   string
+		valid bool
+	}
+
+	tests := map[string]testCase{
+		"show": {
+			cmd:   "show lldp neighbors",
+			valid: true,
+		},
+		"show_with_pipes": {
+			cmd:   "show log messages | match \"a|b\" | except save | no-more",
+			valid: true,
+		},
+		"request": {
+			cmd:   "request system reboot",
+			valid: false,
+		},
+		"clear": {
+			cmd:   "clear bgp neighbor",
+			valid: false,
+		},
+		"pipe_save": {
+			cmd:   "show configuration | save /var/tmp/config.txt",
+			valid: false,
+		},
+		"empty_pipe": {
+			cmd:   "show version |",
+			valid: false,
+		},
+		"empty": {
+			cmd:   " ",
+			valid: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := junos.CheckReadOnlyCommand(test.cmd)
+			if test.valid && err != nil {
+				t.Errorf("got unexpected error: %s", err)
+			}
+			if !test.valid && err == nil {
+				t.Errorf("expected error with %q", test.cmd)
+			}
+		})
+	}
+}
+
+func TestCheckReadOnlyRPC(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		rpc   string
+		valid bool
+	}
+
+	tests := map[string]testCase{
+		"get": {
+			rpc:   "<get-lldp-neighbors-information/>",
+			valid: true,
+		},
+		"get_with_args": {
+			rpc:   "<get-interface-information><terse/><interface-name>ge-0/0/0</interface-name></get-interface-information>",
+			valid: true,
+		},
+		"get_not_in_list": {
+			rpc:   "<get-support-information/>",
+			valid: false,
+		},
+		"not_get": {
+			rpc:   "<request-reboot/>",
+			valid: false,
+		},
+		"load": {
+			rpc:   "<load-configuration action=\"set\"/>",
+			valid: false,
+		},
+		"multiple": {
+			rpc:   "<get-software-information/><request-reboot/>",
+			valid: false,
+		},
+		"not_xml": {
+			rpc:   "get-software-information",
+			valid: false,
+		},
+		"bad_xml": {
+			rpc:   "<get-software-information>",
+			valid: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := junos.CheckReadOnlyRPC(test.rpc)
+			if test.valid && err != nil {
+				t.Errorf("got unexpected error: %s", err)
+			}
+			if !test.valid && err == nil {
+				t.Errorf("expected error with %q", test.rpc)
+			}
+		})
+	}
+}
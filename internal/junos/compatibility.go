@@ -1,6 +1,13 @@
 package junos
 
-import "strings"
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+)
 
 func (sess *Session) CheckCompatibilityChassisCluster() bool {
 	if strings.HasPrefix(strings.ToLower(sess.SystemInformation.HardwareModel), "srx") {
@@ -40,3 +47,139 @@ func (sess *Session) CheckCompatibilitySecurity() bool {
 
 	return false
 }
+
+// Features with requirements in compatibility registry.
+const (
+	FeatureSecurityPolicyMatchDynamicApplication = "security-policy-match-dynamic-application"
+	FeatureSwitchingELS                          = "switching-els"
+	FeatureSwitchingNonELS                       = "switching-non-els"
+)
+
+// Compatibility: requirements of a feature on a Junos device.
+//
+// Empty Platforms, SwitchingStyle, MinOSVersion or MaxOSVersion means no requirement.
+type Compatibility struct {
+	Description    string
+	Platforms      []string
+	SwitchingStyle string
+	MinOSVersion   string
+	MaxOSVersion   string
+}
+
+//nolint:gochecknoglobals
+var compatibilityRegistry = map[string]Compatibility{
+	FeatureSecurityPolicyMatchDynamicApplication: {
+		Description:  "match dynamic-application in security policies (unified policies)",
+		Platforms:    []string{PlatformSRX},
+		MinOSVersion: "18.2R1",
+	},
+	FeatureSwitchingELS: {
+		Description:    "Enhanced Layer 2 Software (ELS) configuration style",
+		SwitchingStyle: SwitchingELS,
+	},
+	FeatureSwitchingNonELS: {
+		Description:    "non-ELS (legacy) configuration style for switching",
+		SwitchingStyle: SwitchingNonELS,
+	},
+}
+
+// LookupCompatibility returns the requirements of feature in compatibility registry.
+func LookupCompatibility(feature string) (Compatibility, bool) {
+	compat, ok := compatibilityRegistry[feature]
+
+	return compat, ok
+}
+
+// CheckFeature checks that the device respects the requirements of feature in compatibility registry.
+func (sess *Session) CheckFeature(feature string) error {
+	compat, ok := LookupCompatibility(feature)
+	if !ok {
+		return fmt.Errorf("internal error: feature %q not found in compatibility registry", feature)
+	}
+
+	return sess.CheckCompatibility(compat)
+}
+
+// CheckCompatibility checks that the device respects the requirements of compat.
+//
+// Requirements can't be checked without hardware model or OS version of device
+// (like with a fake set file) and are considered as respected.
+func (sess *Session) CheckCompatibility(compat Compatibility) error {
+	if len(compat.Platforms) > 0 && sess.SystemInformation.HardwareModel != "" {
+		if family, _ := platformFamily(sess.SystemInformation.HardwareModel); !slices.Contains(compat.Platforms, family) {
+			return fmt.Errorf("%s not compatible with Junos device %q (need a device in platform family %s)",
+				compat.Description, sess.SystemInformation.HardwareModel, strings.Join(compat.Platforms, " or "))
+		}
+	}
+	if compat.SwitchingStyle != "" && sess.Platform.SwitchingStyle != "" &&
+		compat.SwitchingStyle != sess.Platform.SwitchingStyle {
+		return fmt.Errorf("%s not compatible with Junos device %q (device with %s switching style)",
+			compat.Description, sess.SystemInformation.HardwareModel, sess.Platform.SwitchingStyle)
+	}
+	if sess.SystemInformation.OSVersion == "" || (compat.MinOSVersion == "" && compat.MaxOSVersion == "") {
+		return nil
+	}
+	osVersion, err := ParseOSVersion(sess.SystemInformation.OSVersion)
+	if err != nil {
+		return fmt.Errorf("checking compatibility of %s: %w", compat.Description, err)
+	}
+	if compat.MinOSVersion != "" {
+		minVersion, err := ParseOSVersion(compat.MinOSVersion)
+		if err != nil {
+			return fmt.Errorf("internal error: checking compatibility of %s: %w", compat.Description, err)
+		}
+		if osVersion.LessThan(minVersion) {
+			return fmt.Errorf("%s not compatible with Junos version %q (need %s or later)",
+				compat.Description, sess.SystemInformation.OSVersion, compat.MinOSVersion)
+		}
+	}
+	if compat.MaxOSVersion != "" {
+		maxVersion, err := ParseOSVersion(compat.MaxOSVersion)
+		if err != nil {
+			return fmt.Errorf("internal error: checking compatibility of %s: %w", compat.Description, err)
+		}
+		if osVersion.GreaterThan(maxVersion) {
+			return fmt.Errorf("%s not compatible with Junos version %q (need %s or earlier)",
+				compat.Description, sess.SystemInformation.OSVersion, compat.MaxOSVersion)
+		}
+	}
+
+	return nil
+}
+
+//nolint:gochecknoglobals
+var osVersionRegexp = regexp.MustCompile(`^(\d+)\.(\d+)(?:([A-Z]+)(\d+)?)?(?:-S(\d+))?(?:\.(\d+))?`)
+
+// ParseOSVersion parses a Junos version (like 21.4R3-S5.4 or 15.1X49-D170.4)
+// in a comparable version: major.minor.release.service-release.build
+// (type of release and letters are ignored).
+func ParseOSVersion(osVersion string) (*version.Version, error) {
+	matches := osVersionRegexp.FindStringSubmatch(osVersion)
+	if matches == nil {
+		return nil, fmt.Errorf("unable to parse Junos version %q", osVersion)
+	}
+	segments := []string{matches[1], matches[2], "0", "0", "0"}
+	if matches[4] != "" {
+		segments[2] = matches[4]
+	}
+	if matches[5] != "" {
+		segments[3] = matches[5]
+	}
+	if matches[6] != "" {
+		segments[4] = matches[6]
+	}
+	// X releases (like 15.1X49-D170): the number after X is the release
+	// and the number after -D is the service release
+	if matches[3] == "X" {
+		if _, afterD, ok := strings.Cut(osVersion, "-D"); ok {
+			segments[3], _, _ = strings.Cut(afterD, ".")
+		}
+	}
+
+	v, err := version.NewVersion(strings.Join(segments, "."))
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse Junos version %q: %w", osVersion, err)
+	}
+
+	return v, nil
+}
@@ -0,0 +1,123 @@
+package junos_test
+
+import (
+	"testing"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+)
+
+func TestParseOSVersion(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		osVersion   string
+		expectValue string
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"release": {
+			osVersion:   "23.4R1.9",
+			expectValue: "23.4.1.0.9",
+		},
+		"service_release": {
+			osVersion:   "21.4R3-S5.4",
+			expectValue: "21.4.3.5.4",
+		},
+		"x_release": {
+			osVersion:   "15.1X49-D170.4",
+			expectValue: "15.1.49.170.0",
+		},
+		"without_build": {
+			osVersion:   "18.2R1",
+			expectValue: "18.2.1.0.0",
+		},
+		"evo": {
+			osVersion:   "22.4R3-S2.11-EVO",
+			expectValue: "22.4.3.2.11",
+		},
+		"invalid": {
+			osVersion:   "junos",
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			v, err := junos.ParseOSVersion(tc.osVersion)
+			if tc.expectError {
+				if err == nil {
+					t.Errorf("got unexpected no error with %q", tc.osVersion)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+			if v.String() != tc.expectValue {
+				t.Errorf("got unexpected value %q, want %q", v.String(), tc.expectValue)
+			}
+		})
+	}
+}
+
+func TestSessionCheckCompatibility(t *testing.T) {
+	t.Parallel()
+
+	compat := junos.Compatibility{
+		Description:  "feature",
+		Platforms:    []string{junos.PlatformSRX},
+		MinOSVersion: "18.2R1",
+		MaxOSVersion: "23.4R1",
+	}
+	type testCase struct {
+		hardwareModel string
+		osVersion     string
+		expectError   bool
+	}
+	tests := map[string]testCase{
+		"compatible": {
+			hardwareModel: "vsrx",
+			osVersion:     "20.4R3-S1.3",
+		},
+		"min_version": {
+			hardwareModel: "srx345",
+			osVersion:     "18.2R1.9",
+		},
+		"too_old": {
+			hardwareModel: "srx345",
+			osVersion:     "15.1X49-D170.4",
+			expectError:   true,
+		},
+		"too_recent": {
+			hardwareModel: "srx345",
+			osVersion:     "24.2R1.17",
+			expectError:   true,
+		},
+		"other_platform": {
+			hardwareModel: "mx240",
+			osVersion:     "20.4R3-S1.3",
+			expectError:   true,
+		},
+		"unknown_device": {},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			sess := &junos.Session{}
+			sess.SystemInformation.HardwareModel = tc.hardwareModel
+			sess.SystemInformation.OSVersion = tc.osVersion
+			err := sess.CheckCompatibility(compat)
+			if tc.expectError && err == nil {
+				t.Errorf("got unexpected no error")
+			}
+			if !tc.expectError && err != nil {
+				t.Errorf("got unexpected error: %s", err)
+			}
+		})
+	}
+}
@@ -62,12 +62,26 @@ const (
 	EnvSleepSSHClosed             = "JUNOS_SLEEP_SSH_CLOSED"
 	EnvSSHTimeoutToEstablish      = "JUNOS_SSH_TIMEOUT_TO_ESTABLISH"
 	EnvSSHRetryToEstablish        = "JUNOS_SSH_RETRY_TO_ESTABLISH"
+	EnvRPCTimeout                 = "JUNOS_RPC_TIMEOUT"
+	EnvRetryMaxElapsedTime        = "JUNOS_RETRY_MAX_ELAPSED_TIME"
+	EnvRetryInitialInterval       = "JUNOS_RETRY_INITIAL_INTERVAL"
+	EnvRetryMaxInterval           = "JUNOS_RETRY_MAX_INTERVAL"
+	EnvRetryErrors                = "JUNOS_RETRY_ERRORS"
 	EnvFilePermission             = "JUNOS_FILE_PERMISSION"
 	EnvLogPath                    = "JUNOS_LOG_PATH"
 	EnvFakecreateSetfile          = "JUNOS_FAKECREATE_SETFILE"
 	EnvFakeupdateAlso             = "JUNOS_FAKEUPDATE_ALSO"
 	EnvFakedeleteAlso             = "JUNOS_FAKEDELETE_ALSO"
+	EnvFakesetfileHeader          = "JUNOS_FAKESETFILE_HEADER"
+	EnvFakesetfileXML             = "JUNOS_FAKESETFILE_XML"
+	EnvFakesetfileText            = "JUNOS_FAKESETFILE_TEXT"
 	EnvUseSingleSession           = "JUNOS_USE_SINGLE_SESSION"
+	EnvOfflineConfigFile          = "JUNOS_OFFLINE_CONFIG_FILE"
+	EnvOfflineHardwareModel       = "JUNOS_OFFLINE_HARDWARE_MODEL"
+	EnvPlanCheckReferences        = "JUNOS_PLAN_CHECK_REFERENCES"
+
+	PlanCheckReferencesWarning = "warning"
+	PlanCheckReferencesError   = "error"
 
 	DefaultInterfaceTestAcc        = "ge-0/0/3"
 	DefaultInterfaceTestAcc2       = "ge-0/0/4"
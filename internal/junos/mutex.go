@@ -1,13 +1,34 @@
 package junos
 
-import "sync"
+// ReadLock locks the configuration of device for a read-only operation.
+//
+// The read-only operations with the same client run concurrently
+// but wait the end of a configuration change (between ConfigLock and ConfigUnlock).
+func (sess *Session) ReadLock() {
+	if sess.configMutex != nil {
+		sess.configMutex.RLock()
+	}
+}
 
-var mutex = &sync.Mutex{} //nolint:gochecknoglobals
+// ReadUnlock unlocks the configuration of device locked by ReadLock.
+func (sess *Session) ReadUnlock() {
+	if sess.configMutex != nil {
+		sess.configMutex.RUnlock()
+	}
+}
 
-func MutexLock() {
-	mutex.Lock()
+// writeLock locks exclusively the configuration of device for a configuration change.
+func (sess *Session) writeLock() {
+	if sess.configMutex != nil && !sess.writeLocked {
+		sess.configMutex.Lock()
+		sess.writeLocked = true
+	}
 }
 
-func MutexUnlock() {
-	mutex.Unlock()
+// writeUnlock unlocks the configuration of device locked by writeLock.
+func (sess *Session) writeUnlock() {
+	if sess.configMutex != nil && sess.writeLocked {
+		sess.writeLocked = false
+		sess.configMutex.Unlock()
+	}
 }
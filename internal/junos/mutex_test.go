@@ -0,0 +1,73 @@
+package junos_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+)
+
+func TestSessionReadLock(t *testing.T) {
+	t.Parallel()
+
+	client := junos.NewClient("").WithOfflineConfigFile(filepath.Join(t.TempDir(), "config.txt"))
+	writeSess, err := client.StartNewSession(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer writeSess.Close()
+	readSess, err := client.StartNewSession(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer readSess.Close()
+	otherClient := junos.NewClient("").WithOfflineConfigFile(filepath.Join(t.TempDir(), "config.txt"))
+	otherSess, err := otherClient.StartNewSession(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer otherSess.Close()
+
+	readLocked := func(sess *junos.Session) <-chan struct{} {
+		locked := make(chan struct{})
+		go func() {
+			sess.ReadLock()
+			close(locked)
+			sess.ReadUnlock()
+		}()
+
+		return locked
+	}
+
+	// read-only operations run concurrently
+	writeSess.ReadLock()
+	select {
+	case <-readLocked(readSess):
+	case <-time.After(time.Second):
+		t.Fatalf("read lock blocked by another read lock")
+	}
+	writeSess.ReadUnlock()
+
+	// configuration change is exclusive for the client only
+	if err := writeSess.ConfigLock(t.Context()); err != nil {
+		t.Fatalf("unexpected lock error: %s", err)
+	}
+	select {
+	case <-readLocked(otherSess):
+	case <-time.After(time.Second):
+		t.Fatalf("read lock blocked by a configuration change of another client")
+	}
+	locked := readLocked(readSess)
+	select {
+	case <-locked:
+		t.Fatalf("read lock not blocked by a configuration change")
+	case <-time.After(100 * time.Millisecond):
+	}
+	_ = writeSess.ConfigUnlock(t.Context())
+	select {
+	case <-locked:
+	case <-time.After(time.Second):
+		t.Fatalf("read lock blocked after the end of configuration change")
+	}
+}
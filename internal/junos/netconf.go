@@ -5,9 +5,11 @@ import (
 	"encoding/xml"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/jeremmfr/terraform-provider-junos/internal/configtree"
 	"github.com/jeremmfr/terraform-provider-junos/internal/utils"
 
 	"github.com/jeremmfr/go-netconf/netconf"
@@ -20,46 +22,132 @@ const (
 	XMLEndTagConfigOut   = "</configuration-output>"
 )
 
+// errNetconfAborted: error of RPCs sent on a netconf session with the transport closed
+// by an aborted RPC (see netconfExec).
+var errNetconfAborted = errors.New("netconf session aborted by a previous RPC")
+
+// netconfExec executes the RPC methods on device and waits the reply
+// until ctx is done or the RPC timeout of session (if set) is reached.
+//
+// When the reply is not received in time, the transport is closed to abort the RPC
+// and the netconf session can't be used anymore.
+func (sess *Session) netconfExec(
+	ctx context.Context, methods ...netconf.RPCMethod,
+) (
+	*netconf.RPCReply, error,
+) {
+	if sess.netconfAborted {
+		return nil, errNetconfAborted
+	}
+	if sess.rpcTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, sess.rpcTimeout)
+		defer cancel()
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("RPC not sent: %w", err)
+	}
+
+	type execResult struct {
+		reply *netconf.RPCReply
+		err   error
+	}
+	result := make(chan execResult, 1)
+	go func() {
+		reply, err := netconfExecWithReply(sess.netconf, methods...)
+		result <- execResult{reply: reply, err: err}
+	}()
+
+	select {
+	case r := <-result:
+		return r.reply, r.err
+	case <-ctx.Done():
+		sess.netconfAborted = true
+		_ = sess.netconf.Transport.Close()
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) && sess.rpcTimeout > 0 {
+			return nil, fmt.Errorf("RPC aborted, no reply after %s (rpc_timeout): %w", sess.rpcTimeout, ctx.Err())
+		}
+
+		return nil, fmt.Errorf("RPC aborted: %w", ctx.Err())
+	}
+}
+
+// netconfExecWithReply executes the RPC methods like netconf.Session.Exec
+// but returns the reply with all rpc-errors also when the first rpc-error with severity error
+// is returned as error (Exec doesn't return the reply in this case).
+func netconfExecWithReply(
+	session *netconf.Session, methods ...netconf.RPCMethod,
+) (
+	*netconf.RPCReply, error,
+) {
+	rpc := netconf.NewRPCMessage(methods)
+	request, err := xml.Marshal(rpc)
+	if err != nil {
+		return nil, err
+	}
+	if err := session.Transport.Send(append([]byte(xml.Header), request...)); err != nil {
+		return nil, err
+	}
+	rawXML, err := session.Transport.Receive()
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &netconf.RPCReply{RawReply: string(rawXML)}
+	if err := xml.Unmarshal(rawXML, reply); err != nil {
+		return nil, err
+	}
+	reply.MessageID = rpc.MessageID
+	for i, rpcErr := range reply.Errors {
+		if rpcErr.Severity == errorSeverity || session.ErrOnWarning {
+			return reply, &reply.Errors[i]
+		}
+	}
+
+	return reply, nil
+}
+
 // gatherFacts gathers basic information about the device.
-func (sess *Session) gatherFacts() error {
+func (sess *Session) gatherFacts(ctx context.Context) error {
 	// Get info for get-system-information and populate SystemInformation Struct
-	val, err := sess.netconf.Exec(netconf.RawMethod(rpcGetSystemInformation))
+	val, err := sess.netconfExec(ctx, netconf.RawMethod(rpcGetSystemInformation))
 	if err != nil {
 		return fmt.Errorf("executing netconf get-system-information: %w", err)
 	}
 
 	if len(val.Errors) > 0 {
-		errs := make([]string, len(val.Errors))
-		for i, m := range val.Errors {
-			errs[i] = m.Error()
+		errs := make([]error, len(val.Errors))
+		for i := range val.Errors {
+			errs[i] = &val.Errors[i]
 		}
 
-		return errors.New(strings.Join(errs, "\n"))
+		return errors.Join(errs...)
 	}
 	var reply rpcGetSystemInformationReply
 	if err := xml.Unmarshal([]byte(val.RawReply), &reply); err != nil {
 		return fmt.Errorf("unmarshaling xml reply %q of get-system-information: %w", val.RawReply, err)
 	}
 	sess.SystemInformation = reply.SystemInformation
+	sess.detectPlatform()
 
 	return nil
 }
 
 // netconfCommand (show, execute) on Junos device.
-func (sess *Session) netconfCommand(cmd string) (string, error) {
+func (sess *Session) netconfCommand(ctx context.Context, cmd string) (string, error) {
 	command := fmt.Sprintf(rpcCommandText, cmd)
-	reply, err := sess.netconf.Exec(netconf.RawMethod(command))
+	reply, err := sess.netconfExec(ctx, netconf.RawMethod(command))
 	if err != nil {
 		return "", fmt.Errorf("executing netconf command: %w", err)
 	}
 
 	if len(reply.Errors) > 0 {
-		errs := make([]string, len(reply.Errors))
-		for i, m := range reply.Errors {
-			errs[i] = m.Error()
+		errs := make([]error, len(reply.Errors))
+		for i := range reply.Errors {
+			errs[i] = &reply.Errors[i]
 		}
 
-		return "", errors.New(strings.Join(errs, "\n"))
+		return "", errors.Join(errs...)
 	}
 
 	if reply.Data == "" || strings.Count(reply.Data, "") <= 2 {
@@ -73,43 +161,117 @@ func (sess *Session) netconfCommand(cmd string) (string, error) {
 	return output.Config, nil
 }
 
-func (sess *Session) netconfCommandXML(cmd string) (string, error) {
-	reply, err := sess.netconf.Exec(netconf.RawMethod(cmd))
+func (sess *Session) netconfCommandXML(ctx context.Context, cmd string) (string, error) {
+	reply, err := sess.netconfExec(ctx, netconf.RawMethod(cmd))
 	if err != nil {
 		return "", fmt.Errorf("executing netconf xml command: %w", err)
 	}
 
 	if len(reply.Errors) > 0 {
-		errs := make([]string, len(reply.Errors))
-		for i, m := range reply.Errors {
-			errs[i] = m.Error()
+		errs := make([]error, len(reply.Errors))
+		for i := range reply.Errors {
+			errs[i] = &reply.Errors[i]
 		}
 
-		return "", errors.New(strings.Join(errs, "\n"))
+		return "", errors.Join(errs...)
 	}
 
 	return reply.Data, nil
 }
 
-func (sess *Session) netconfConfigSet(cmd []string) (string, error) {
-	command := fmt.Sprintf(rpcLoadConfigSetText, strings.Join(cmd, "\n"))
-	reply, err := sess.netconf.Exec(netconf.RawMethod(command))
-	if err != nil {
-		return "", fmt.Errorf("executing netconf apply of set/delete command: %w", err)
+// netconfCommandFormat sends the operational command (CLI) or the RPC (with rpc)
+// and returns the output in format (text, xml or json).
+func (sess *Session) netconfCommandFormat(ctx context.Context, cmd, format string, rpc bool) (string, error) {
+	var method string
+	switch {
+	case rpc && format == ConfigFormatXML:
+		method = cmd
+	case rpc:
+		var err error
+		if method, err = rpcWithFormat(cmd, format); err != nil {
+			return "", err
+		}
+	default:
+		var escaped strings.Builder
+		if err := xml.EscapeText(&escaped, []byte(cmd)); err != nil {
+			return "", fmt.Errorf("escaping command %q: %w", cmd, err)
+		}
+		method = fmt.Sprintf(rpcCommandFormat, format, escaped.String())
+	}
+	reply, err := sess.netconfExec(ctx, netconf.RawMethod(method))
+	if err != nil && !isRPCErrorReply(reply, err) {
+		return "", fmt.Errorf("executing netconf command: %w", err)
 	}
 	if len(reply.Errors) > 0 {
-		var message strings.Builder
-		for _, m := range reply.Errors {
-			_, _ = message.WriteString(m.Message)
+		errs := make([]error, 0, len(reply.Errors))
+		for i, m := range reply.Errors {
+			if m.Severity == errorSeverity {
+				errs = append(errs, &reply.Errors[i])
+			}
+		}
+		if len(errs) > 0 {
+			return "", errors.Join(errs...)
+		}
+	}
+
+	switch format {
+	case ConfigFormatXML:
+		return strings.TrimSpace(reply.Data), nil
+	case ConfigFormatJSON:
+		var output struct {
+			Data string `xml:",chardata"`
+		}
+		if err := xml.Unmarshal([]byte("<reply>"+reply.Data+"</reply>"), &output); err != nil {
+			return "", fmt.Errorf("unmarshaling json reply of command: %w", err)
 		}
 
-		return message.String(), nil
+		return strings.TrimSpace(output.Data), nil
+	}
+	var output commandTextReply
+	if err := xml.Unmarshal([]byte("<reply>"+reply.Data+"</reply>"), &output); err != nil {
+		return "", fmt.Errorf("unmarshaling xml reply of command: %w", err)
+	}
+	if output.ConfigOutput != "" {
+		return strings.TrimPrefix(output.ConfigOutput, "\n"), nil
 	}
 
-	return "", nil
+	return strings.TrimPrefix(output.Output, "\n"), nil
 }
 
-func (sess *Session) netconfConfigLoad(action, format, config string) (string, error) {
+// rpcWithFormat adds the format attribute to the element of RPC.
+func rpcWithFormat(rpc, format string) (string, error) {
+	rpc = strings.TrimSpace(rpc)
+	if !strings.HasPrefix(rpc, "<") {
+		return "", fmt.Errorf("bad RPC %q, need to be a XML element", rpc)
+	}
+	endName := strings.IndexAny(rpc, " \t\n/>")
+	if endName <= 1 {
+		return "", fmt.Errorf("bad RPC %q, need to be a XML element", rpc)
+	}
+
+	return rpc[:endName] + fmt.Sprintf(" format=%q", format) + rpc[endName:], nil
+}
+
+// netconfConfigSet loads the set/delete lines in candidate configuration
+// and returns the warnings and the errors of reply, each one with the line concerned.
+func (sess *Session) netconfConfigSet(ctx context.Context, cmd []string) (warnings []error, _ error) {
+	command := fmt.Sprintf(rpcLoadConfigSetText, strings.Join(cmd, "\n"))
+	reply, err := sess.netconfExec(ctx, netconf.RawMethod(command))
+	if err != nil && !isRPCErrorReply(reply, err) {
+		return nil, fmt.Errorf("executing netconf apply of set/delete command: %w", err)
+	}
+
+	return readNetconfLoadReply(reply, cmd)
+}
+
+// netconfConfigLoad loads the configuration in candidate configuration
+// and returns the warnings and the errors of reply
+// (each one with the line concerned when the action is set).
+func (sess *Session) netconfConfigLoad(
+	ctx context.Context, action, format, config string,
+) (
+	warnings []error, _ error,
+) {
 	var rawConfig string
 	switch {
 	case action == LoadConfigActionSet:
@@ -124,25 +286,108 @@ func (sess *Session) netconfConfigLoad(action, format, config string) (string, e
 		rawConfig = fmt.Sprintf(rpcLoadConfigXML, action, config)
 	}
 
-	reply, err := sess.netconf.Exec(netconf.RawMethod(rawConfig))
-	if err != nil {
-		return "", fmt.Errorf("executing netconf load-configuration with action %q and format %q: %w", action, format, err)
+	reply, err := sess.netconfExec(ctx, netconf.RawMethod(rawConfig))
+	if err != nil && !isRPCErrorReply(reply, err) {
+		return nil, fmt.Errorf("executing netconf load-configuration with action %q and format %q: %w", action, format, err)
 	}
-	if len(reply.Errors) > 0 {
-		var message strings.Builder
-		for _, m := range reply.Errors {
-			_, _ = message.WriteString(m.Message + "\n")
+	if action != LoadConfigActionSet {
+		return readNetconfLoadReply(reply, nil)
+	}
+
+	return readNetconfLoadReply(reply, strings.Split(config, "\n"))
+}
+
+// isRPCErrorReply checks if the error of Exec is only an rpc-error in reply
+// (the reply is received and need to be read).
+func isRPCErrorReply(reply *netconf.RPCReply, err error) bool {
+	var rpcErr *netconf.RPCError
+
+	return reply != nil && errors.As(err, &rpcErr)
+}
+
+// configLoadError: rpc-error in the reply of a load of configuration
+// with the line of configuration concerned (if found).
+type configLoadError struct {
+	rpcError netconf.RPCError
+	line     string
+}
+
+func (e *configLoadError) Error() string {
+	if e.line != "" {
+		return e.rpcError.Error() + fmt.Sprintf("\n  in line %q", e.line)
+	}
+
+	return e.rpcError.Error()
+}
+
+func (e *configLoadError) Unwrap() error {
+	return &e.rpcError
+}
+
+// readNetconfLoadReply returns the rpc-errors in the reply of a load of configuration
+// as warnings (severity warning, like `statement not found` for a delete line)
+// and error (severity error), each one with the line concerned in lines.
+func readNetconfLoadReply(reply *netconf.RPCReply, lines []string) (warnings []error, _ error) {
+	errs := make([]error, 0, len(reply.Errors))
+	for _, m := range reply.Errors {
+		loadErr := &configLoadError{
+			rpcError: m,
+			line:     lineOfRPCError(m, lines),
+		}
+		if m.Severity == errorSeverity {
+			errs = append(errs, loadErr)
+		} else {
+			warnings = append(warnings, loadErr)
+		}
+	}
+
+	return warnings, errors.Join(errs...)
+}
+
+// lineOfRPCError returns the first line (set/delete line of configuration) concerned by the rpc-error
+// with the <error-path> (like `[edit interfaces ge-0/0/0]`) as prefix
+// and the <bad-element> in the words after it.
+//
+// When there is only one line, it's the line concerned.
+func lineOfRPCError(rpcErr netconf.RPCError, lines []string) string {
+	if len(lines) == 1 {
+		return strings.TrimSpace(lines[0])
+	}
+	pathWords := configtree.SplitWords(strings.TrimSuffix(strings.TrimPrefix(
+		strings.TrimSpace(rpcErr.Path), "[edit"), "]"))
+	badElement := strings.TrimSpace(rpcErr.BadElement)
+	if len(pathWords) == 0 && badElement == "" {
+		return ""
+	}
+	for _, line := range lines {
+		words := configtree.SplitWords(line)
+		if len(words) == 0 {
+			continue
+		}
+		// bad command (not set or delete)
+		if len(pathWords) == 0 && words[0] == badElement {
+			return strings.TrimSpace(line)
+		}
+		if len(words) < 2 || (words[0] != "set" && words[0] != "delete") {
+			continue
+		}
+		words = words[1:]
+		if !configtree.HasPrefix(words, pathWords) {
+			continue
+		}
+		if badElement != "" && !slices.Contains(words[len(pathWords):], badElement) {
+			continue
 		}
 
-		return message.String(), nil
+		return strings.TrimSpace(line)
 	}
 
-	return "", nil
+	return ""
 }
 
 // netConfConfigLock locks the candidate configuration.
-func (sess *Session) netconfConfigLock() bool {
-	reply, err := sess.netconf.Exec(netconf.RawMethod(rpcLockCandidate))
+func (sess *Session) netconfConfigLock(ctx context.Context) bool {
+	reply, err := sess.netconfExec(ctx, netconf.RawMethod(rpcLockCandidate))
 	if err != nil {
 		return false
 	}
@@ -154,8 +399,8 @@ func (sess *Session) netconfConfigLock() bool {
 }
 
 // Unlock unlocks the candidate configuration.
-func (sess *Session) netconfConfigUnlock() []error {
-	reply, err := sess.netconf.Exec(netconf.RawMethod(rpcUnlockCandidate))
+func (sess *Session) netconfConfigUnlock(ctx context.Context) []error {
+	reply, err := sess.netconfExec(ctx, netconf.RawMethod(rpcUnlockCandidate))
 	if err != nil {
 		return []error{fmt.Errorf("executing netconf config unlock: %w", err)}
 	}
@@ -172,20 +417,20 @@ func (sess *Session) netconfConfigUnlock() []error {
 	return nil
 }
 
-func (sess *Session) netconfConfigGet(format string) (string, error) {
+func (sess *Session) netconfConfigGet(ctx context.Context, format string) (string, error) {
 	command := fmt.Sprintf(rpcGetConfigurationCommitted, format)
-	reply, err := sess.netconf.Exec(netconf.RawMethod(command))
+	reply, err := sess.netconfExec(ctx, netconf.RawMethod(command))
 	if err != nil {
 		return "", fmt.Errorf("executing netconf get-configuration: %w", err)
 	}
 
 	if len(reply.Errors) > 0 {
-		errs := make([]string, len(reply.Errors))
-		for i, m := range reply.Errors {
-			errs[i] = m.Error()
+		errs := make([]error, len(reply.Errors))
+		for i := range reply.Errors {
+			errs[i] = &reply.Errors[i]
 		}
 
-		return "", errors.New(strings.Join(errs, "\n"))
+		return "", errors.Join(errs...)
 	}
 
 	// Check that we are not receiving XML when JSON minified format should not be wrapped in an XML element
@@ -224,11 +469,17 @@ func (sess *Session) netconfConfigGet(format string) (string, error) {
 	}
 }
 
-// netconfCommit commits the configuration.
+// netconfCommit commits the configuration (on both routing engines with synchronize).
 //
 // return potential warnings and/or error.
-func (sess *Session) netconfCommit(logMessage string) (_ []error, _ error) {
-	reply, err := sess.netconf.Exec(netconf.RawMethod(fmt.Sprintf(rpcCommitConfig, logMessage)))
+func (sess *Session) netconfCommit(ctx context.Context, logMessage string, synchronize bool) (_ []error, _ error) {
+	synchronizeElement, escapedLog, err := commitRPCElements(logMessage, synchronize)
+	if err != nil {
+		return nil, err
+	}
+	reply, err := sess.netconfExec(ctx,
+		netconf.RawMethod(fmt.Sprintf(rpcCommitConfig, synchronizeElement, escapedLog)),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("executing netconf commit: %w", err)
 	}
@@ -236,16 +487,23 @@ func (sess *Session) netconfCommit(logMessage string) (_ []error, _ error) {
 	return readNetconfCommitReply(reply, "commit-configuration")
 }
 
-// netconfCommitConfirmed commits the configuration with confirmed option and confirmed timeout,
-// then wait percentage of timeout and send afterwards the confirmation with commit check.
+// netconfCommitConfirmed commits the configuration with confirmed option and confirmed timeout
+// (on both routing engines with synchronize),
+// then with confirm, wait percentage of timeout and send afterwards the confirmation with commit check.
 //
 // return potential warnings and/or error.
-func (sess *Session) netconfCommitConfirmed(ctx context.Context, logMessage string) (warnings []error, _ error) {
-	reply, err := sess.netconf.Exec(
-		netconf.RawMethod(fmt.Sprintf(rpcCommitConfigConfirmed, logMessage, sess.commitConfirmedTimeout)),
+func (sess *Session) netconfCommitConfirmed(
+	ctx context.Context, logMessage string, synchronize bool, timeout int, confirm bool,
+) (warnings []error, _ error) {
+	synchronizeElement, escapedLog, err := commitRPCElements(logMessage, synchronize)
+	if err != nil {
+		return nil, err
+	}
+	reply, err := sess.netconfExec(ctx,
+		netconf.RawMethod(fmt.Sprintf(rpcCommitConfigConfirmed, synchronizeElement, escapedLog, timeout)),
 	)
 	if err != nil {
-		return warnings, fmt.Errorf("executing netconf commit (confirmed %d): %w", sess.commitConfirmedTimeout, err)
+		return warnings, fmt.Errorf("executing netconf commit (confirmed %d): %w", timeout, err)
 	}
 
 	replyWarns, err := readNetconfCommitReply(reply, "commit-configuration(confirmed)")
@@ -253,6 +511,9 @@ func (sess *Session) netconfCommitConfirmed(ctx context.Context, logMessage stri
 	if err != nil {
 		return warnings, err
 	}
+	if !confirm {
+		return warnings, nil
+	}
 
 	select {
 	case <-ctx.Done():
@@ -260,12 +521,7 @@ func (sess *Session) netconfCommitConfirmed(ctx context.Context, logMessage stri
 	case <-time.After(sess.commitConfirmedWait):
 	}
 
-	replyConfirm, err := sess.netconf.Exec(netconf.RawMethod(rpcCommitConfigCheck))
-	if err != nil {
-		return warnings, fmt.Errorf("executing netconf commit check (to confirm): %w", err)
-	}
-
-	replyWarns, err = readNetconfCommitReply(replyConfirm, "commit-configuration(check)")
+	replyWarns, err = sess.netconfCommitCheck(ctx)
 	warnings = append(warnings, replyWarns...)
 	if err != nil {
 		return warnings, err
@@ -274,17 +530,91 @@ func (sess *Session) netconfCommitConfirmed(ctx context.Context, logMessage stri
 	return warnings, nil
 }
 
+// netconfCommitCheck checks the candidate configuration (and confirms a previous commit confirmed).
+//
+// return potential warnings and/or error.
+func (sess *Session) netconfCommitCheck(ctx context.Context) (_ []error, _ error) {
+	reply, err := sess.netconfExec(ctx, netconf.RawMethod(rpcCommitConfigCheck))
+	if err != nil {
+		return nil, fmt.Errorf("executing netconf commit check: %w", err)
+	}
+
+	return readNetconfCommitReply(reply, "commit-configuration(check)")
+}
+
+// commitRPCElements returns the synchronize element (if needed) and the escaped log message
+// for the commit-configuration RPC.
+func commitRPCElements(logMessage string, synchronize bool) (string, string, error) {
+	var escapedLog strings.Builder
+	if err := xml.EscapeText(&escapedLog, []byte(logMessage)); err != nil {
+		return "", "", fmt.Errorf("escaping log message %q of commit: %w", logMessage, err)
+	}
+	if synchronize {
+		return rpcCommitSynchronize, escapedLog.String(), nil
+	}
+
+	return "", escapedLog.String(), nil
+}
+
+// netconfConfigCompare returns the differences between the candidate and the active configuration
+// (`show | compare`).
+func (sess *Session) netconfConfigCompare(ctx context.Context) (string, error) {
+	reply, err := sess.netconfExec(ctx, netconf.RawMethod(rpcGetConfigurationCompare))
+	if err != nil && !isRPCErrorReply(reply, err) {
+		return "", fmt.Errorf("executing netconf get-configuration compare: %w", err)
+	}
+	if len(reply.Errors) > 0 {
+		errs := make([]error, 0, len(reply.Errors))
+		for i, m := range reply.Errors {
+			if m.Severity == errorSeverity {
+				errs = append(errs, &reply.Errors[i])
+			}
+		}
+		if len(errs) > 0 {
+			return "", errors.Join(errs...)
+		}
+	}
+
+	var output commandTextReply
+	if err := xml.Unmarshal([]byte("<reply>"+reply.Data+"</reply>"), &output); err != nil {
+		return "", fmt.Errorf("unmarshaling xml reply of get-configuration compare: %w", err)
+	}
+
+	return strings.TrimSpace(output.ConfigOutput), nil
+}
+
+// netconfConfigDiscard discards the changes in candidate configuration (`rollback 0`).
+func (sess *Session) netconfConfigDiscard(ctx context.Context) error {
+	reply, err := sess.netconfExec(ctx, netconf.RawMethod(rpcDiscardChanges))
+	if err != nil && !isRPCErrorReply(reply, err) {
+		return fmt.Errorf("executing netconf discard-changes: %w", err)
+	}
+	if len(reply.Errors) > 0 {
+		errs := make([]error, 0, len(reply.Errors))
+		for i, m := range reply.Errors {
+			if m.Severity == errorSeverity {
+				errs = append(errs, &reply.Errors[i])
+			}
+		}
+		if len(errs) > 0 {
+			return errors.Join(errs...)
+		}
+	}
+
+	return nil
+}
+
 func readNetconfCommitReply(reply *netconf.RPCReply, commitType string) (warnings []error, _ error) {
-	errs := make([]string, 0, len(reply.Errors))
-	for _, m := range reply.Errors {
+	errs := make([]error, 0, len(reply.Errors))
+	for i, m := range reply.Errors {
 		if m.Severity == errorSeverity {
-			errs = append(errs, m.Error())
+			errs = append(errs, &reply.Errors[i])
 		} else {
 			warnings = append(warnings, errors.New(m.Error()))
 		}
 	}
 	if len(errs) > 0 {
-		return warnings, errors.New(strings.Join(errs, "\n"))
+		return warnings, errors.Join(errs...)
 	}
 
 	var result commitResults
@@ -293,16 +623,16 @@ func readNetconfCommitReply(reply *netconf.RPCReply, commitType string) (warning
 			return warnings, fmt.Errorf("unmarshaling xml reply %q of %s: %w", reply.Data, commitType, err)
 		}
 
-		errs = make([]string, 0, len(result.Errors))
-		for _, m := range result.Errors {
+		errs = make([]error, 0, len(result.Errors))
+		for i, m := range result.Errors {
 			if m.Severity == errorSeverity {
-				errs = append(errs, m.Error())
+				errs = append(errs, &result.Errors[i])
 			} else {
 				warnings = append(warnings, errors.New(m.Error()))
 			}
 		}
 		if len(errs) > 0 {
-			return warnings, errors.New(strings.Join(errs, "\n"))
+			return warnings, errors.Join(errs...)
 		}
 	}
 
@@ -311,7 +641,10 @@ func readNetconfCommitReply(reply *netconf.RPCReply, commitType string) (warning
 
 // Close disconnects our session to the device.
 func (sess *Session) closeNetconf(sleepClosed int) error {
-	_, err := sess.netconf.Exec(netconf.RawMethod(rpcCloseSession))
+	if sess.netconfAborted {
+		return nil
+	}
+	_, err := sess.netconfExec(context.Background(), netconf.RawMethod(rpcCloseSession))
 	sess.netconf.Transport.Close()
 	if err != nil {
 		utils.Sleep(sleepClosed)
@@ -3,13 +3,15 @@ package junos
 import (
 	"encoding/xml"
 	"fmt"
+	"strings"
 
 	"github.com/jeremmfr/go-netconf/netconf"
 )
 
 //nolint:lll
 const (
-	rpcCommandText = "<command format=\"text\">%s</command>"
+	rpcCommandText   = "<command format=\"text\">%s</command>"
+	rpcCommandFormat = "<command format=\"%s\">%s</command>"
 
 	rpcLoadConfigSetText = "<load-configuration action=\"set\" format=\"text\">" +
 		"<configuration-set>%s</configuration-set>" +
@@ -28,15 +30,20 @@ const (
 		"</load-configuration>"
 
 	rpcCommitConfig = "<commit-configuration>" +
-		"<log>%s</log>" +
+		"%s<log>%s</log>" +
 		"</commit-configuration>"
 	rpcCommitConfigConfirmed = "<commit-configuration>" +
-		"<log>%s</log>" +
+		"%s<log>%s</log>" +
 		"<confirmed/><confirm-timeout>%d</confirm-timeout>" +
 		"</commit-configuration>"
 	rpcCommitConfigCheck = "<commit-configuration>" +
 		"<check/>" +
 		"</commit-configuration>"
+	rpcCommitSynchronize = "<synchronize/>"
+
+	rpcDiscardChanges          = "<discard-changes/>"
+	rpcGetConfigurationCompare = "<get-configuration compare=\"rollback\" rollback=\"0\" format=\"text\">" +
+		"</get-configuration>"
 
 	rpcLockCandidate   = "<lock><target><candidate/></target></lock>"
 	rpcUnlockCandidate = "<unlock><target><candidate/></target></unlock>"
@@ -45,12 +52,25 @@ const (
 
 	rpcGetConfigurationCommitted            = "<get-configuration database=\"committed\" format=\"%s\"></get-configuration>"
 	rpcGetSystemInformation                 = "<get-system-information/>"
+	rpcGetRouteEngineInformation            = "<get-route-engine-information/>"
+	rpcGetSoftwareInformation               = "<get-software-information/>"
+	rpcGetSystemUptimeInformation           = "<get-system-uptime-information/>"
+	rpcGetVlanInformationBrief              = "<get-vlan-information><brief/></get-vlan-information>"
 	RPCGetChassisInventory                  = `<get-chassis-inventory></get-chassis-inventory>`
 	RPCGetInterfaceInformationInterfaceName = "<get-interface-information><interface-name>%s</interface-name></get-interface-information>"
 	RPCGetInterfacesInformationTerse        = `<get-interface-information><terse/></get-interface-information>`
 	RPCGetInterfaceInformationTerse         = `<get-interface-information>%s<terse/></get-interface-information>`
 	RPCGetRouteAllInformation               = `<get-route-information><all/></get-route-information>`
 	RPCGetRouteAllTableInformation          = `<get-route-information><all/><table>%s</table></get-route-information>`
+	rpcGetSHA256ChecksumInformation         = "<get-sha256-checksum-information><path>%s</path></get-sha256-checksum-information>"
+	rpcRequestPackageAdd                    = "<request-package-add>%s<package-name>%s</package-name></request-package-add>"
+	rpcRequestReboot                        = "<request-reboot>%s</request-reboot>"
+	rpcRequestHalt                          = "<request-halt>%s</request-halt>"
+	rpcPing                                 = "<ping>%s</ping>"
+	rpcGetRescueInformation                 = "<get-rescue-information/>"
+	rpcRequestSaveRescueConfiguration       = "<request-save-rescue-configuration/>"
+	rpcRequestDeleteRescueConfiguration     = "<request-delete-rescue-configuration/>"
+	rpcTraceroute                           = "<traceroute>%s</traceroute>"
 )
 
 type rpcGetSystemInformationReply struct {
@@ -74,6 +94,32 @@ type commandXMLConfig struct {
 	Config string `xml:",innerxml"`
 }
 
+// commandTextReply: reply of an operational command (or RPC) with the text format.
+type commandTextReply struct {
+	Output       string `xml:"output"`
+	ConfigOutput string `xml:"configuration-information>configuration-output"`
+}
+
+// checksumInformationReply: reply of get-*-checksum-information RPCs.
+type checksumInformationReply struct {
+	Files []struct {
+		InputFile string `xml:"input-file"`
+		Checksum  string `xml:"checksum"`
+	} `xml:"checksum-information>file-checksum"`
+}
+
+// packageAddReply: reply of request-package-add RPC.
+type packageAddReply struct {
+	Output []string `xml:"output"`
+	Result string   `xml:"package-result"`
+}
+
+// requestShutdownReply: reply of request-reboot and request-halt RPCs.
+type requestShutdownReply struct {
+	Output []string `xml:"output"`
+	Status []string `xml:"request-reboot-results>request-reboot-status"`
+}
+
 type commitResults struct {
 	XMLName xml.Name           `xml:"commit-results"`
 	Errors  []netconf.RPCError `xml:"rpc-error"`
@@ -157,3 +203,14 @@ type RPCGetChassisInventoryReplyComponent struct {
 	CleiCode     *string `xml:"clei-code"`
 	Description  *string `xml:"description"`
 }
+
+// writeXMLElement writes the XML element name with the escaped value as text.
+func writeXMLElement(elements *strings.Builder, name, value string) error {
+	elements.WriteString("<" + name + ">")
+	if err := xml.EscapeText(elements, []byte(value)); err != nil {
+		return fmt.Errorf("escaping %s %q: %w", name, value, err)
+	}
+	elements.WriteString("</" + name + ">")
+
+	return nil
+}
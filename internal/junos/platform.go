@@ -0,0 +1,253 @@
+package junos
+
+import (
+	"context"
+	"encoding/xml"
+	"slices"
+	"strings"
+
+	"github.com/jeremmfr/go-netconf/netconf"
+)
+
+// Platform families of Junos devices.
+const (
+	PlatformACX = "acx"
+	PlatformEX  = "ex"
+	PlatformMX  = "mx"
+	PlatformNFX = "nfx"
+	PlatformPTX = "ptx"
+	PlatformQFX = "qfx"
+	PlatformSRX = "srx"
+)
+
+// Switching configuration styles.
+const (
+	// SwitchingELS: Enhanced Layer 2 Software
+	// (interface-mode, vlans with vlan-id, ...).
+	SwitchingELS = "els"
+	// SwitchingNonELS: legacy style (port-mode, native-vlan-id under family ethernet-switching, ...).
+	SwitchingNonELS = "non-els"
+)
+
+// Platform: classification of device gathered when opening session.
+type Platform struct {
+	// Family: one of Platform* constants or empty if unknown.
+	Family string
+	// SwitchingStyle: SwitchingELS, SwitchingNonELS or empty if not detected
+	// (no switching or detection not possible).
+	SwitchingStyle string
+	// Evolved: device with Junos OS Evolved.
+	Evolved bool
+	// Virtual: virtual device (vSRX, vMX, vQFX, ...).
+	Virtual bool
+	// DualRE: device with two routing engines.
+	DualRE bool
+	// Cluster: device is a node of a chassis cluster.
+	Cluster bool
+}
+
+type rpcSoftwareInformation struct {
+	ProductModel string                   `xml:"product-model"`
+	JunosVersion string                   `xml:"junos-version"`
+	Packages     []rpcSoftwarePackageInfo `xml:"package-information"`
+}
+
+type rpcSoftwarePackageInfo struct {
+	Name    string `xml:"name"`
+	Comment string `xml:"comment"`
+}
+
+type rpcGetSoftwareInformationReply struct {
+	SoftwareInformation []rpcSoftwareInformation `xml:"software-information"`
+	// reply of a device with multiple routing engines
+	MultiRESoftwareInformation []rpcSoftwareInformation `xml:"multi-routing-engine-results>multi-routing-engine-item>software-information"`
+}
+
+// first returns the software information of the first routing engine in reply.
+func (reply *rpcGetSoftwareInformationReply) first() (rpcSoftwareInformation, bool) {
+	if len(reply.SoftwareInformation) > 0 {
+		return reply.SoftwareInformation[0], true
+	}
+	if len(reply.MultiRESoftwareInformation) > 0 {
+		return reply.MultiRESoftwareInformation[0], true
+	}
+
+	return rpcSoftwareInformation{}, false
+}
+
+// evolved checks if the software is Junos OS Evolved
+// with the suffix of version or the description of a package.
+func (software rpcSoftwareInformation) evolved() bool {
+	if strings.HasSuffix(software.JunosVersion, "-EVO") {
+		return true
+	}
+
+	return slices.ContainsFunc(software.Packages, func(pkg rpcSoftwarePackageInfo) bool {
+		return strings.Contains(strings.ToLower(pkg.Comment), "evolved")
+	})
+}
+
+type rpcGetRouteEngineInformationReply struct {
+	RouteEngines []struct {
+		Slot string `xml:"slot"`
+	} `xml:"route-engine-information>route-engine"`
+}
+
+type rpcFirstElementReply struct {
+	Element struct {
+		XMLName xml.Name
+	} `xml:",any"`
+}
+
+// platformFamily returns the platform family of a hardware model
+// and if it's a virtual device.
+func platformFamily(hardwareModel string) (string, bool) {
+	model := strings.ToLower(hardwareModel)
+	if strings.HasPrefix(model, "firefly") {
+		return PlatformSRX, true
+	}
+	virtual := false
+	if trimModel, ok := strings.CutPrefix(model, "v"); ok {
+		model = trimModel
+		virtual = true
+	}
+	for _, family := range []string{PlatformACX, PlatformEX, PlatformMX, PlatformNFX, PlatformPTX, PlatformQFX, PlatformSRX} {
+		if strings.HasPrefix(model, family) {
+			return family, virtual
+		}
+	}
+	if !virtual && strings.HasPrefix(model, "j") {
+		return PlatformSRX, false
+	}
+
+	return "", false
+}
+
+// detectPlatform classifies the device from system information
+// (before the extra RPCs of detectPlatformWithRPCs on a netconf session).
+func (sess *Session) detectPlatform() {
+	sess.Platform.Family, sess.Platform.Virtual = platformFamily(sess.SystemInformation.HardwareModel)
+	sess.Platform.Evolved = strings.Contains(strings.ToLower(sess.SystemInformation.OSName), "evo") ||
+		strings.HasSuffix(sess.SystemInformation.OSVersion, "-EVO")
+	sess.Platform.Cluster = sess.SystemInformation.ClusterNode != nil
+}
+
+// detectSessionPlatform completes the platform of the new netconf session
+// with the result of extra RPCs (software, route engines and vlans).
+//
+// The extra RPCs are only sent on the first session (and after a change of model or version)
+// then the result is kept in client to not send them each time a session is opened.
+func (clt *Client) detectSessionPlatform(ctx context.Context, sess *Session) {
+	key := sess.SystemInformation.HardwareModel + " " + sess.SystemInformation.OSVersion
+
+	clt.platformMutex.Lock()
+	defer clt.platformMutex.Unlock()
+	if clt.platformDetected != nil && clt.platformDetectedKey == key {
+		sess.Platform.Family = clt.platformDetected.Family
+		sess.Platform.Virtual = clt.platformDetected.Virtual
+		sess.Platform.Evolved = clt.platformDetected.Evolved
+		sess.Platform.DualRE = clt.platformDetected.DualRE
+		sess.Platform.SwitchingStyle = clt.platformDetected.SwitchingStyle
+
+		return
+	}
+	sess.detectPlatformWithRPCs(ctx)
+	platform := sess.Platform
+	clt.platformDetected = &platform
+	clt.platformDetectedKey = key
+}
+
+// detectPlatformWithRPCs completes the platform with extra RPCs (software, route engines and vlans).
+//
+// The software information (product model and packages) is the reference
+// to classify the family and detect Junos OS Evolved,
+// the system information is only used when it's not available.
+//
+// Errors of extra RPCs are ignored (not supported on all devices)
+// and keep the default values.
+func (sess *Session) detectPlatformWithRPCs(ctx context.Context) {
+	if sess.netconf == nil {
+		return
+	}
+
+	if reply, err := sess.netconfExec(ctx, netconf.RawMethod(rpcGetSoftwareInformation)); err == nil &&
+		len(reply.Errors) == 0 {
+		var softwareReply rpcGetSoftwareInformationReply
+		if err := xml.Unmarshal([]byte("<reply>"+reply.Data+"</reply>"), &softwareReply); err == nil {
+			if software, ok := softwareReply.first(); ok {
+				if family, virtual := platformFamily(software.ProductModel); family != "" {
+					sess.Platform.Family = family
+					sess.Platform.Virtual = virtual
+				}
+				sess.Platform.Evolved = software.evolved()
+			}
+		}
+	}
+
+	if !sess.Platform.Virtual && !sess.Platform.Cluster {
+		if reply, err := sess.netconfExec(ctx, netconf.RawMethod(rpcGetRouteEngineInformation)); err == nil &&
+			len(reply.Errors) == 0 {
+			var routeEngines rpcGetRouteEngineInformationReply
+			if err := xml.Unmarshal([]byte("<reply>"+reply.Data+"</reply>"), &routeEngines); err == nil {
+				sess.Platform.DualRE = len(routeEngines.RouteEngines) > 1
+			}
+		}
+	}
+
+	switch sess.Platform.Family {
+	case PlatformEX, PlatformQFX, PlatformNFX:
+	case PlatformSRX:
+		if sess.Platform.Virtual {
+			return
+		}
+	default:
+		return
+	}
+	if reply, err := sess.netconfExec(ctx, netconf.RawMethod(rpcGetVlanInformationBrief)); err == nil &&
+		len(reply.Errors) == 0 {
+		var vlans rpcFirstElementReply
+		if err := xml.Unmarshal([]byte("<reply>"+reply.Data+"</reply>"), &vlans); err == nil {
+			switch vlans.Element.XMLName.Local {
+			case "l2ng-l2ald-vlan-instance-information":
+				sess.Platform.SwitchingStyle = SwitchingELS
+			case "vlan-information":
+				sess.Platform.SwitchingStyle = SwitchingNonELS
+			}
+		}
+	}
+}
+
+// CheckELS checks if the device uses the Enhanced Layer 2 Software (ELS) configuration style for switching.
+func (sess *Session) CheckELS() bool {
+	return sess.Platform.SwitchingStyle == SwitchingELS
+}
+
+// CheckNonELS checks if the device uses the legacy (non-ELS) configuration style for switching.
+func (sess *Session) CheckNonELS() bool {
+	return sess.Platform.SwitchingStyle == SwitchingNonELS
+}
+
+// CheckEvolved checks if the device runs Junos OS Evolved.
+func (sess *Session) CheckEvolved() bool {
+	return sess.Platform.Evolved
+}
+
+// CheckVirtual checks if the device is a virtual device.
+func (sess *Session) CheckVirtual() bool {
+	return sess.Platform.Virtual
+}
+
+// CheckDualRE checks if the device has two routing engines.
+func (sess *Session) CheckDualRE() bool {
+	return sess.Platform.DualRE
+}
+
+// CheckClusterNode checks if the device is a node of a chassis cluster.
+func (sess *Session) CheckClusterNode() bool {
+	return sess.Platform.Cluster
+}
+
+// CheckPlatformFamily checks if the device is in one of families.
+func (sess *Session) CheckPlatformFamily(families ...string) bool {
+	return sess.Platform.Family != "" && slices.Contains(families, sess.Platform.Family)
+}
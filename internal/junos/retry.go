@@ -0,0 +1,261 @@
+package junos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"regexp"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/jeremmfr/go-netconf/netconf"
+)
+
+// Kinds of transient errors that can be retried.
+const (
+	// RetryTransport: connection to device lost (EOF, broken pipe, connection reset, ...).
+	RetryTransport = "transport"
+	// RetryLockDenied: candidate configuration locked by another session.
+	RetryLockDenied = "lock_denied"
+	// RetryCommitInProgress: commit in progress by another user.
+	RetryCommitInProgress = "commit_in_progress"
+	// RetryDatabaseLocked: configuration database locked (by another process on device).
+	RetryDatabaseLocked = "database_locked"
+	// RetrySwitchover: routing engine switchover in progress.
+	RetrySwitchover = "switchover"
+)
+
+// RetryKinds returns the kinds of transient errors that can be retried.
+func RetryKinds() []string {
+	return []string{
+		RetryTransport,
+		RetryLockDenied,
+		RetryCommitInProgress,
+		RetryDatabaseLocked,
+		RetrySwitchover,
+	}
+}
+
+// TransientError: error classified as transient with its kind.
+type TransientError struct {
+	Kind string
+	Err  error
+}
+
+func (e *TransientError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *TransientError) Unwrap() error {
+	return e.Err
+}
+
+// netconfReceiptEndMessage: message of the error returned by the netconf transport
+// when the connection is closed before the end of a reply.
+const netconfReceiptEndMessage = "unexpected end of netconf message receipt"
+
+// retryRPCErrorTags: <error-tag> of rpc-errors by kind of transient error.
+//
+//nolint:gochecknoglobals
+var retryRPCErrorTags = map[string][]string{
+	RetryLockDenied: {"lock-denied"},
+}
+
+// retryRPCErrorMessages: patterns of <error-message> of rpc-errors (anchored at the start of message)
+// by kind of transient error.
+//
+//nolint:gochecknoglobals
+var retryRPCErrorMessages = map[string]*regexp.Regexp{
+	RetryLockDenied:       regexp.MustCompile(`(?i)^configuration database locked by\b`),
+	RetryCommitInProgress: regexp.MustCompile(`(?i)^(another )?commit (is )?in progress\b`),
+	RetryDatabaseLocked:   regexp.MustCompile(`(?i)^(configuration )?database (is )?locked$`),
+	RetrySwitchover: regexp.MustCompile(`(?i)^(` +
+		`(graceful )?(routing engine )?switchover (is )?in progress` +
+		`|not (the )?master routing engine` +
+		`|routing engine mastership (change|switch) in progress` +
+		`)\b`),
+}
+
+// ClassifyError returns the error as a *TransientError if it's a transient error
+// or the error unchanged otherwise.
+//
+// Only the error types of a lost connection (io.EOF, net.Error, ...) are classified as transport
+// errors and the other kinds are only detected in the rpc-errors (*netconf.RPCError) of replies,
+// with their tag or their message, never with a substring of the whole error
+// (which can contain the configuration or the output of a command).
+//
+// The errors of an aborted context (canceled or deadline exceeded) are never transient.
+func ClassifyError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var transientErr *TransientError
+	if errors.As(err, &transientErr) {
+		return err
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+	if isTransportError(err) {
+		return &TransientError{Kind: RetryTransport, Err: err}
+	}
+	for _, kind := range RetryKinds() {
+		if inErrorTree(err, func(err error) bool {
+			rpcErr, ok := err.(*netconf.RPCError) //nolint:errorlint
+
+			return ok && rpcErrorIsKind(rpcErr, kind)
+		}) {
+			return &TransientError{Kind: kind, Err: err}
+		}
+	}
+
+	return err
+}
+
+// isTransportError checks if the error is a connection to device lost.
+func isTransportError(err error) bool {
+	var netErr net.Error
+
+	return errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, net.ErrClosed) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, errNetconfAborted) ||
+		errors.As(err, &netErr) ||
+		inErrorTree(err, func(err error) bool {
+			return err.Error() == netconfReceiptEndMessage
+		})
+}
+
+// rpcErrorIsKind checks if the rpc-error (with severity error) is a transient error of kind.
+func rpcErrorIsKind(rpcErr *netconf.RPCError, kind string) bool {
+	if rpcErr.Severity != errorSeverity {
+		return false
+	}
+	if slices.Contains(retryRPCErrorTags[kind], strings.TrimSpace(rpcErr.Tag)) {
+		return true
+	}
+	if pattern, ok := retryRPCErrorMessages[kind]; ok {
+		return pattern.MatchString(strings.TrimSpace(rpcErr.Message))
+	}
+
+	return false
+}
+
+// inErrorTree checks if check is true for err or one of errors wrapped by err
+// (with Unwrap() error or Unwrap() []error like errors.Join).
+func inErrorTree(err error, check func(error) bool) bool {
+	if err == nil {
+		return false
+	}
+	if check(err) {
+		return true
+	}
+	switch e := err.(type) { //nolint:errorlint
+	case interface{ Unwrap() error }:
+		return inErrorTree(e.Unwrap(), check)
+	case interface{ Unwrap() []error }:
+		return slices.ContainsFunc(e.Unwrap(), func(err error) bool {
+			return inErrorTree(err, check)
+		})
+	}
+
+	return false
+}
+
+// RetryPolicy: policy to retry the RPCs failed with a transient error,
+// with exponential backoff and jitter.
+type RetryPolicy struct {
+	// Kinds of transient errors retried.
+	Kinds []string
+	// InitialInterval: wait before the first retry.
+	InitialInterval time.Duration
+	// MaxInterval: maximum wait between two retries.
+	MaxInterval time.Duration
+	// Multiplier of wait after each retry.
+	Multiplier float64
+	// RandomizationFactor: the wait is randomly chosen in [wait * (1 - factor), wait * (1 + factor)].
+	RandomizationFactor float64
+	// MaxElapsedTime: no retry after this time since the first try, 0 to disable retries.
+	MaxElapsedTime time.Duration
+}
+
+// DefaultRetryPolicy returns the default policy to retry RPCs.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		Kinds:               RetryKinds(),
+		InitialInterval:     time.Second,
+		MaxInterval:         15 * time.Second,
+		Multiplier:          2,
+		RandomizationFactor: 0.5,
+		MaxElapsedTime:      60 * time.Second,
+	}
+}
+
+// retryable returns the transient error if err is one of kinds retried by policy.
+func (policy RetryPolicy) retryable(err error) (*TransientError, bool) {
+	var transientErr *TransientError
+	if !errors.As(ClassifyError(err), &transientErr) {
+		return nil, false
+	}
+
+	return transientErr, slices.Contains(policy.Kinds, transientErr.Kind)
+}
+
+// nextInterval returns the wait with jitter for the current interval
+// and the interval for the next retry.
+func (policy RetryPolicy) nextInterval(interval time.Duration) (time.Duration, time.Duration) {
+	wait := interval
+	if policy.RandomizationFactor > 0 {
+		delta := policy.RandomizationFactor * float64(interval)
+		wait = time.Duration(float64(interval) - delta + rand.Float64()*2*delta) //nolint:gosec
+	}
+	next := min(time.Duration(float64(interval)*policy.Multiplier), policy.MaxInterval)
+
+	return wait, max(next, interval)
+}
+
+// withRetry calls the RPC function and retries it, following the retry policy of session,
+// when it fails with a transient error.
+//
+// A transport error is retried after a reconnection to the device only if the configuration
+// is not locked by the session (the candidate configuration is lost with the connection).
+func (sess *Session) withRetry(ctx context.Context, name string, call func() error) error {
+	start := time.Now()
+	interval := sess.retryPolicy.InitialInterval
+	for {
+		err := call()
+		if err == nil {
+			return nil
+		}
+		transientErr, ok := sess.retryPolicy.retryable(err)
+		if !ok || sess.retryPolicy.MaxElapsedTime <= 0 || sess.client == nil {
+			return ClassifyError(err)
+		}
+		if transientErr.Kind == RetryTransport && sess.writeLocked {
+			return transientErr
+		}
+		var wait time.Duration
+		wait, interval = sess.retryPolicy.nextInterval(interval)
+		if time.Since(start)+wait > sess.retryPolicy.MaxElapsedTime {
+			return transientErr
+		}
+		sess.logFile(fmt.Sprintf("[%s] %s error, retry in %s: %q", name, transientErr.Kind, wait, err))
+		select {
+		case <-ctx.Done():
+			return transientErr
+		case <-time.After(wait):
+		}
+		if transientErr.Kind == RetryTransport {
+			if err := sess.reconnect(ctx); err != nil {
+				sess.logFile(fmt.Sprintf("[%s] reconnect failed: %q", name, err))
+			}
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package junos_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+
+	"github.com/jeremmfr/go-netconf/netconf"
+)
+
+func TestClassifyError(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		err        error
+		expectKind string
+	}
+	tests := map[string]testCase{
+		"eof": {
+			err:        fmt.Errorf("executing netconf command: %w", io.EOF),
+			expectKind: junos.RetryTransport,
+		},
+		"connection_reset": {
+			err: fmt.Errorf("executing netconf command: %w", &net.OpError{
+				Op:  "read",
+				Net: "tcp",
+				Err: os.NewSyscallError("read", syscall.ECONNRESET),
+			}),
+			expectKind: junos.RetryTransport,
+		},
+		"broken_pipe": {
+			err:        fmt.Errorf("sending netconf message: %w", syscall.EPIPE),
+			expectKind: junos.RetryTransport,
+		},
+		"end_of_receipt": {
+			err:        fmt.Errorf("executing netconf command: %w", errors.New("unexpected end of netconf message receipt")),
+			expectKind: junos.RetryTransport,
+		},
+		"lock_denied": {
+			err: &netconf.RPCError{
+				Tag:      "lock-denied",
+				Severity: "error",
+				Message:  "configuration database locked by session 1234",
+			},
+			expectKind: junos.RetryLockDenied,
+		},
+		"lock_denied_message": {
+			err: &netconf.RPCError{
+				Tag:      "operation-failed",
+				Severity: "error",
+				Message:  "\nconfiguration database locked by:\n  user terminal p0 (pid 1234)\n",
+			},
+			expectKind: junos.RetryLockDenied,
+		},
+		"commit_in_progress": {
+			err: fmt.Errorf("commit: %w", errors.Join(
+				&netconf.RPCError{Severity: "error", Message: "statement not found"},
+				&netconf.RPCError{Severity: "error", Message: "commit is in progress"},
+			)),
+			expectKind: junos.RetryCommitInProgress,
+		},
+		"database_locked": {
+			err:        &netconf.RPCError{Severity: "error", Message: "database is locked"},
+			expectKind: junos.RetryDatabaseLocked,
+		},
+		"switchover": {
+			err:        &netconf.RPCError{Severity: "error", Message: "graceful switchover in progress"},
+			expectKind: junos.RetrySwitchover,
+		},
+		"not_master": {
+			err:        &netconf.RPCError{Severity: "error", Message: "Not master Routing Engine"},
+			expectKind: junos.RetrySwitchover,
+		},
+		"syntax_error": {
+			err: errors.New("syntax error"),
+		},
+		"warning_lock_denied": {
+			err: &netconf.RPCError{Tag: "lock-denied", Severity: "warning", Message: "lock denied"},
+		},
+		"interface_name_with_eof": {
+			err: &netconf.RPCError{Severity: "error", Message: "interface geof-0/0/0 not found"},
+		},
+		"description_with_switchover": {
+			err: &netconf.RPCError{
+				Severity: "error",
+				Path:     "[edit interfaces ge-0/0/0]",
+				Message:  "syntax error, description \"not master of switchover\"",
+			},
+		},
+		"message_not_rpc_error": {
+			err: errors.New("error: commit is in progress"),
+		},
+		"context_canceled": {
+			err: fmt.Errorf("RPC aborted: %w", context.Canceled),
+		},
+		"context_deadline_exceeded_with_eof": {
+			err: fmt.Errorf("RPC aborted (%w): %w", io.EOF, context.DeadlineExceeded),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := junos.ClassifyError(tc.err)
+			if !errors.Is(err, tc.err) {
+				t.Errorf("got unexpected error %q not wrapping the original error", err)
+			}
+			var transientErr *junos.TransientError
+			if !errors.As(err, &transientErr) {
+				if tc.expectKind != "" {
+					t.Errorf("got unexpected not transient error, want kind %q", tc.expectKind)
+				}
+
+				return
+			}
+			if transientErr.Kind != tc.expectKind {
+				t.Errorf("got unexpected kind %q, want %q", transientErr.Kind, tc.expectKind)
+			}
+		})
+	}
+}
@@ -7,7 +7,7 @@ import (
 	"log"
 	"net"
 	"os"
-	"strings"
+	"sync"
 	"time"
 
 	"github.com/jeremmfr/terraform-provider-junos/internal/tfdata"
@@ -23,12 +23,22 @@ import (
 type Session struct {
 	client                 *Client
 	SystemInformation      rpcSystemInformation
+	Platform               Platform
 	netconf                *netconf.Session
 	localAddress           string
 	remoteAddress          string
 	logFile                func(string)
 	decodeSecrets          bool
-	fakeSetFile            func([]string) error
+	fakeSetFile            func(string, []string) error
+	fakeSetLines           *[]string
+	configSetRecord        *[]string
+	configWarnings         []error
+	netconfAborted         bool
+	configMutex            *sync.RWMutex
+	writeLocked            bool
+	rpcTimeout             time.Duration
+	retryPolicy            RetryPolicy
+	offline                *offlineConfig
 	sleepShort             int
 	sleepLock              int
 	commitConfirmedTimeout int
@@ -47,8 +57,9 @@ type sshAuthMethod struct {
 }
 
 type openSSHOptions struct {
-	Retry   int
-	Timeout int
+	Retry      int
+	Timeout    int
+	RPCTimeout int
 }
 
 type sshOptions struct {
@@ -86,6 +97,34 @@ func netconfNewSessionWithConfig(
 ) (
 	*Session, error,
 ) {
+	var sess *Session
+	err := sshConnectWithRetry(ctx, host, sshOpts, func(conn net.Conn) (bool, error) {
+		s, err := netconf.NewSSHSession(conn, sshOpts.ClientConfig)
+		if err != nil {
+			return false, err
+		}
+		sess, err = newSessionFromNetconf(
+			ctx, s, conn.LocalAddr().String(), conn.RemoteAddr().String(),
+			time.Duration(sshOpts.RPCTimeout)*time.Second,
+		)
+
+		return true, err
+	})
+
+	return sess, err
+}
+
+// sshConnectWithRetry opens a TCP connection to host and calls connect with it to initialize
+// the SSH session, and retries (with the Retry of sshOpts) when one of these steps fails.
+//
+// connect returns if the SSH session is initialized to stop the retries
+// and return its error as is (the error of the next steps).
+func sshConnectWithRetry(
+	ctx context.Context,
+	host string,
+	sshOpts *sshOptions,
+	connect func(net.Conn) (bool, error),
+) error {
 	netDialer := net.Dialer{
 		Timeout: time.Duration(sshOpts.Timeout) * time.Second,
 	}
@@ -100,7 +139,7 @@ toretry:
 		if err != nil {
 			select {
 			case <-ctx.Done():
-				return nil, fmt.Errorf("error connecting to %s: %w", host, err)
+				return fmt.Errorf("error connecting to %s: %w", host, err)
 			default:
 				if retry != 0 {
 					log.Printf("[WARN] connecting to %s: %s, go retry", host, err.Error())
@@ -111,40 +150,41 @@ toretry:
 					continue toretry
 				}
 
-				return nil, fmt.Errorf("error connecting to %s: %w", host, err)
+				return fmt.Errorf("error connecting to %s: %w", host, err)
 			}
 		}
-		s, err := netconf.NewSSHSession(conn, sshOpts.ClientConfig)
-		if err != nil {
-			_ = conn.Close()
-			select {
-			case <-ctx.Done():
-				return nil, fmt.Errorf("initializing SSH session to %s: %w", host, err)
-			default:
-				if retry != 0 {
-					log.Printf("[WARN] initializing SSH session to %s: %s, go retry", host, err.Error())
-					// sleep with time increasing as things try
-					sleepTime++
-					utils.Sleep(sleepTime)
-
-					continue toretry
-				}
+		connected, err := connect(conn)
+		if connected {
+			return err
+		}
+		_ = conn.Close()
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("initializing SSH session to %s: %w", host, err)
+		default:
+			if retry != 0 {
+				log.Printf("[WARN] initializing SSH session to %s: %s, go retry", host, err.Error())
+				// sleep with time increasing as things try
+				sleepTime++
+				utils.Sleep(sleepTime)
 
-				return nil, fmt.Errorf("initializing SSH session to %s: %w", host, err)
+				continue toretry
 			}
-		}
 
-		return newSessionFromNetconf(s, conn.LocalAddr().String(), conn.RemoteAddr().String())
+			return fmt.Errorf("initializing SSH session to %s: %w", host, err)
+		}
 	}
 	// this return can't happen
-	return nil, fmt.Errorf("connecting to %s: retries exceeded", host)
+	return fmt.Errorf("connecting to %s: retries exceeded", host)
 }
 
 // newSessionFromNetconf uses an existing netconf.Session to run our commands against.
 func newSessionFromNetconf(
+	ctx context.Context,
 	netConfSess *netconf.Session,
 	localAddress,
 	remoteAddress string,
+	rpcTimeout time.Duration,
 ) (
 	*Session, error,
 ) {
@@ -152,9 +192,10 @@ func newSessionFromNetconf(
 		netconf:       netConfSess,
 		localAddress:  localAddress,
 		remoteAddress: remoteAddress,
+		rpcTimeout:    rpcTimeout,
 	}
 
-	return sess, sess.gatherFacts()
+	return sess, sess.gatherFacts(ctx)
 }
 
 // genSSHClientConfig is a wrapper function based around the auth method defined
@@ -209,11 +250,24 @@ func (sess *Session) HasNetconf() bool {
 }
 
 // Command (show, execute) on Junos device via netconf.
-func (sess *Session) Command(cmd string) (string, error) {
-	read, err := sess.netconfCommand(cmd)
-	if errRecover := sess.checkAndRecover(context.TODO(), err); errRecover == nil && err != nil {
-		read, err = sess.netconfCommand(cmd)
+func (sess *Session) Command(ctx context.Context, cmd string) (string, error) {
+	if sess.offline != nil {
+		read, err := sess.offline.command(cmd)
+		sess.logFile(fmt.Sprintf("[Command] offline cmd: %q", cmd))
+		if err != nil {
+			sess.logFile(fmt.Sprintf("[Command] err: %q", err))
+
+			return "", err
+		}
+
+		return read, nil
 	}
+	var read string
+	err := sess.withRetry(ctx, "Command", func() (err error) {
+		read, err = sess.netconfCommand(ctx, cmd)
+
+		return err
+	})
 	sess.logFile(fmt.Sprintf("[Command] cmd: %q", cmd))
 	sess.logFile(fmt.Sprintf("[Command] read: %q", read))
 	utils.SleepShort(sess.sleepShort)
@@ -227,11 +281,16 @@ func (sess *Session) Command(cmd string) (string, error) {
 }
 
 // CommandXML send XML cmd on Junos device via netconf.
-func (sess *Session) CommandXML(cmd string) (string, error) {
-	read, err := sess.netconfCommandXML(cmd)
-	if errRecover := sess.checkAndRecover(context.TODO(), err); errRecover == nil && err != nil {
-		read, err = sess.netconfCommandXML(cmd)
+func (sess *Session) CommandXML(ctx context.Context, cmd string) (string, error) {
+	if sess.offline != nil {
+		return "", errors.New("xml command not supported with offline configuration")
 	}
+	var read string
+	err := sess.withRetry(ctx, "CommandXML", func() (err error) {
+		read, err = sess.netconfCommandXML(ctx, cmd)
+
+		return err
+	})
 	sess.logFile(fmt.Sprintf("[CommandXML] cmd: %q", cmd))
 	sess.logFile(fmt.Sprintf("[CommandXML] read: %q", read))
 	utils.SleepShort(sess.sleepShort)
@@ -244,17 +303,91 @@ func (sess *Session) CommandXML(cmd string) (string, error) {
 	return read, nil
 }
 
+// CommandFormat sends the operational command (CLI) on Junos device via netconf
+// and returns the output in format (ConfigFormatText, ConfigFormatXML or ConfigFormatJSON).
+func (sess *Session) CommandFormat(ctx context.Context, cmd, format string) (string, error) {
+	return sess.commandFormat(ctx, "CommandFormat", cmd, format, false, true)
+}
+
+// RPCFormat sends the RPC (XML element) on Junos device via netconf
+// and returns the output in format (ConfigFormatText, ConfigFormatXML or ConfigFormatJSON).
+func (sess *Session) RPCFormat(ctx context.Context, rpc, format string) (string, error) {
+	return sess.commandFormat(ctx, "RPCFormat", rpc, format, true, true)
+}
+
+// CommandFormatNoRetry sends the operational command (CLI) like CommandFormat
+// but without retry when it fails with a transient error.
+//
+// To use for commands which can change the state of device (clear, request, ...):
+// the command can be executed by device even if the reply is lost.
+func (sess *Session) CommandFormatNoRetry(ctx context.Context, cmd, format string) (string, error) {
+	return sess.commandFormat(ctx, "CommandFormatNoRetry", cmd, format, false, false)
+}
+
+// RPCFormatNoRetry sends the RPC (XML element) like RPCFormat
+// but without retry when it fails with a transient error.
+//
+// To use for RPCs which can change the state of device.
+func (sess *Session) RPCFormatNoRetry(ctx context.Context, rpc, format string) (string, error) {
+	return sess.commandFormat(ctx, "RPCFormatNoRetry", rpc, format, true, false)
+}
+
+func (sess *Session) commandFormat(ctx context.Context, name, cmd, format string, rpc, retry bool) (string, error) {
+	switch format {
+	case ConfigFormatText, ConfigFormatXML, ConfigFormatJSON:
+	default:
+		return "", fmt.Errorf("unknown format %q for output of command", format)
+	}
+	if sess.offline != nil {
+		if rpc || format != ConfigFormatText {
+			return "", fmt.Errorf("only command with format %q supported with offline configuration",
+				ConfigFormatText)
+		}
+
+		return sess.Command(ctx, cmd)
+	}
+	var read string
+	call := func() (err error) {
+		read, err = sess.netconfCommandFormat(ctx, cmd, format, rpc)
+
+		return err
+	}
+	var err error
+	if retry {
+		err = sess.withRetry(ctx, name, call)
+	} else {
+		err = ClassifyError(call())
+	}
+	sess.logFile(fmt.Sprintf("[%s] cmd: %q (format %s)", name, cmd, format))
+	sess.logFile(fmt.Sprintf("[%s] read: %q", name, read))
+	utils.SleepShort(sess.sleepShort)
+	if err != nil {
+		sess.logFile(fmt.Sprintf("[%s] err: %q", name, err))
+
+		return "", err
+	}
+
+	return read, nil
+}
+
 // ConfigSet append candidate configuration with set/delete lines
 // on Junos device via netconf or in fake file if set.
-func (sess *Session) ConfigSet(cmd []string) error {
+func (sess *Session) ConfigSet(ctx context.Context, cmd []string) error {
+	if sess.configSetRecord != nil {
+		*sess.configSetRecord = append(*sess.configSetRecord, cmd...)
+
+		return nil
+	}
 	if sess.netconf != nil {
-		message, err := sess.netconfConfigSet(cmd)
-		if errRecover := sess.checkAndRecover(context.TODO(), err); errRecover == nil && err != nil {
-			message, err = sess.netconfConfigSet(cmd)
-		}
+		var warnings []error
+		err := sess.withRetry(ctx, "ConfigSet", func() (err error) {
+			warnings, err = sess.netconfConfigSet(ctx, cmd)
+
+			return err
+		})
 		utils.SleepShort(sess.sleepShort)
 		sess.logFile(fmt.Sprintf("[ConfigSet] cmd: %q", cmd))
-		sess.logFile(fmt.Sprintf("[ConfigSet] message: %q", message))
+		sess.addConfigWarnings("ConfigSet", warnings)
 		if err != nil {
 			sess.logFile(fmt.Sprintf("[ConfigSet] err: %q", err))
 
@@ -262,14 +395,55 @@ func (sess *Session) ConfigSet(cmd []string) error {
 		}
 
 		return nil
-	} else if sess.fakeSetFile != nil {
-		return sess.fakeSetFile(cmd)
+	} else if sess.offline != nil {
+		sess.logFile(fmt.Sprintf("[ConfigSet] offline cmd: %q", cmd))
+
+		return sess.offline.configSet(cmd)
+	} else if sess.fakeSetLines != nil {
+		*sess.fakeSetLines = append(*sess.fakeSetLines, cmd...)
+
+		return nil
 	}
 
 	return errors.New("internal error: call Session.ConfigSet without netconf session or fake set file")
 }
 
-func (sess *Session) ConfigLoad(action, format, config string) error {
+// WriteFakeSetFile appends the set/delete lines kept by the session without netconf
+// to the fake set file in a section for the resource and operation (like `junos_vlan.foo create`).
+//
+// The lines are cleared after the write.
+func (sess *Session) WriteFakeSetFile(resource, operation string) error {
+	if sess.fakeSetLines == nil {
+		return errors.New("internal error: call Session.WriteFakeSetFile without fake set file")
+	}
+	lines := *sess.fakeSetLines
+	*sess.fakeSetLines = make([]string, 0)
+
+	return sess.fakeSetFile(resource+" "+operation, lines)
+}
+
+// ConfigSetRecorder returns a copy of session where the lines of ConfigSet are recorded
+// instead of being applied, and a func to get the recorded lines.
+//
+// The other methods of the copy use the session.
+func (sess *Session) ConfigSetRecorder() (*Session, func() []string) {
+	recorded := make([]string, 0)
+	recorder := *sess
+	recorder.configSetRecord = &recorded
+
+	return &recorder, func() []string { return recorded }
+}
+
+func (sess *Session) ConfigLoad(ctx context.Context, action, format, config string) error {
+	if sess.offline != nil {
+		if action != LoadConfigActionSet || format != ConfigFormatText {
+			return fmt.Errorf("only action %q with format %q supported to load configuration "+
+				"with offline configuration", LoadConfigActionSet, ConfigFormatText)
+		}
+		sess.logFile(fmt.Sprintf("[ConfigLoad] offline config: %q", config))
+
+		return sess.offline.configSet([]string{config})
+	}
 	if sess.netconf == nil {
 		return errors.New("internal error: call Session.ConfigLoad without netconf session")
 	}
@@ -284,7 +458,7 @@ func (sess *Session) ConfigLoad(action, format, config string) error {
 		}
 	case LoadConfigActionUpdate:
 	default:
-		return errors.New("unknown action %q to load configuration")
+		return fmt.Errorf("unknown action %q to load configuration", action)
 	}
 
 	switch format {
@@ -292,15 +466,17 @@ func (sess *Session) ConfigLoad(action, format, config string) error {
 	case ConfigFormatText:
 	case ConfigFormatXML:
 	default:
-		return errors.New("unknown format %q to load configuration")
+		return fmt.Errorf("unknown format %q to load configuration", format)
 	}
 
-	message, err := sess.netconfConfigLoad(action, format, config)
-	if errRecover := sess.checkAndRecover(context.TODO(), err); errRecover == nil && err != nil {
-		message, err = sess.netconfConfigLoad(action, format, config)
-	}
+	var warnings []error
+	err := sess.withRetry(ctx, "ConfigLoad", func() (err error) {
+		warnings, err = sess.netconfConfigLoad(ctx, action, format, config)
+
+		return err
+	})
 	utils.SleepShort(sess.sleepShort)
-	sess.logFile(fmt.Sprintf("[ConfigLoad] message: %q", message))
+	sess.addConfigWarnings("ConfigLoad", warnings)
 	if err != nil {
 		sess.logFile(fmt.Sprintf("[ConfigLoad] err: %q", err))
 
@@ -311,7 +487,10 @@ func (sess *Session) ConfigLoad(action, format, config string) error {
 }
 
 // ConfigGet: get committed configuration in desired format.
-func (sess *Session) ConfigGet(format string) (string, error) {
+func (sess *Session) ConfigGet(ctx context.Context, format string) (string, error) {
+	if sess.offline != nil {
+		return sess.offline.configGet(format)
+	}
 	if sess.netconf == nil {
 		return "", errors.New("internal error: call Session.ConfigGet without netconf session")
 	}
@@ -327,10 +506,12 @@ func (sess *Session) ConfigGet(format string) (string, error) {
 		return "", errors.New("unknown format %q to get configuration")
 	}
 
-	output, err := sess.netconfConfigGet(format)
-	if errRecover := sess.checkAndRecover(context.TODO(), err); errRecover == nil && err != nil {
-		output, err = sess.netconfConfigGet(format)
-	}
+	var output string
+	err := sess.withRetry(ctx, "ConfigGet", func() (err error) {
+		output, err = sess.netconfConfigGet(ctx, format)
+
+		return err
+	})
 	utils.SleepShort(sess.sleepShort)
 	if err != nil {
 		sess.logFile(fmt.Sprintf("[ConfigGet] err: %q", err))
@@ -342,10 +523,18 @@ func (sess *Session) ConfigGet(format string) (string, error) {
 }
 
 // ConfigLock lock candidate configuration and retry with sleep between when fail.
+//
+// The configuration is also locked exclusively for the other sessions of client
+// until ConfigUnlock.
 func (sess *Session) ConfigLock(ctx context.Context) error {
+	sess.writeLock()
+	if sess.offline != nil {
+		return nil
+	}
 	for {
 		select {
 		case <-ctx.Done():
+			sess.writeUnlock()
 			sess.logFile("[ConfigLock] lock aborted")
 
 			return errors.New("candidate configuration lock attempt aborted")
@@ -354,12 +543,18 @@ func (sess *Session) ConfigLock(ctx context.Context) error {
 			if sess.client != nil && sess.client.useSingleSession {
 				_ = sess.checkAndRecover(ctx, errors.New("ping"))
 			}
-			if sess.netconfConfigLock() {
+			if sess.netconfConfigLock(ctx) {
 				sess.logFile("[ConfigLock] config locked")
 				utils.SleepShort(sess.sleepShort)
 
 				return nil
 			}
+			if sess.netconfAborted {
+				sess.writeUnlock()
+				sess.logFile("[ConfigLock] lock aborted with netconf session")
+
+				return errors.New("candidate configuration lock attempt aborted with netconf session")
+			}
 			sess.logFile("[ConfigLock] sleep to wait the lock")
 			utils.Sleep(sess.sleepLock)
 		}
@@ -367,8 +562,15 @@ func (sess *Session) ConfigLock(ctx context.Context) error {
 }
 
 // ConfigUnlock unlock candidate configuration.
-func (sess *Session) ConfigUnlock() []error {
-	errs := sess.netconfConfigUnlock()
+//
+// The unlock is sent even if ctx is canceled to not keep the lock on a reused session.
+func (sess *Session) ConfigUnlock(ctx context.Context) []error {
+	defer sess.writeUnlock()
+	sess.configWarnings = nil
+	if sess.offline != nil {
+		return nil
+	}
+	errs := sess.netconfConfigUnlock(context.WithoutCancel(ctx))
 
 	sess.logFile("[ConfigUnlock] config unlocked")
 	utils.SleepShort(sess.sleepShort)
@@ -376,23 +578,62 @@ func (sess *Session) ConfigUnlock() []error {
 	return errs
 }
 
+// CommitOptions: options of a commit overriding the commit settings of client.
+type CommitOptions struct {
+	// Synchronize: commit on both routing engines.
+	Synchronize bool
+	// ConfirmedTimeout: commit with the confirmed option and this timeout (in minutes)
+	// without sending the confirmation (to send later with CommitCheck),
+	// instead of the commit confirmed settings of client.
+	ConfirmedTimeout int
+}
+
 // CommitConf commit the configuration with message via netconf.
 func (sess *Session) CommitConf(ctx context.Context, logMessage string) (warnings []error, err error) {
-	if sess.commitConfirmedTimeout > 0 {
+	return sess.CommitConfWithOptions(ctx, logMessage, CommitOptions{})
+}
+
+// CommitConfWithOptions commit the configuration with message and options via netconf.
+func (sess *Session) CommitConfWithOptions(
+	ctx context.Context, logMessage string, opts CommitOptions,
+) (warnings []error, err error) {
+	if sess.offline != nil {
+		if opts.Synchronize || opts.ConfirmedTimeout > 0 {
+			return nil, errors.New("commit options not supported with offline configuration")
+		}
+		// set/delete lines are already saved in file by ConfigSet
+		sess.logFile(fmt.Sprintf("[CommitConf] offline commit %q", logMessage))
+
+		return nil, nil
+	}
+	switch {
+	case opts.ConfirmedTimeout > 0:
+		sess.logFile(fmt.Sprintf(
+			"[CommitConf] commit confirmed %d (without confirmation) %q",
+			opts.ConfirmedTimeout, logMessage,
+		))
+		err = sess.withRetry(ctx, "CommitConf", func() (err error) {
+			warnings, err = sess.netconfCommitConfirmed(ctx, logMessage, opts.Synchronize, opts.ConfirmedTimeout, false)
+
+			return err
+		})
+	case sess.commitConfirmedTimeout > 0:
 		sess.logFile(fmt.Sprintf(
 			"[CommitConf] commit confirmed %d (wait %s) %q",
 			sess.commitConfirmedTimeout, sess.commitConfirmedWait, logMessage,
 		))
-		warnings, err = sess.netconfCommitConfirmed(ctx, logMessage)
-		if errRecover := sess.checkAndRecover(ctx, err); errRecover == nil && err != nil {
-			warnings, err = sess.netconfCommitConfirmed(ctx, logMessage)
-		}
-	} else {
+		err = sess.withRetry(ctx, "CommitConf", func() (err error) {
+			warnings, err = sess.netconfCommitConfirmed(ctx, logMessage, opts.Synchronize, sess.commitConfirmedTimeout, true)
+
+			return err
+		})
+	default:
 		sess.logFile(fmt.Sprintf("[CommitConf] commit %q", logMessage))
-		warnings, err = sess.netconfCommit(logMessage)
-		if errRecover := sess.checkAndRecover(ctx, err); errRecover == nil && err != nil {
-			warnings, err = sess.netconfCommit(logMessage)
-		}
+		err = sess.withRetry(ctx, "CommitConf", func() (err error) {
+			warnings, err = sess.netconfCommit(ctx, logMessage, opts.Synchronize)
+
+			return err
+		})
 	}
 	utils.SleepShort(sess.sleepShort)
 	if len(warnings) > 0 {
@@ -400,6 +641,7 @@ func (sess *Session) CommitConf(ctx context.Context, logMessage string) (warning
 			sess.logFile(fmt.Sprintf("[CommitConf] commit warning: %q", w))
 		}
 	}
+	warnings = append(sess.takeConfigWarnings(), warnings...)
 	if err != nil {
 		sess.logFile(fmt.Sprintf("[CommitConf] commit error: %q", err))
 
@@ -409,7 +651,94 @@ func (sess *Session) CommitConf(ctx context.Context, logMessage string) (warning
 	return warnings, nil
 }
 
+// CommitCheck checks the candidate configuration without commit (`commit check`).
+//
+// A previous commit confirmed is also confirmed by the check.
+func (sess *Session) CommitCheck(ctx context.Context) (warnings []error, err error) {
+	if sess.offline != nil {
+		return nil, errors.New("commit check not supported with offline configuration")
+	}
+	err = sess.withRetry(ctx, "CommitCheck", func() (err error) {
+		warnings, err = sess.netconfCommitCheck(ctx)
+
+		return err
+	})
+	utils.SleepShort(sess.sleepShort)
+	for _, w := range warnings {
+		sess.logFile(fmt.Sprintf("[CommitCheck] warning: %q", w))
+	}
+	warnings = append(sess.takeConfigWarnings(), warnings...)
+	if err != nil {
+		sess.logFile(fmt.Sprintf("[CommitCheck] error: %q", err))
+
+		return warnings, err
+	}
+
+	return warnings, nil
+}
+
+// ConfigCompare returns the differences between the candidate and the active configuration
+// (`show | compare`), empty without differences.
+func (sess *Session) ConfigCompare(ctx context.Context) (string, error) {
+	if sess.offline != nil {
+		return "", errors.New("compare of configuration not supported with offline configuration")
+	}
+	var output string
+	err := sess.withRetry(ctx, "ConfigCompare", func() (err error) {
+		output, err = sess.netconfConfigCompare(ctx)
+
+		return err
+	})
+	utils.SleepShort(sess.sleepShort)
+	if err != nil {
+		sess.logFile(fmt.Sprintf("[ConfigCompare] err: %q", err))
+
+		return "", err
+	}
+
+	return output, nil
+}
+
+// ConfigDiscard discards the changes in candidate configuration (`rollback 0`).
+func (sess *Session) ConfigDiscard(ctx context.Context) error {
+	if sess.offline != nil {
+		return errors.New("discard of configuration changes not supported with offline configuration")
+	}
+	err := sess.withRetry(ctx, "ConfigDiscard", func() error {
+		return sess.netconfConfigDiscard(ctx)
+	})
+	utils.SleepShort(sess.sleepShort)
+	sess.configWarnings = nil
+	if err != nil {
+		sess.logFile(fmt.Sprintf("[ConfigDiscard] err: %q", err))
+
+		return err
+	}
+	sess.logFile("[ConfigDiscard] candidate configuration changes discarded")
+
+	return nil
+}
+
+// addConfigWarnings keeps the warnings of load of configuration
+// to return them with the warnings of the next commit.
+func (sess *Session) addConfigWarnings(method string, warnings []error) {
+	for _, w := range warnings {
+		sess.logFile(fmt.Sprintf("[%s] warning: %q", method, w))
+	}
+	sess.configWarnings = append(sess.configWarnings, warnings...)
+}
+
+// takeConfigWarnings returns the warnings of load of configuration since the last commit
+// and clears them.
+func (sess *Session) takeConfigWarnings() []error {
+	warnings := sess.configWarnings
+	sess.configWarnings = nil
+
+	return warnings
+}
+
 func (sess *Session) Close() {
+	sess.writeUnlock()
 	if sess.client != nil && sess.client.useSingleSession {
 		sess.client.sessionMutex.Unlock()
 
@@ -425,38 +754,49 @@ func (sess *Session) Close() {
 	}
 }
 
+// checkAndRecover reconnects the session to the device, when the single session is used,
+// if the error is a transport error (or a ping).
 func (sess *Session) checkAndRecover(ctx context.Context, err error) error {
 	if err == nil || sess.client == nil || !sess.client.useSingleSession {
 		return err
 	}
-	errStr := err.Error()
-	if strings.Contains(errStr, "EOF") ||
-		strings.Contains(errStr, "connection closed") ||
-		strings.Contains(errStr, "broken pipe") ||
-		strings.Contains(errStr, "connection reset by peer") ||
-		strings.Contains(errStr, "use of closed network connection") || errStr == "ping" {
-		sess.logFile(fmt.Sprintf("[checkAndRecover] connection error detected: %v, attempting reconnect...", err))
-		if sess.HasNetconf() {
-			_ = sess.closeNetconf(0)
-		}
-		newSess, reconnErr := sess.client.internalStartNewSession(ctx)
-		if reconnErr != nil {
-			sess.logFile(fmt.Sprintf("[checkAndRecover] reconnect failed: %v", reconnErr))
-
-			return err
-		}
+	var transientErr *TransientError
+	if errStr := err.Error(); errStr != "ping" &&
+		(!errors.As(ClassifyError(err), &transientErr) || transientErr.Kind != RetryTransport) {
+		return err
+	}
+	sess.logFile(fmt.Sprintf("[checkAndRecover] connection error detected: %v, attempting reconnect...", err))
+	if reconnErr := sess.reconnect(ctx); reconnErr != nil {
+		sess.logFile(fmt.Sprintf("[checkAndRecover] reconnect failed: %v", reconnErr))
 
-		sess.netconf = newSess.netconf
-		sess.SystemInformation = newSess.SystemInformation
-		sess.localAddress = newSess.localAddress
-		sess.remoteAddress = newSess.remoteAddress
+		return err
+	}
+	sess.logFile("[checkAndRecover] reconnect successful")
 
-		sess.logFile("[checkAndRecover] reconnect successful")
+	return nil
+}
 
-		return nil
+// reconnect closes the connection to the device and opens a new one for the session.
+func (sess *Session) reconnect(ctx context.Context) error {
+	if sess.client == nil {
+		return errors.New("internal error: reconnect session without client")
+	}
+	if sess.HasNetconf() {
+		_ = sess.closeNetconf(0)
+	}
+	newSess, err := sess.client.internalStartNewSession(ctx)
+	if err != nil {
+		return err
 	}
 
-	return err
+	sess.netconf = newSess.netconf
+	sess.netconfAborted = false
+	sess.SystemInformation = newSess.SystemInformation
+	sess.Platform = newSess.Platform
+	sess.localAddress = newSess.localAddress
+	sess.remoteAddress = newSess.remoteAddress
+
+	return nil
 }
 
 func (sess *Session) JunosDecode(
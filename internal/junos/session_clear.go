@@ -0,0 +1,187 @@
+package junos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	ClearTargetBGPNeighbor          = "bgp_neighbor"
+	ClearTargetIKESecurityAssocs    = "ike_security_associations"
+	ClearTargetIPsecSecurityAssocs  = "ipsec_security_associations"
+	ClearTargetFlowSession          = "flow_session"
+	ClearTargetARP                  = "arp"
+	ClearTargetInterfacesStatistics = "interfaces_statistics"
+
+	ClearBGPSoft        = "soft"
+	ClearBGPSoftInbound = "soft-inbound"
+)
+
+// ClearTargets returns the targets of the `clear` commands supported by ClearOptions.
+func ClearTargets() []string {
+	return []string{
+		ClearTargetBGPNeighbor,
+		ClearTargetIKESecurityAssocs,
+		ClearTargetIPsecSecurityAssocs,
+		ClearTargetFlowSession,
+		ClearTargetARP,
+		ClearTargetInterfacesStatistics,
+	}
+}
+
+// ClearOptions: target and filters of a `clear` operational command.
+//
+// Each filter is only valid with some targets:
+//   - Neighbor, Soft: ClearTargetBGPNeighbor
+//   - RoutingInstance: ClearTargetBGPNeighbor, ClearTargetARP
+//   - PeerAddress: ClearTargetIKESecurityAssocs
+//   - Interface: ClearTargetFlowSession, ClearTargetARP, ClearTargetInterfacesStatistics
+//   - SourcePrefix, DestinationPrefix, SourcePort, DestinationPort, Protocol, Application:
+//     ClearTargetFlowSession
+type ClearOptions struct {
+	Target            string
+	Neighbor          string
+	Soft              string
+	RoutingInstance   string
+	PeerAddress       string
+	Interface         string
+	SourcePrefix      string
+	DestinationPrefix string
+	SourcePort        int64
+	DestinationPort   int64
+	Protocol          string
+	Application       string
+}
+
+// Command returns the `clear` operational command (CLI) of options.
+//
+// Each filter need to be a unique word of command (without whitespace, pipe or quote)
+// to not add other arguments or pipes to the command.
+func (opts ClearOptions) Command() (string, error) {
+	for _, filter := range [][2]string{
+		{"neighbor", opts.Neighbor},
+		{"routing instance", opts.RoutingInstance},
+		{"peer address", opts.PeerAddress},
+		{"interface", opts.Interface},
+		{"source prefix", opts.SourcePrefix},
+		{"destination prefix", opts.DestinationPrefix},
+		{"protocol", opts.Protocol},
+		{"application", opts.Application},
+	} {
+		if err := checkClearWord(filter[0], filter[1]); err != nil {
+			return "", err
+		}
+	}
+	if opts.SourcePort < 0 || opts.SourcePort > 65535 {
+		return "", fmt.Errorf("bad source port %d for clear, need to be between 1 and 65535", opts.SourcePort)
+	}
+	if opts.DestinationPort < 0 || opts.DestinationPort > 65535 {
+		return "", fmt.Errorf("bad destination port %d for clear, need to be between 1 and 65535", opts.DestinationPort)
+	}
+	words := make([]string, 0)
+	switch opts.Target {
+	case ClearTargetBGPNeighbor:
+		words = append(words, "clear", "bgp", "neighbor")
+		if opts.Neighbor != "" {
+			words = append(words, opts.Neighbor)
+		}
+		switch opts.Soft {
+		case "":
+		case ClearBGPSoft, ClearBGPSoftInbound:
+			words = append(words, opts.Soft)
+		default:
+			return "", fmt.Errorf("unknown soft option %q for clear of bgp neighbor", opts.Soft)
+		}
+		if opts.RoutingInstance != "" {
+			words = append(words, "instance", opts.RoutingInstance)
+		}
+	case ClearTargetIKESecurityAssocs:
+		words = append(words, "clear", "security", "ike", "security-associations")
+		if opts.PeerAddress != "" {
+			words = append(words, opts.PeerAddress)
+		}
+	case ClearTargetIPsecSecurityAssocs:
+		words = append(words, "clear", "security", "ipsec", "security-associations")
+	case ClearTargetFlowSession:
+		words = append(words, "clear", "security", "flow", "session")
+		filters := make([]string, 0)
+		for _, v := range [][2]string{
+			{"source-prefix", opts.SourcePrefix},
+			{"destination-prefix", opts.DestinationPrefix},
+			{"source-port", portWord(opts.SourcePort)},
+			{"destination-port", portWord(opts.DestinationPort)},
+			{"protocol", opts.Protocol},
+			{"application", opts.Application},
+			{"interface", opts.Interface},
+		} {
+			if v[1] != "" {
+				filters = append(filters, v[0], v[1])
+			}
+		}
+		if len(filters) == 0 {
+			words = append(words, "all")
+		} else {
+			words = append(words, filters...)
+		}
+	case ClearTargetARP:
+		words = append(words, "clear", "arp")
+		if opts.Interface != "" {
+			words = append(words, "interface", opts.Interface)
+		}
+		if opts.RoutingInstance != "" {
+			words = append(words, "vpn", opts.RoutingInstance)
+		}
+	case ClearTargetInterfacesStatistics:
+		words = append(words, "clear", "interfaces", "statistics")
+		if opts.Interface != "" {
+			words = append(words, opts.Interface)
+		} else {
+			words = append(words, "all")
+		}
+	case "":
+		return "", errors.New("missing target of clear")
+	default:
+		return "", fmt.Errorf("unknown target %q of clear, need to be one of %s",
+			opts.Target, strings.Join(ClearTargets(), ", "))
+	}
+
+	return strings.Join(words, " "), nil
+}
+
+// checkClearWord checks that the value of a filter (if set) is a unique word of command.
+func checkClearWord(name, value string) error {
+	if strings.IndexFunc(value, unicode.IsSpace) != -1 || strings.ContainsAny(value, `|;"'\`) {
+		return fmt.Errorf("bad %s %q for clear, need to be a unique word without whitespace, pipe or quote",
+			name, value)
+	}
+
+	return nil
+}
+
+func portWord(port int64) string {
+	if port == 0 {
+		return ""
+	}
+
+	return strconv.FormatInt(port, 10)
+}
+
+// Clear sends the `clear` operational command of options and returns its output (often empty).
+//
+// The command is not retried (like the other requests which change the state of device).
+func (sess *Session) Clear(ctx context.Context, opts ClearOptions) (string, error) {
+	cmd, err := opts.Command()
+	if err != nil {
+		return "", err
+	}
+	output, err := sess.CommandFormatNoRetry(ctx, cmd, ConfigFormatText)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", cmd, err)
+	}
+
+	return strings.TrimSpace(output), nil
+}
@@ -0,0 +1,132 @@
+package junos_test
+
+import (
+	"testing"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+)
+
+func TestClearOptionsCommand(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		opts  junos.ClearOptions
+		cmd   string
+		valid bool
+	}
+
+	tests := map[string]testCase{
+		"bgp_neighbor_all": {
+			opts:  junos.ClearOptions{Target: junos.ClearTargetBGPNeighbor},
+			cmd:   "clear bgp neighbor",
+			valid: true,
+		},
+		"bgp_neighbor_soft_inbound": {
+			opts: junos.ClearOptions{
+				Target:          junos.ClearTargetBGPNeighbor,
+				Neighbor:        "192.0.2.1",
+				Soft:            junos.ClearBGPSoftInbound,
+				RoutingInstance: "vrf1",
+			},
+			cmd:   "clear bgp neighbor 192.0.2.1 soft-inbound instance vrf1",
+			valid: true,
+		},
+		"bgp_neighbor_bad_soft": {
+			opts:  junos.ClearOptions{Target: junos.ClearTargetBGPNeighbor, Soft: "hard"},
+			valid: false,
+		},
+		"ike_peer": {
+			opts:  junos.ClearOptions{Target: junos.ClearTargetIKESecurityAssocs, PeerAddress: "192.0.2.2"},
+			cmd:   "clear security ike security-associations 192.0.2.2",
+			valid: true,
+		},
+		"ipsec": {
+			opts:  junos.ClearOptions{Target: junos.ClearTargetIPsecSecurityAssocs},
+			cmd:   "clear security ipsec security-associations",
+			valid: true,
+		},
+		"flow_session_all": {
+			opts:  junos.ClearOptions{Target: junos.ClearTargetFlowSession},
+			cmd:   "clear security flow session all",
+			valid: true,
+		},
+		"flow_session_filters": {
+			opts: junos.ClearOptions{
+				Target:            junos.ClearTargetFlowSession,
+				SourcePrefix:      "192.0.2.0/24",
+				DestinationPrefix: "198.51.100.1/32",
+				DestinationPort:   443,
+				Protocol:          "tcp",
+			},
+			cmd: "clear security flow session source-prefix 192.0.2.0/24 destination-prefix 198.51.100.1/32 " +
+				"destination-port 443 protocol tcp",
+			valid: true,
+		},
+		"arp_interface": {
+			opts:  junos.ClearOptions{Target: junos.ClearTargetARP, Interface: "ge-0/0/0.0"},
+			cmd:   "clear arp interface ge-0/0/0.0",
+			valid: true,
+		},
+		"interfaces_statistics_all": {
+			opts:  junos.ClearOptions{Target: junos.ClearTargetInterfacesStatistics},
+			cmd:   "clear interfaces statistics all",
+			valid: true,
+		},
+		"interfaces_statistics_interface": {
+			opts:  junos.ClearOptions{Target: junos.ClearTargetInterfacesStatistics, Interface: "ge-0/0/1"},
+			cmd:   "clear interfaces statistics ge-0/0/1",
+			valid: true,
+		},
+		"interface_with_pipe": {
+			opts: junos.ClearOptions{
+				Target:    junos.ClearTargetInterfacesStatistics,
+				Interface: "ge-0/0/1|save /var/tmp/out",
+			},
+			valid: false,
+		},
+		"neighbor_with_space": {
+			opts:  junos.ClearOptions{Target: junos.ClearTargetBGPNeighbor, Neighbor: "192.0.2.1 all"},
+			valid: false,
+		},
+		"application_with_newline": {
+			opts: junos.ClearOptions{
+				Target:      junos.ClearTargetFlowSession,
+				Application: "junos-http\nrequest system reboot",
+			},
+			valid: false,
+		},
+		"protocol_with_quote": {
+			opts:  junos.ClearOptions{Target: junos.ClearTargetFlowSession, Protocol: `tcp"`},
+			valid: false,
+		},
+		"bad_port": {
+			opts:  junos.ClearOptions{Target: junos.ClearTargetFlowSession, SourcePort: 70000},
+			valid: false,
+		},
+		"unknown_target": {
+			opts:  junos.ClearOptions{Target: "route"},
+			valid: false,
+		},
+		"empty_target": {
+			opts:  junos.ClearOptions{},
+			valid: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cmd, err := test.opts.Command()
+			if test.valid && err != nil {
+				t.Errorf("got unexpected error: %s", err)
+			}
+			if !test.valid && err == nil {
+				t.Errorf("expected error with %#v", test.opts)
+			}
+			if cmd != test.cmd {
+				t.Errorf("got command %q, expected %q", cmd, test.cmd)
+			}
+		})
+	}
+}
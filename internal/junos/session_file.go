@@ -0,0 +1,33 @@
+package junos
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// FileChecksumSHA256 returns the SHA-256 checksum (in hex) of a file on device
+// with the `file checksum sha-256` RPC.
+func (sess *Session) FileChecksumSHA256(ctx context.Context, path string) (string, error) {
+	var escaped strings.Builder
+	if err := xml.EscapeText(&escaped, []byte(path)); err != nil {
+		return "", fmt.Errorf("escaping path %q: %w", path, err)
+	}
+	reply, err := sess.CommandXML(ctx, fmt.Sprintf(rpcGetSHA256ChecksumInformation, escaped.String()))
+	if err != nil {
+		return "", fmt.Errorf("getting checksum of file %q: %w", path, err)
+	}
+	var checksumInfo checksumInformationReply
+	if err := xml.Unmarshal([]byte("<reply>"+reply+"</reply>"), &checksumInfo); err != nil {
+		return "", fmt.Errorf("unmarshaling xml reply of checksum of file %q: %w", path, err)
+	}
+	for _, file := range checksumInfo.Files {
+		if v := strings.TrimSpace(file.Checksum); v != "" {
+			return strings.ToLower(v), nil
+		}
+	}
+
+	return "", errors.New("no checksum in reply of checksum of file " + path)
+}
@@ -0,0 +1,241 @@
+package junos
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// PingOptions: options of the `ping` RPC.
+type PingOptions struct {
+	Host            string
+	Count           int64
+	Size            *int64
+	Source          string
+	RoutingInstance string
+	Interface       string
+	DoNotFragment   bool
+}
+
+// PingResult: statistics of a ping with the RTT in milliseconds.
+type PingResult struct {
+	TargetIP          string
+	ProbesSent        int64
+	ResponsesReceived int64
+	PacketLoss        float64
+	RTTMinimum        float64
+	RTTMaximum        float64
+	RTTAverage        float64
+	RTTStddev         float64
+}
+
+type pingReply struct {
+	TargetIP string `xml:"ping-results>target-ip"`
+	Summary  *struct {
+		ProbesSent        string `xml:"probes-sent"`
+		ResponsesReceived string `xml:"responses-received"`
+		PacketLoss        string `xml:"packet-loss"`
+		RTTMinimum        string `xml:"rtt-minimum"`
+		RTTMaximum        string `xml:"rtt-maximum"`
+		RTTAverage        string `xml:"rtt-average"`
+		RTTStddev         string `xml:"rtt-stddev"`
+	} `xml:"ping-results>probe-results-summary"`
+	Failure *string `xml:"ping-results>ping-failure"`
+}
+
+// TracerouteOptions: options of the `traceroute` RPC.
+type TracerouteOptions struct {
+	Host            string
+	Source          string
+	RoutingInstance string
+	Interface       string
+	TTL             int64
+	Wait            int64
+	NoResolve       bool
+}
+
+// TracerouteResult: hops of a traceroute with the RTT in milliseconds.
+type TracerouteResult struct {
+	TargetIP string
+	// Success: the target has been reached.
+	Success bool
+	Hops    []TracerouteHop
+}
+
+// TracerouteHop: addresses that answered to the probes with a TTL and their average RTT.
+type TracerouteHop struct {
+	TTL        int64
+	Addresses  []string
+	RTTAverage float64
+}
+
+type tracerouteReply struct {
+	TargetIP string `xml:"traceroute-results>target-ip"`
+	Hops     []struct {
+		TTL    string `xml:"ttl-value"`
+		Probes []struct {
+			IPAddress string `xml:"ip-address"`
+			RTT       string `xml:"rtt"`
+		} `xml:"probe-result"`
+	} `xml:"traceroute-results>hop"`
+	Success *struct{} `xml:"traceroute-results>traceroute-success"`
+}
+
+func (opts PingOptions) rpcElements() (string, error) {
+	var elements strings.Builder
+	if err := writeXMLElement(&elements, "host", opts.Host); err != nil {
+		return "", err
+	}
+	if opts.Count > 0 {
+		_ = writeXMLElement(&elements, "count", strconv.FormatInt(opts.Count, 10))
+	}
+	if opts.Size != nil {
+		_ = writeXMLElement(&elements, "size", strconv.FormatInt(*opts.Size, 10))
+	}
+	for _, element := range [][2]string{
+		{"source", opts.Source},
+		{"routing-instance", opts.RoutingInstance},
+		{"interface", opts.Interface},
+	} {
+		if element[1] == "" {
+			continue
+		}
+		if err := writeXMLElement(&elements, element[0], element[1]); err != nil {
+			return "", err
+		}
+	}
+	if opts.DoNotFragment {
+		elements.WriteString("<do-not-fragment/>")
+	}
+	elements.WriteString("<rapid/>")
+
+	return elements.String(), nil
+}
+
+func (opts TracerouteOptions) rpcElements() (string, error) {
+	var elements strings.Builder
+	if err := writeXMLElement(&elements, "host", opts.Host); err != nil {
+		return "", err
+	}
+	for _, element := range [][2]string{
+		{"source", opts.Source},
+		{"routing-instance", opts.RoutingInstance},
+		{"interface", opts.Interface},
+	} {
+		if element[1] == "" {
+			continue
+		}
+		if err := writeXMLElement(&elements, element[0], element[1]); err != nil {
+			return "", err
+		}
+	}
+	if opts.TTL > 0 {
+		_ = writeXMLElement(&elements, "ttl", strconv.FormatInt(opts.TTL, 10))
+	}
+	if opts.Wait > 0 {
+		_ = writeXMLElement(&elements, "wait", strconv.FormatInt(opts.Wait, 10))
+	}
+	if opts.NoResolve {
+		elements.WriteString("<no-resolve/>")
+	}
+
+	return elements.String(), nil
+}
+
+// Ping sends the `ping` RPC (in rapid mode) and returns the statistics.
+func (sess *Session) Ping(ctx context.Context, opts PingOptions) (PingResult, error) {
+	var result PingResult
+	if opts.Host == "" {
+		return result, errors.New("internal error: ping without host")
+	}
+	elements, err := opts.rpcElements()
+	if err != nil {
+		return result, err
+	}
+	reply, err := sess.CommandXML(ctx, fmt.Sprintf(rpcPing, elements))
+	if err != nil {
+		return result, fmt.Errorf("ping %s: %w", opts.Host, err)
+	}
+	var ping pingReply
+	if err := xml.Unmarshal([]byte("<reply>"+reply+"</reply>"), &ping); err != nil {
+		return result, fmt.Errorf("unmarshaling xml reply of ping: %w", err)
+	}
+	if ping.Summary == nil {
+		if ping.Failure != nil && strings.TrimSpace(*ping.Failure) != "" {
+			return result, fmt.Errorf("ping %s: %s", opts.Host, strings.TrimSpace(*ping.Failure))
+		}
+
+		return result, fmt.Errorf("ping %s: no statistics in reply", opts.Host)
+	}
+	result.TargetIP = strings.TrimSpace(ping.TargetIP)
+	result.ProbesSent = parseReplyInt(ping.Summary.ProbesSent)
+	result.ResponsesReceived = parseReplyInt(ping.Summary.ResponsesReceived)
+	result.PacketLoss = parseReplyFloat(ping.Summary.PacketLoss)
+	// RTT in reply is in microseconds
+	result.RTTMinimum = parseReplyFloat(ping.Summary.RTTMinimum) / 1000
+	result.RTTMaximum = parseReplyFloat(ping.Summary.RTTMaximum) / 1000
+	result.RTTAverage = parseReplyFloat(ping.Summary.RTTAverage) / 1000
+	result.RTTStddev = parseReplyFloat(ping.Summary.RTTStddev) / 1000
+
+	return result, nil
+}
+
+// Traceroute sends the `traceroute` RPC and returns the hops.
+func (sess *Session) Traceroute(ctx context.Context, opts TracerouteOptions) (TracerouteResult, error) {
+	var result TracerouteResult
+	if opts.Host == "" {
+		return result, errors.New("internal error: traceroute without host")
+	}
+	elements, err := opts.rpcElements()
+	if err != nil {
+		return result, err
+	}
+	reply, err := sess.CommandXML(ctx, fmt.Sprintf(rpcTraceroute, elements))
+	if err != nil {
+		return result, fmt.Errorf("traceroute %s: %w", opts.Host, err)
+	}
+	var traceroute tracerouteReply
+	if err := xml.Unmarshal([]byte("<reply>"+reply+"</reply>"), &traceroute); err != nil {
+		return result, fmt.Errorf("unmarshaling xml reply of traceroute: %w", err)
+	}
+	result.TargetIP = strings.TrimSpace(traceroute.TargetIP)
+	result.Success = traceroute.Success != nil
+	for _, hop := range traceroute.Hops {
+		resultHop := TracerouteHop{
+			TTL:       parseReplyInt(hop.TTL),
+			Addresses: make([]string, 0),
+		}
+		rttCount := 0
+		for _, probe := range hop.Probes {
+			if v := strings.TrimSpace(probe.IPAddress); v != "" && !slices.Contains(resultHop.Addresses, v) {
+				resultHop.Addresses = append(resultHop.Addresses, v)
+			}
+			if v := strings.TrimSpace(probe.RTT); v != "" {
+				resultHop.RTTAverage += parseReplyFloat(v) / 1000
+				rttCount++
+			}
+		}
+		if rttCount > 0 {
+			resultHop.RTTAverage /= float64(rttCount)
+		}
+		result.Hops = append(result.Hops, resultHop)
+	}
+
+	return result, nil
+}
+
+func parseReplyInt(value string) int64 {
+	v, _ := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+
+	return v
+}
+
+func parseReplyFloat(value string) float64 {
+	v, _ := strconv.ParseFloat(strings.TrimSpace(value), 64)
+
+	return v
+}
@@ -0,0 +1,64 @@
+package junos
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const cmdShowRescueConfig = "show system configuration rescue"
+
+// noRescueConfigRegexp: error of device without rescue configuration.
+//
+//nolint:gochecknoglobals
+var noRescueConfigRegexp = regexp.MustCompile(`(?i)no rescue configuration|rescue configuration is not set`)
+
+// RescueConfigSave saves the active committed configuration as rescue configuration
+// (`request system configuration rescue save`).
+func (sess *Session) RescueConfigSave(ctx context.Context) error {
+	if _, err := sess.CommandXML(ctx, rpcRequestSaveRescueConfiguration); err != nil {
+		return fmt.Errorf("saving rescue configuration: %w", err)
+	}
+
+	return nil
+}
+
+// RescueConfigDelete deletes the rescue configuration
+// (`request system configuration rescue delete`).
+func (sess *Session) RescueConfigDelete(ctx context.Context) error {
+	if _, err := sess.CommandXML(ctx, rpcRequestDeleteRescueConfiguration); err != nil {
+		return fmt.Errorf("deleting rescue configuration: %w", err)
+	}
+
+	return nil
+}
+
+// RescueConfigGet returns the rescue configuration in format (ConfigFormatSet or ConfigFormatText)
+// or an empty string if the device has no rescue configuration.
+func (sess *Session) RescueConfigGet(ctx context.Context, format string) (string, error) {
+	var output string
+	var err error
+	switch format {
+	case ConfigFormatSet:
+		output, err = sess.CommandFormat(ctx, cmdShowRescueConfig+PipeDisplaySet, ConfigFormatText)
+	case ConfigFormatText:
+		output, err = sess.RPCFormat(ctx, rpcGetRescueInformation, ConfigFormatText)
+	default:
+		return "", fmt.Errorf("unknown format %q to get rescue configuration", format)
+	}
+	if err != nil {
+		if noRescueConfigRegexp.MatchString(err.Error()) {
+			return "", nil
+		}
+
+		return "", fmt.Errorf("getting rescue configuration: %w", err)
+	}
+	output = strings.TrimSpace(output)
+	// message (not an error) of some devices
+	if !strings.Contains(output, "\n") && noRescueConfigRegexp.MatchString(output) {
+		return "", nil
+	}
+
+	return output, nil
+}
@@ -0,0 +1,155 @@
+package junos
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jeremmfr/go-netconf/netconf"
+)
+
+// ShutdownOptions: options of the `request system reboot` and `request system halt` RPCs.
+type ShutdownOptions struct {
+	// In: delay (in minutes) before the shutdown (0 for now).
+	In int64
+	// At: time of shutdown (like `yymmddhhmm` or `hh:mm`).
+	At string
+	// Member: member of virtual chassis (all members if nil).
+	Member *int64
+	// Node: node of chassis cluster (like `local`, `all`, `0`, `1`).
+	Node string
+	// BothRoutingEngines: shutdown both routing engines.
+	BothRoutingEngines bool
+	// Message: message to display to all system users.
+	Message string
+}
+
+// Scheduled returns if the shutdown is scheduled later and not done immediately.
+func (opts ShutdownOptions) Scheduled() bool {
+	return opts.In > 0 || opts.At != ""
+}
+
+func (opts ShutdownOptions) rpcElements() (string, error) {
+	var elements strings.Builder
+	if opts.BothRoutingEngines {
+		elements.WriteString("<both-routing-engines/>")
+	}
+	if opts.In > 0 {
+		_ = writeXMLElement(&elements, "in", strconv.FormatInt(opts.In, 10))
+	}
+	if opts.At != "" {
+		if err := writeXMLElement(&elements, "at", opts.At); err != nil {
+			return "", err
+		}
+	}
+	if opts.Member != nil {
+		_ = writeXMLElement(&elements, "member", strconv.FormatInt(*opts.Member, 10))
+	}
+	if opts.Node != "" {
+		if err := writeXMLElement(&elements, "node", opts.Node); err != nil {
+			return "", err
+		}
+	}
+	if opts.Message != "" {
+		if err := writeXMLElement(&elements, "message", opts.Message); err != nil {
+			return "", err
+		}
+	}
+
+	return elements.String(), nil
+}
+
+// Reboot reboots the device (`request system reboot`) and returns the status of request.
+//
+// The RPC is not retried (to not reboot twice).
+func (sess *Session) Reboot(ctx context.Context, opts ShutdownOptions) (string, error) {
+	return sess.requestShutdown(ctx, "Reboot", rpcRequestReboot, opts)
+}
+
+// Halt halts the device (`request system halt`) and returns the status of request.
+//
+// The RPC is not retried (to not halt twice).
+func (sess *Session) Halt(ctx context.Context, opts ShutdownOptions) (string, error) {
+	return sess.requestShutdown(ctx, "Halt", rpcRequestHalt, opts)
+}
+
+func (sess *Session) requestShutdown(ctx context.Context, name, rpcFormat string, opts ShutdownOptions) (string, error) {
+	if sess.offline != nil {
+		return "", errors.New(strings.ToLower(name) + " not supported with offline configuration")
+	}
+	elements, err := opts.rpcElements()
+	if err != nil {
+		return "", err
+	}
+	rpc := fmt.Sprintf(rpcFormat, elements)
+	sess.logFile(fmt.Sprintf("[%s] rpc: %q", name, rpc))
+	reply, err := sess.netconfExec(ctx, netconf.RawMethod(rpc))
+	if err != nil && !isRPCErrorReply(reply, err) {
+		sess.logFile(fmt.Sprintf("[%s] err: %q", name, err))
+
+		return "", fmt.Errorf("executing request %s: %w", strings.ToLower(name), err)
+	}
+	errs := make([]string, 0)
+	for _, m := range reply.Errors {
+		if m.Severity == errorSeverity {
+			errs = append(errs, m.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return "", errors.New(strings.Join(errs, "\n"))
+	}
+	var result requestShutdownReply
+	if err := xml.Unmarshal([]byte("<reply>"+reply.Data+"</reply>"), &result); err != nil {
+		return "", fmt.Errorf("unmarshaling xml reply of request %s: %w", strings.ToLower(name), err)
+	}
+	status := strings.TrimSpace(strings.Join(append(result.Status, result.Output...), "\n"))
+	sess.logFile(fmt.Sprintf("[%s] status: %q", name, status))
+
+	return status, nil
+}
+
+// SystemBootedTime returns the time of the last boot of device (`show system uptime`),
+// of the first routing engine in reply for a device with multiple routing engines.
+//
+// Used to detect with WaitReconnect that the device has really rebooted.
+func (sess *Session) SystemBootedTime(ctx context.Context) (string, error) {
+	if sess.offline != nil {
+		return "", errors.New("system uptime not supported with offline configuration")
+	}
+	reply, err := sess.CommandXML(ctx, rpcGetSystemUptimeInformation)
+	if err != nil {
+		return "", err
+	}
+
+	return readSystemBootedTime(reply)
+}
+
+// readSystemBootedTime returns the <date-time> of the first <system-booted-time> in the reply
+// of the get-system-uptime-information RPC.
+func readSystemBootedTime(reply string) (string, error) {
+	decoder := xml.NewDecoder(strings.NewReader(reply))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return "", fmt.Errorf("system-booted-time not found in reply of get-system-uptime-information: %w", err)
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "system-booted-time" {
+			continue
+		}
+		var bootedTime struct {
+			DateTime string `xml:"date-time"`
+		}
+		if err := decoder.DecodeElement(&bootedTime, &start); err != nil {
+			return "", fmt.Errorf("decoding system-booted-time in reply of get-system-uptime-information: %w", err)
+		}
+		if v := strings.TrimSpace(bootedTime.DateTime); v != "" {
+			return v, nil
+		}
+
+		return "", errors.New("empty system-booted-time in reply of get-system-uptime-information")
+	}
+}
@@ -0,0 +1,165 @@
+package junos
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jeremmfr/go-netconf/netconf"
+)
+
+// SoftwareAddOptions: options of the `request system software add` RPC.
+type SoftwareAddOptions struct {
+	// Validate: validate the package against the current configuration
+	// (`validate` if true, `no-validate` if false, default of device if nil).
+	Validate *bool
+	// NoCopy: don't save a copy of the package.
+	NoCopy bool
+	// Reboot: reboot the device after the installation.
+	Reboot bool
+}
+
+// SoftwareAdd installs the software package (path on device) with the `request system software add` RPC
+// and returns the output of installation.
+//
+// The RPC is not retried (to not install twice) and is only limited by ctx, not by the RPC timeout of session,
+// as the installation takes much longer than the other RPCs.
+func (sess *Session) SoftwareAdd(ctx context.Context, pkg string, opts SoftwareAddOptions) (string, error) {
+	if sess.offline != nil {
+		return "", errors.New("software installation not supported with offline configuration")
+	}
+	var options strings.Builder
+	if opts.Validate != nil {
+		if *opts.Validate {
+			options.WriteString("<validate/>")
+		} else {
+			options.WriteString("<no-validate/>")
+		}
+	}
+	if opts.NoCopy {
+		options.WriteString("<no-copy/>")
+	}
+	if opts.Reboot {
+		options.WriteString("<reboot/>")
+	}
+	var escaped strings.Builder
+	if err := xml.EscapeText(&escaped, []byte(pkg)); err != nil {
+		return "", fmt.Errorf("escaping package %q: %w", pkg, err)
+	}
+	rpc := fmt.Sprintf(rpcRequestPackageAdd, options.String(), escaped.String())
+
+	rpcTimeout := sess.rpcTimeout
+	sess.rpcTimeout = 0
+	defer func() { sess.rpcTimeout = rpcTimeout }()
+	sess.logFile(fmt.Sprintf("[SoftwareAdd] rpc: %q", rpc))
+	reply, err := sess.netconfExec(ctx, netconf.RawMethod(rpc))
+	if err != nil && !isRPCErrorReply(reply, err) {
+		sess.logFile(fmt.Sprintf("[SoftwareAdd] err: %q", err))
+
+		return "", fmt.Errorf("executing request-package-add: %w", err)
+	}
+	var result packageAddReply
+	if err := xml.Unmarshal([]byte("<reply>"+reply.Data+"</reply>"), &result); err != nil {
+		return "", fmt.Errorf("unmarshaling xml reply of request-package-add: %w", err)
+	}
+	output := strings.TrimSpace(strings.Join(result.Output, "\n"))
+	sess.logFile(fmt.Sprintf("[SoftwareAdd] output: %q", output))
+	errs := make([]string, 0)
+	for _, m := range reply.Errors {
+		if m.Severity == errorSeverity {
+			errs = append(errs, m.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return output, errors.New(strings.Join(errs, "\n"))
+	}
+	if v := strings.TrimSpace(result.Result); v != "" && v != "0" {
+		return output, fmt.Errorf("installation of package %q failed (result %s)", pkg, v)
+	}
+
+	return output, nil
+}
+
+// WaitReconnect reconnects the session after a reboot of device:
+// waits interval before each try to reconnect (each one with the retries of connection of client)
+// until success or ctx done, and calls progress (if not nil) with the error of each failed try.
+//
+// A reconnection is only accepted when the device has really rebooted,
+// to not reconnect to the device still available just after the request of reboot:
+//   - with bootedTime (the time of the last boot read with SystemBootedTime before the request),
+//     when the time of the last boot has changed,
+//   - without bootedTime (not available), after a failed try to reconnect (device down).
+func (sess *Session) WaitReconnect(
+	ctx context.Context, interval time.Duration, bootedTime string, progress func(error),
+) error {
+	if sess.client == nil {
+		return errors.New("internal error: reconnect session without client")
+	}
+	// the device is rebooting, the session can't be closed properly
+	sess.abortNetconf()
+	var lastErr error
+	deviceDown := false
+	for {
+		select {
+		case <-ctx.Done():
+			if lastErr != nil {
+				return fmt.Errorf("device not available (%w): %w", ctx.Err(), lastErr)
+			}
+
+			return fmt.Errorf("device not available: %w", ctx.Err())
+		case <-time.After(interval):
+		}
+		if err := sess.reconnect(ctx); err != nil {
+			deviceDown = true
+			lastErr = err
+		} else if lastErr = sess.checkRebooted(ctx, bootedTime, deviceDown); lastErr == nil {
+			sess.logFile("[WaitReconnect] session reconnected")
+
+			return nil
+		} else {
+			// still the session to device before the reboot
+			sess.abortNetconf()
+		}
+		sess.logFile(fmt.Sprintf("[WaitReconnect] reconnect failed: %q", lastErr))
+		if progress != nil {
+			progress(lastErr)
+		}
+	}
+}
+
+// checkRebooted checks, after a reconnection, that the device has rebooted
+// since the time of boot bootedTime (or if the device was down without bootedTime).
+func (sess *Session) checkRebooted(ctx context.Context, bootedTime string, deviceDown bool) error {
+	if bootedTime == "" {
+		if !deviceDown {
+			return errors.New("device not yet rebooted (still available since the request)")
+		}
+
+		return nil
+	}
+	reply, err := sess.netconfCommandXML(ctx, rpcGetSystemUptimeInformation)
+	if err == nil {
+		var currentBootedTime string
+		currentBootedTime, err = readSystemBootedTime(reply)
+		if err == nil && currentBootedTime == bootedTime {
+			return fmt.Errorf("device not yet rebooted (booted time %s unchanged)", bootedTime)
+		}
+	}
+	if err != nil && !deviceDown {
+		return fmt.Errorf("reading booted time after reconnect: %w", err)
+	}
+
+	return nil
+}
+
+// abortNetconf closes the transport of the netconf session (if not already done)
+// without closing the session properly.
+func (sess *Session) abortNetconf() {
+	if sess.HasNetconf() && !sess.netconfAborted {
+		sess.netconfAborted = true
+		_ = sess.netconf.Transport.Close()
+	}
+}
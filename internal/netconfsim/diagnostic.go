@@ -0,0 +1,130 @@
+package netconfsim
+
+import (
+	"encoding/xml"
+	"strconv"
+	"strings"
+)
+
+// gatewayAddress: address of first hop of traceroute.
+const gatewayAddress = "192.0.2.254"
+
+type rpcPing struct {
+	Host  string `xml:"host"`
+	Count int    `xml:"count"`
+	Size  *int   `xml:"size"`
+}
+
+// ping answers to ping with the packet loss of host in PingLoss of Config.
+func (srv *Server) ping(op *rpcOperation) string {
+	var request rpcPing
+	if err := xml.Unmarshal([]byte("<request>"+op.Inner+"</request>"), &request); err != nil {
+		return rpcError("protocol", "malformed-message", "error", err.Error())
+	}
+	if request.Host == "" {
+		return rpcError("protocol", "missing-element", "error", "missing mandatory argument: host")
+	}
+	count := request.Count
+	if count == 0 {
+		count = 5
+	}
+	size := 56
+	if request.Size != nil {
+		size = *request.Size
+	}
+	loss, ok := srv.config.PingLoss[request.Host]
+	if !ok {
+		loss = 100
+	}
+	received := count - count*loss/100
+
+	var output strings.Builder
+	output.WriteString("<ping-results>" +
+		"<target-host>" + xmlEscape(request.Host) + "</target-host>" +
+		"<target-ip>" + xmlEscape(request.Host) + "</target-ip>" +
+		"<packet-size>" + strconv.Itoa(size) + "</packet-size>")
+	// RTT in microseconds: 1000, 1100, 1200, ...
+	rttSum := 0
+	for i := range received {
+		rtt := 1000 + i*100
+		rttSum += rtt
+		output.WriteString("<probe-result>" +
+			"<probe-index>" + strconv.Itoa(i+1) + "</probe-index><probe-success/>" +
+			"<ip-address>" + xmlEscape(request.Host) + "</ip-address>" +
+			"<rtt>" + strconv.Itoa(rtt) + "</rtt>" +
+			"</probe-result>")
+	}
+	output.WriteString("<probe-results-summary>" +
+		"<probes-sent>" + strconv.Itoa(count) + "</probes-sent>" +
+		"<responses-received>" + strconv.Itoa(received) + "</responses-received>" +
+		"<packet-loss>" + strconv.Itoa(100-received*100/count) + "</packet-loss>")
+	if received > 0 {
+		output.WriteString("<rtt-minimum>1000</rtt-minimum>" +
+			"<rtt-maximum>" + strconv.Itoa(1000+(received-1)*100) + "</rtt-maximum>" +
+			"<rtt-average>" + strconv.Itoa(rttSum/received) + "</rtt-average>" +
+			"<rtt-stddev>0</rtt-stddev>")
+	}
+	output.WriteString("</probe-results-summary>")
+	if received > 0 {
+		output.WriteString("<ping-success/>")
+	}
+	output.WriteString("</ping-results>")
+
+	return output.String()
+}
+
+type rpcTraceroute struct {
+	Host string `xml:"host"`
+	TTL  int    `xml:"ttl"`
+}
+
+// traceroute answers to traceroute with the gateway as first hop then the host if it answers to ping
+// (in PingLoss of Config with a loss lower than 100) or hops without answer.
+func (srv *Server) traceroute(op *rpcOperation) string {
+	var request rpcTraceroute
+	if err := xml.Unmarshal([]byte("<request>"+op.Inner+"</request>"), &request); err != nil {
+		return rpcError("protocol", "malformed-message", "error", err.Error())
+	}
+	if request.Host == "" {
+		return rpcError("protocol", "missing-element", "error", "missing mandatory argument: host")
+	}
+	maxHops := 3
+	if request.TTL > 0 && request.TTL < maxHops {
+		maxHops = request.TTL
+	}
+	loss, ok := srv.config.PingLoss[request.Host]
+	reachable := ok && loss < 100
+
+	var output strings.Builder
+	output.WriteString("<traceroute-results>" +
+		"<target-host>" + xmlEscape(request.Host) + "</target-host>" +
+		"<target-ip>" + xmlEscape(request.Host) + "</target-ip>" +
+		"<max-hops>" + strconv.Itoa(maxHops) + "</max-hops>")
+	for ttl := 1; ttl <= maxHops; ttl++ {
+		address := ""
+		switch {
+		case ttl == 1:
+			address = gatewayAddress
+		case reachable:
+			address = request.Host
+		}
+		output.WriteString("<hop><ttl-value>" + strconv.Itoa(ttl) + "</ttl-value>")
+		for probe := 1; probe <= 3; probe++ {
+			output.WriteString("<probe-result><probe-index>" + strconv.Itoa(probe) + "</probe-index>")
+			if address != "" {
+				output.WriteString("<ip-address>" + xmlEscape(address) + "</ip-address>" +
+					"<rtt>" + strconv.Itoa(ttl*1000) + "</rtt>")
+			}
+			output.WriteString("</probe-result>")
+		}
+		output.WriteString("</hop>")
+		if reachable && address == request.Host {
+			output.WriteString("<traceroute-success/>")
+
+			break
+		}
+	}
+	output.WriteString("</traceroute-results>")
+
+	return output.String()
+}
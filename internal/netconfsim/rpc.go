@@ -0,0 +1,507 @@
+package netconfsim
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"html"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/configtree"
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+)
+
+type rpcMessage struct {
+	XMLName   xml.Name `xml:"rpc"`
+	MessageID string   `xml:"message-id,attr"`
+	Inner     string   `xml:",innerxml"`
+}
+
+// rpcOperation is the first element in a rpc message.
+type rpcOperation struct {
+	XMLName xml.Name
+	Attrs   []xml.Attr `xml:",any,attr"`
+	Inner   string     `xml:",innerxml"`
+}
+
+func (op *rpcOperation) attr(name string) string {
+	for _, attr := range op.Attrs {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+
+	return ""
+}
+
+type rpcCommitConfiguration struct {
+	Log            string    `xml:"log"`
+	Confirmed      *struct{} `xml:"confirmed"`
+	ConfirmTimeout int       `xml:"confirm-timeout"`
+	Check          *struct{} `xml:"check"`
+	Synchronize    *struct{} `xml:"synchronize"`
+}
+
+// handleRPC returns the reply to a rpc message
+// and if the session need to be closed.
+func (srv *Server) handleRPC(sessionID int, msg []byte) (string, bool) {
+	var rpc rpcMessage
+	if err := xml.Unmarshal(msg, &rpc); err != nil {
+		return rpcReply("", rpcError("protocol", "malformed-message", "error", err.Error())), false
+	}
+	var op rpcOperation
+	if err := xml.Unmarshal([]byte(rpc.Inner), &op); err != nil {
+		return rpcReply(rpc.MessageID, rpcError("protocol", "malformed-message", "error", err.Error())), false
+	}
+
+	reply, closeSession := srv.handleOperation(sessionID, rpc.MessageID, &op)
+	if reply == "" {
+		return reply, closeSession
+	}
+	if message, ok := srv.config.RPCWarnings[op.XMLName.Local]; ok {
+		// the warning is the first element after the start tag of <rpc-reply>
+		start, data, _ := strings.Cut(reply, ">")
+		reply = start + ">" + rpcError("application", "warning", "warning", message) + data
+	}
+
+	return reply, closeSession
+}
+
+// handleOperation returns the reply to the operation of a rpc message
+// and if the session need to be closed (without reply if empty).
+func (srv *Server) handleOperation(sessionID int, messageID string, op *rpcOperation) (string, bool) {
+	if op.XMLName.Local == "command" && srv.config.CommandDelay > 0 {
+		time.Sleep(srv.config.CommandDelay)
+	}
+
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+
+	srv.rpcCounts[op.XMLName.Local]++
+	switch op.XMLName.Local {
+	case "get-system-information":
+		return rpcReply(messageID, srv.systemInformation()), false
+	case "get-route-engine-information":
+		return rpcReply(messageID, srv.routeEngineInformation()), false
+	case "get-software-information":
+		return rpcReply(messageID, srv.softwareInformation()), false
+	case "get-vlan-information":
+		return rpcReply(messageID, srv.vlanInformation()), false
+	case "command":
+		cmd := html.UnescapeString(strings.TrimSpace(op.Inner))
+		if srv.commandFails(cmd) {
+			if slices.Contains(srv.config.CommandCloses, cmd) {
+				return "", true
+			}
+			if message, ok := srv.config.CommandErrors[cmd]; ok {
+				return rpcReply(messageID, rpcError("application", "operation-failed", "error", message)), false
+			}
+		}
+
+		return rpcReply(messageID, srv.command(cmd, op.attr("format"))), false
+	case "load-configuration":
+		return rpcReply(messageID, srv.loadConfiguration(sessionID, op)), false
+	case "lock":
+		if srv.lockedBy != 0 && srv.lockedBy != sessionID {
+			return rpcReply(messageID, rpcError("protocol", "lock-denied", "error",
+				"configuration database locked by session "+strconv.Itoa(srv.lockedBy))), false
+		}
+		srv.lockedBy = sessionID
+
+		return rpcReply(messageID, "<ok/>"), false
+	case "unlock":
+		if srv.lockedBy != sessionID {
+			return rpcReply(messageID, rpcError("protocol", "operation-failed", "error",
+				"configuration database not locked by this session")), false
+		}
+		srv.lockedBy = 0
+
+		return rpcReply(messageID, "<ok/>"), false
+	case "discard-changes":
+		if srv.lockedBy != 0 && srv.lockedBy != sessionID {
+			return rpcReply(messageID, rpcError("protocol", "lock-denied", "error",
+				"configuration database locked by session "+strconv.Itoa(srv.lockedBy))), false
+		}
+		srv.candidate = srv.committed.Clone()
+
+		return rpcReply(messageID, "<ok/>"), false
+	case "commit-configuration":
+		return rpcReply(messageID, srv.commitConfiguration(sessionID, op)), false
+	case "request-package-add":
+		return rpcReply(messageID, srv.packageAdd(op)), false
+	case "request-reboot":
+		return rpcReply(messageID, srv.requestShutdown(op, false)), false
+	case "request-halt":
+		return rpcReply(messageID, srv.requestShutdown(op, true)), false
+	case "request-save-rescue-configuration":
+		srv.rescue = srv.committed.Clone()
+
+		return rpcReply(messageID, "<ok/>"), false
+	case "request-delete-rescue-configuration":
+		srv.rescue = nil
+
+		return rpcReply(messageID, "<ok/>"), false
+	case "ping":
+		return rpcReply(messageID, srv.ping(op)), false
+	case "traceroute":
+		return rpcReply(messageID, srv.traceroute(op)), false
+	case "get-sha256-checksum-information":
+		return rpcReply(messageID, srv.sha256Checksum(op)), false
+	case "get-configuration":
+		return rpcReply(messageID, srv.getConfiguration(op)), false
+	case "close-session":
+		srv.releaseLockLocked(sessionID)
+
+		return rpcReply(messageID, "<ok/>"), true
+	default:
+		if output, ok := srv.config.RPCs[op.XMLName.Local]; ok {
+			format := op.attr("format")
+			if format == "" {
+				format = junos.ConfigFormatXML
+			}
+
+			return rpcReply(messageID, operationalOutput(output, format, true)), false
+		}
+		// time of boot updated by reboots when not in RPCs of Config
+		if op.XMLName.Local == "get-system-uptime-information" {
+			return rpcReply(messageID, srv.systemUptimeInformation()), false
+		}
+
+		return rpcReply(messageID, rpcError("protocol", "operation-not-supported", "error",
+			"syntax error, expecting <command> (rpc "+op.XMLName.Local+" not supported by simulator)")), false
+	}
+}
+
+func (srv *Server) systemInformation() string {
+	return "<system-information>" +
+		"<hardware-model>" + html.EscapeString(srv.config.HardwareModel) + "</hardware-model>" +
+		"<os-name>junos</os-name>" +
+		"<os-version>" + html.EscapeString(srv.config.OSVersion) + "</os-version>" +
+		"<serial-number>SIM0000</serial-number>" +
+		"<host-name>" + html.EscapeString(srv.config.HostName) + "</host-name>" +
+		"</system-information>"
+}
+
+func (srv *Server) softwareInformation() string {
+	version := html.EscapeString(srv.config.OSVersion)
+	packageInfo := "<package-information><name>junos</name>" +
+		"<comment>JUNOS Software Release [" + version + "]</comment></package-information>"
+	if srv.config.Evolved || strings.HasSuffix(srv.config.OSVersion, "-EVO") {
+		packageInfo = "<package-information><name>junos-evo</name>" +
+			"<comment>Junos OS Evolved [" + version + "]</comment></package-information>"
+	}
+
+	return "<software-information>" +
+		"<host-name>" + html.EscapeString(srv.config.HostName) + "</host-name>" +
+		"<product-model>" + html.EscapeString(strings.ToLower(srv.config.HardwareModel)) + "</product-model>" +
+		"<product-name>" + html.EscapeString(strings.ToLower(srv.config.HardwareModel)) + "</product-name>" +
+		"<junos-version>" + version + "</junos-version>" +
+		packageInfo +
+		"</software-information>"
+}
+
+func (srv *Server) routeEngineInformation() string {
+	routeEngines := "<route-engine><slot>0</slot><mastership-state>master</mastership-state></route-engine>"
+	if srv.config.DualRE {
+		routeEngines += "<route-engine><slot>1</slot><mastership-state>backup</mastership-state></route-engine>"
+	}
+
+	return "<route-engine-information>" + routeEngines + "</route-engine-information>"
+}
+
+func (srv *Server) systemUptimeInformation() string {
+	now := time.Now().UTC()
+
+	return "<system-uptime-information>" +
+		"<current-time><date-time junos:seconds=\"" + strconv.FormatInt(now.Unix(), 10) + "\">" +
+		now.Format(time.DateTime) + " UTC</date-time></current-time>" +
+		"<system-booted-time><date-time junos:seconds=\"" + strconv.FormatInt(srv.bootedTime.Unix(), 10) + "\">" +
+		srv.bootedTime.Format(time.DateTime) + " UTC</date-time></system-booted-time>" +
+		"</system-uptime-information>"
+}
+
+func (srv *Server) vlanInformation() string {
+	switch srv.config.SwitchingStyle {
+	case junos.SwitchingELS:
+		return "<l2ng-l2ald-vlan-instance-information>" +
+			"<l2ng-l2ald-vlan-instance-group><l2ng-l2rtb-vlan-name>default</l2ng-l2rtb-vlan-name>" +
+			"<l2ng-l2rtb-vlan-tag>1</l2ng-l2rtb-vlan-tag></l2ng-l2ald-vlan-instance-group>" +
+			"</l2ng-l2ald-vlan-instance-information>"
+	case junos.SwitchingNonELS:
+		return "<vlan-information>" +
+			"<vlan><vlan-name>default</vlan-name><vlan-tag>0</vlan-tag></vlan>" +
+			"</vlan-information>"
+	default:
+		return rpcError("protocol", "operation-not-supported", "error",
+			"syntax error, expecting <command> (rpc get-vlan-information not supported by simulator)")
+	}
+}
+
+// commandFails checks if the command fails with CommandErrors or CommandCloses of Config
+// and counts the failures for CommandFailureCount.
+func (srv *Server) commandFails(cmd string) bool {
+	if _, ok := srv.config.CommandErrors[cmd]; !ok && !slices.Contains(srv.config.CommandCloses, cmd) {
+		return false
+	}
+	srv.commandFailures[cmd]++
+	count, ok := srv.config.CommandFailureCount[cmd]
+
+	return !ok || srv.commandFailures[cmd] <= count
+}
+
+// command answers to `show configuration ... | display set [relative]`
+// from the committed configuration, to `show system configuration rescue | display set`
+// and to the operational commands of Config.
+func (srv *Server) command(cmd, format string) string {
+	if output, ok := srv.config.Commands[cmd]; ok {
+		return operationalOutput(output, format, false)
+	}
+	if cmd == cmdShowRescueConfigSet {
+		if srv.rescue == nil {
+			return rpcError("application", "operation-failed", "error", "No rescue configuration is set.")
+		}
+
+		return "\n<configuration-information>" + configtree.Frame(srv.rescue.String()) + "</configuration-information>\n"
+	}
+	hierarchy, ok := strings.CutPrefix(cmd, junos.CmdShowConfig)
+	if !ok {
+		return rpcError("protocol", "operation-failed", "error",
+			"syntax error (command "+cmd+" not supported by simulator)")
+	}
+	relative := false
+	switch {
+	case strings.HasSuffix(hierarchy, junos.PipeDisplaySetRelative):
+		hierarchy = strings.TrimSuffix(hierarchy, junos.PipeDisplaySetRelative)
+		relative = true
+	case strings.HasSuffix(hierarchy, junos.PipeDisplaySet):
+		hierarchy = strings.TrimSuffix(hierarchy, junos.PipeDisplaySet)
+	default:
+		return rpcError("protocol", "operation-failed", "error",
+			"syntax error (command "+cmd+" not supported by simulator)")
+	}
+
+	output := srv.committed.Render(relative, configtree.SplitWords(hierarchy)...)
+	if output == "" {
+		return ""
+	}
+
+	return "\n<configuration-information>" + configtree.Frame(output) + "</configuration-information>\n"
+}
+
+// operationalOutput returns the reply of an operational command (or RPC with isXML) in format.
+//
+// The text output of a command is answered as is with the xml and json formats
+// and the XML output of a RPC as text in a <output> element with the text format.
+func operationalOutput(output, format string, isXML bool) string {
+	switch format {
+	case junos.ConfigFormatJSON:
+		if isXML {
+			return rpcError("protocol", "operation-not-supported", "error",
+				"json format of rpc not supported by simulator")
+		}
+		data, _ := json.Marshal(map[string]string{"output": output})
+
+		return xmlEscaper.Replace(string(data))
+	case junos.ConfigFormatXML:
+		if isXML {
+			return output
+		}
+	}
+
+	return "\n<output>\n" + html.EscapeString(output) + "</output>\n"
+}
+
+func (srv *Server) loadConfiguration(sessionID int, op *rpcOperation) string {
+	if srv.lockedBy != 0 && srv.lockedBy != sessionID {
+		return rpcError("protocol", "lock-denied", "error",
+			"configuration database locked by session "+strconv.Itoa(srv.lockedBy))
+	}
+	if action := op.attr("action"); action != junos.LoadConfigActionSet {
+		return rpcError("protocol", "operation-not-supported", "error",
+			"load-configuration with action "+action+" not supported by simulator")
+	}
+	var configSet struct {
+		Lines string `xml:"configuration-set"`
+	}
+	if err := xml.Unmarshal([]byte("<load>"+op.Inner+"</load>"), &configSet); err != nil {
+		return rpcError("protocol", "malformed-message", "error", err.Error())
+	}
+
+	// apply line by line like a device:
+	// error for a line not supported, warning for a delete line of statement not found
+	var reply strings.Builder
+	errorCount := 0
+	for line := range strings.SplitSeq(configSet.Lines, "\n") {
+		line = strings.TrimSpace(line)
+		words := configtree.SplitWords(line)
+		switch {
+		case len(words) == 0, strings.HasPrefix(line, "#"):
+			continue
+		case words[0] == "set" && len(words) > 1:
+			srv.candidate.Set(words[1:]...)
+		case words[0] == "delete" && len(words) > 1:
+			if !srv.candidate.Delete(words[1:]...) {
+				reply.WriteString(rpcErrorAt("protocol", "operation-failed", "warning",
+					editPath(words[1:len(words)-1]), words[len(words)-1], "statement not found"))
+			}
+		default:
+			errorCount++
+			reply.WriteString(rpcErrorAt("protocol", "operation-failed", "error",
+				editPath(nil), words[0], "syntax error"))
+		}
+	}
+	if errorCount > 0 {
+		return reply.String() + "<load-configuration-results><load-error-count>" +
+			strconv.Itoa(errorCount) + "</load-error-count></load-configuration-results>"
+	}
+
+	return reply.String() + "<load-configuration-results><ok/></load-configuration-results>"
+}
+
+// editPath returns the <error-path> of a hierarchy like a device (`[edit interfaces ge-0/0/0]`).
+func editPath(words []string) string {
+	if len(words) == 0 {
+		return "[edit]"
+	}
+
+	return "[edit " + configtree.JoinWords(words) + "]"
+}
+
+func (srv *Server) commitConfiguration(sessionID int, op *rpcOperation) string {
+	if srv.lockedBy != 0 && srv.lockedBy != sessionID {
+		return rpcError("protocol", "lock-denied", "error",
+			"configuration database locked by session "+strconv.Itoa(srv.lockedBy))
+	}
+	var commit rpcCommitConfiguration
+	if err := xml.Unmarshal([]byte("<commit>"+op.Inner+"</commit>"), &commit); err != nil {
+		return rpcError("protocol", "malformed-message", "error", err.Error())
+	}
+
+	success := "<commit-results><routing-engine>" +
+		"<name>re0</name><commit-check-success/>" +
+		"</routing-engine></commit-results>"
+	if commit.Synchronize != nil && srv.config.DualRE {
+		success = strings.Replace(success, "</commit-results>", "<routing-engine>"+
+			"<name>re1</name><commit-check-success/>"+
+			"</routing-engine></commit-results>", 1)
+	}
+	if commit.Check != nil {
+		// a commit check confirms a previous commit confirmed
+		if srv.confirmedTimer != nil {
+			srv.confirmedTimer.Stop()
+			srv.confirmedTimer = nil
+			srv.confirmedRollback = nil
+		}
+
+		return success
+	}
+
+	if commit.Confirmed != nil && srv.confirmedTimer == nil {
+		srv.confirmedRollback = srv.committed
+	}
+	srv.committed = srv.candidate.Clone()
+	srv.commitHistory = append(srv.commitHistory, commit.Log)
+	if commit.Confirmed != nil {
+		timeout := time.Duration(max(commit.ConfirmTimeout, 1)) * time.Minute
+		if srv.confirmedTimer != nil {
+			srv.confirmedTimer.Stop()
+		}
+		srv.confirmedTimer = time.AfterFunc(timeout, srv.rollbackConfirmed)
+	}
+
+	return strings.ReplaceAll(success, "<commit-check-success/>", "<commit-success/>")
+}
+
+// rollbackConfirmed restores the configuration before a commit confirmed not confirmed in time.
+func (srv *Server) rollbackConfirmed() {
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+
+	if srv.confirmedRollback == nil {
+		return
+	}
+	srv.committed = srv.confirmedRollback
+	srv.candidate = srv.committed.Clone()
+	srv.confirmedRollback = nil
+	srv.confirmedTimer = nil
+}
+
+func (srv *Server) getConfiguration(op *rpcOperation) string {
+	if op.attr("compare") == "rollback" {
+		return srv.compareConfiguration()
+	}
+	if database := op.attr("database"); database != "" && database != "committed" {
+		return rpcError("protocol", "operation-not-supported", "error",
+			"get-configuration of database "+database+" not supported by simulator")
+	}
+	if format := op.attr("format"); format != junos.ConfigFormatSet {
+		return rpcError("protocol", "operation-not-supported", "error",
+			"get-configuration with format "+format+" not supported by simulator")
+	}
+
+	return "<configuration-set>\n" + xmlEscape(srv.committed.String()) + "</configuration-set>"
+}
+
+// compareConfiguration answers to get-configuration compare (`show | compare`)
+// with the differences between the candidate and the committed configuration on set lines.
+func (srv *Server) compareConfiguration() string {
+	committed := srv.committed.Lines()
+	candidate := srv.candidate.Lines()
+	var diff strings.Builder
+	for _, line := range committed {
+		if !slices.Contains(candidate, line) {
+			diff.WriteString("-  " + line + "\n")
+		}
+	}
+	for _, line := range candidate {
+		if !slices.Contains(committed, line) {
+			diff.WriteString("+  " + line + "\n")
+		}
+	}
+	if diff.Len() == 0 {
+		return ""
+	}
+
+	return "\n<configuration-information>" + configtree.Frame("[edit]\n"+diff.String()) + "</configuration-information>\n"
+}
+
+func rpcReply(messageID, data string) string {
+	return `<rpc-reply xmlns="urn:ietf:params:xml:ns:netconf:base:1.0"` +
+		` xmlns:junos="http://xml.juniper.net/junos/23.4R0/junos"` +
+		fmt.Sprintf(` message-id=%q>`, messageID) +
+		data +
+		`</rpc-reply>`
+}
+
+func rpcError(errType, tag, severity, message string) string {
+	return "<rpc-error>" +
+		"<error-type>" + errType + "</error-type>" +
+		"<error-tag>" + tag + "</error-tag>" +
+		"<error-severity>" + severity + "</error-severity>" +
+		"<error-message>" + html.EscapeString(message) + "</error-message>" +
+		"</rpc-error>"
+}
+
+// rpcErrorAt returns a rpc-error with the <error-path> and the <bad-element> concerned.
+func rpcErrorAt(errType, tag, severity, errPath, badElement, message string) string {
+	return "<rpc-error>" +
+		"<error-type>" + errType + "</error-type>" +
+		"<error-tag>" + tag + "</error-tag>" +
+		"<error-severity>" + severity + "</error-severity>" +
+		"<error-path>" + html.EscapeString(errPath) + "</error-path>" +
+		"<error-message>" + html.EscapeString(message) + "</error-message>" +
+		"<error-info><bad-element>" + html.EscapeString(badElement) + "</bad-element></error-info>" +
+		"</rpc-error>"
+}
+
+const cmdShowRescueConfigSet = "show system configuration rescue" + junos.PipeDisplaySet
+
+//nolint:gochecknoglobals
+var xmlEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+func xmlEscape(s string) string {
+	return xmlEscaper.Replace(s)
+}
@@ -0,0 +1,522 @@
+// Package netconfsim provides an in-process NETCONF over SSH server
+// that simulates a Junos device to test the provider without a real device.
+//
+// The simulator keeps a candidate and a committed configuration as set lines and supports
+// the RPCs used by the provider:
+// get-system-information, get-route-engine-information, get-vlan-information,
+// load-configuration (action set), command (show configuration ... | display set,
+// show system configuration rescue | display set or operational commands of Config),
+// operational RPCs of Config, get-sha256-checksum-information,
+// request-package-add, request-reboot, request-halt (with the reboot or the halt of device),
+// request-save-rescue-configuration, request-delete-rescue-configuration, ping, traceroute,
+// lock/unlock of candidate, discard-changes,
+// commit-configuration (with confirmed, check or synchronize),
+// get-configuration (format set or compare with the differences on set lines)
+// and close-session, and the SFTP subsystem with the files of device.
+package netconfsim
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/configtree"
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	msgSeparator = "]]>]]>"
+
+	// DefaultUsername is the username accepted by the server if not set in Config.
+	DefaultUsername = "netconf"
+	// DefaultPassword is the password accepted by the server if not set in Config.
+	DefaultPassword = "netconf"
+	// DefaultHardwareModel is the hardware model of simulated device if not set in Config.
+	DefaultHardwareModel = "vsrx"
+	// DefaultOSVersion is the Junos version of simulated device if not set in Config.
+	DefaultOSVersion = "23.4R1.9"
+)
+
+// Config of simulated device.
+type Config struct {
+	Username      string
+	Password      string
+	HardwareModel string
+	OSVersion     string
+	HostName      string
+	// SwitchingStyle answered by get-vlan-information:
+	// junos.SwitchingELS, junos.SwitchingNonELS or empty for a RPC not supported.
+	SwitchingStyle string
+	// DualRE: get-route-engine-information answers with two routing engines.
+	DualRE bool
+	// Evolved: get-software-information answers with a package of Junos OS Evolved
+	// (also when OSVersion has the -EVO suffix).
+	Evolved bool
+	// InitialConfig is the committed configuration at start, with set lines.
+	InitialConfig string
+	// CommandDelay: time to wait before answering each <command> RPC
+	// (to simulate a device that hangs).
+	CommandDelay time.Duration
+	// Commands: text output of operational commands (other than `show configuration`).
+	Commands map[string]string
+	// CommandErrors: message of the rpc-error (with severity error) answered to operational commands
+	// (to simulate a command that fails).
+	CommandErrors map[string]string
+	// CommandCloses: operational commands closing the connection instead of answering
+	// (to simulate a connection lost).
+	CommandCloses []string
+	// CommandFailureCount: number of first <command> RPCs of an operational command
+	// failing with CommandErrors or CommandCloses before it succeeds (all fail if not set).
+	CommandFailureCount map[string]int
+	// RPCWarnings: message of a rpc-error with severity warning added to the reply of RPCs
+	// by name of element.
+	RPCWarnings map[string]string
+	// RPCs: XML output of operational RPCs by name of element.
+	RPCs map[string]string
+	// FilesDir: local directory with the files of device (a device path like /var/tmp/file
+	// is FilesDir/var/tmp/file) served by the SFTP subsystem and used by the checksum RPC.
+	// The SFTP subsystem is not available if empty.
+	FilesDir string
+	// RebootDelay: time the device is down (new connections closed) after a reboot.
+	RebootDelay time.Duration
+	// ShutdownDelay: time the device is still available after the request of a reboot or a halt
+	// (to simulate a device which takes time to shut down).
+	ShutdownDelay time.Duration
+	// PingLoss: packet loss (in percent) of hosts for the ping and traceroute RPCs.
+	// The hosts not in map don't answer.
+	PingLoss map[string]int
+}
+
+// Server is a NETCONF over SSH server listening on localhost.
+type Server struct {
+	config    Config
+	sshConfig *ssh.ServerConfig
+	listener  net.Listener
+	wg        sync.WaitGroup
+	conns     map[net.Conn]struct{}
+
+	mutex          sync.Mutex
+	candidate      *configtree.Tree
+	committed      *configtree.Tree
+	lockedBy       int
+	lastSessionID  int
+	confirmedTimer *time.Timer
+	// committed configuration to restore if commit confirmed is not confirmed
+	confirmedRollback *configtree.Tree
+	commitHistory     []string
+	// saved rescue configuration (nil if not set)
+	rescue *configtree.Tree
+	// Junos version installed, active after the next reboot
+	pendingOSVersion string
+	rebootPending    bool
+	haltPending      bool
+	downUntil        time.Time
+	bootedTime       time.Time
+	shutdownTimer    *time.Timer
+	// number of RPCs received by name of element
+	rpcCounts map[string]int
+	// number of <command> RPCs received by operational command failing with Config
+	commandFailures map[string]int
+}
+
+// Start starts a new server with config and stops it at the end of test.
+func Start(t testing.TB, config Config) *Server {
+	t.Helper()
+
+	srv, err := NewServer(config)
+	if err != nil {
+		t.Fatalf("starting netconf simulator: %s", err)
+	}
+	t.Cleanup(func() { _ = srv.Close() })
+
+	return srv
+}
+
+// NewServer starts a new server with config.
+//
+// The server need to be stopped with Close.
+func NewServer(config Config) (*Server, error) {
+	if config.Username == "" {
+		config.Username = DefaultUsername
+	}
+	if config.Password == "" {
+		config.Password = DefaultPassword
+	}
+	if config.HardwareModel == "" {
+		config.HardwareModel = DefaultHardwareModel
+	}
+	if config.OSVersion == "" {
+		config.OSVersion = DefaultOSVersion
+	}
+	if config.HostName == "" {
+		config.HostName = "netconfsim"
+	}
+
+	_, hostKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("generating host key: %w", err)
+	}
+	signer, err := ssh.NewSignerFromKey(hostKey)
+	if err != nil {
+		return nil, fmt.Errorf("generating host key signer: %w", err)
+	}
+
+	srv := &Server{
+		config:          config,
+		conns:           make(map[net.Conn]struct{}),
+		rpcCounts:       make(map[string]int),
+		commandFailures: make(map[string]int),
+		bootedTime:      time.Now().UTC().Truncate(time.Second),
+	}
+	srv.committed, err = configtree.Parse(config.InitialConfig)
+	if err != nil {
+		return nil, fmt.Errorf("loading initial configuration: %w", err)
+	}
+	srv.candidate = srv.committed.Clone()
+	srv.sshConfig = &ssh.ServerConfig{
+		PasswordCallback: func(conn ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+			if conn.User() == srv.config.Username && string(password) == srv.config.Password {
+				return &ssh.Permissions{}, nil
+			}
+
+			return nil, errors.New("authentication failed")
+		},
+	}
+	srv.sshConfig.AddHostKey(signer)
+
+	srv.listener, err = net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("listening on localhost: %w", err)
+	}
+
+	srv.wg.Add(1)
+	go srv.serve()
+
+	return srv, nil
+}
+
+// Close stops the server.
+func (srv *Server) Close() error {
+	err := srv.listener.Close()
+	srv.mutex.Lock()
+	for conn := range srv.conns {
+		_ = conn.Close()
+	}
+	srv.mutex.Unlock()
+	srv.wg.Wait()
+	srv.mutex.Lock()
+	if srv.confirmedTimer != nil {
+		srv.confirmedTimer.Stop()
+	}
+	if srv.shutdownTimer != nil {
+		srv.shutdownTimer.Stop()
+	}
+	srv.mutex.Unlock()
+
+	return err
+}
+
+// Host returns the IP address where the server listens.
+func (srv *Server) Host() string {
+	return srv.listener.Addr().(*net.TCPAddr).IP.String()
+}
+
+// Port returns the TCP port where the server listens.
+func (srv *Server) Port() int {
+	return srv.listener.Addr().(*net.TCPAddr).Port
+}
+
+// Username returns the username accepted by the server.
+func (srv *Server) Username() string {
+	return srv.config.Username
+}
+
+// Password returns the password accepted by the server.
+func (srv *Server) Password() string {
+	return srv.config.Password
+}
+
+// Setenv sets the environment variables used by the provider to connect to the server
+// for the duration of test.
+func (srv *Server) Setenv(t testing.TB) {
+	t.Helper()
+
+	t.Setenv(junos.EnvHost, srv.Host())
+	t.Setenv(junos.EnvPort, strconv.Itoa(srv.Port()))
+	t.Setenv(junos.EnvUsername, srv.config.Username)
+	t.Setenv(junos.EnvPassword, srv.config.Password)
+	t.Setenv(junos.EnvKeyPem, "")
+	t.Setenv(junos.EnvKeyFile, "")
+	t.Setenv("SSH_AUTH_SOCK", "")
+	t.Setenv(junos.EnvSleepShort, "0")
+	t.Setenv(junos.EnvSleepLock, "1")
+}
+
+// NewClient returns a new client to connect to the server.
+func (srv *Server) NewClient() *junos.Client {
+	return junos.NewClient(srv.Host()).
+		WithPort(srv.Port()).
+		WithUserName(srv.config.Username).
+		WithPassword(srv.config.Password).
+		WithSleepShort(0)
+}
+
+// CommittedConfig returns the committed configuration with set lines.
+func (srv *Server) CommittedConfig() string {
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+
+	return srv.committed.String()
+}
+
+// CandidateConfig returns the candidate configuration with set lines.
+func (srv *Server) CandidateConfig() string {
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+
+	return srv.candidate.String()
+}
+
+// CommitHistory returns the log messages of commits done.
+func (srv *Server) CommitHistory() []string {
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+
+	return append([]string(nil), srv.commitHistory...)
+}
+
+// RPCCount returns the number of RPCs received with the name of element.
+func (srv *Server) RPCCount(name string) int {
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+
+	return srv.rpcCounts[name]
+}
+
+func (srv *Server) serve() {
+	defer srv.wg.Done()
+
+	for {
+		conn, err := srv.listener.Accept()
+		if err != nil {
+			return
+		}
+		srv.mutex.Lock()
+		srv.conns[conn] = struct{}{}
+		srv.mutex.Unlock()
+		srv.wg.Add(1)
+		go func() {
+			defer srv.wg.Done()
+			defer func() {
+				srv.mutex.Lock()
+				delete(srv.conns, conn)
+				srv.mutex.Unlock()
+			}()
+			srv.handleConn(conn)
+		}()
+	}
+}
+
+func (srv *Server) handleConn(conn net.Conn) {
+	defer conn.Close()
+
+	srv.mutex.Lock()
+	down := time.Now().Before(srv.downUntil)
+	srv.mutex.Unlock()
+	if down {
+		return
+	}
+
+	sshConn, chans, reqs, err := ssh.NewServerConn(conn, srv.sshConfig)
+	if err != nil {
+		return
+	}
+	defer sshConn.Close()
+	go ssh.DiscardRequests(reqs)
+
+	for newChannel := range chans {
+		if newChannel.ChannelType() != "session" {
+			_ = newChannel.Reject(ssh.UnknownChannelType, "unknown channel type")
+
+			continue
+		}
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			continue
+		}
+		go func() {
+			defer channel.Close()
+
+			for req := range requests {
+				if req.Type == "subsystem" && len(req.Payload) > 4 && string(req.Payload[4:]) == "netconf" {
+					_ = req.Reply(true, nil)
+					srv.handleNetconf(channel)
+
+					return
+				}
+				if req.Type == "subsystem" && len(req.Payload) > 4 && string(req.Payload[4:]) == "sftp" &&
+					srv.config.FilesDir != "" {
+					_ = req.Reply(true, nil)
+					srv.handleSFTP(channel)
+
+					return
+				}
+				_ = req.Reply(false, nil)
+			}
+		}()
+	}
+}
+
+func (srv *Server) handleNetconf(channel io.ReadWriter) {
+	srv.mutex.Lock()
+	srv.lastSessionID++
+	sessionID := srv.lastSessionID
+	srv.mutex.Unlock()
+	defer srv.releaseLock(sessionID)
+
+	hello := `<?xml version="1.0" encoding="UTF-8"?>` +
+		`<hello xmlns="urn:ietf:params:xml:ns:netconf:base:1.0"><capabilities>` +
+		`<capability>urn:ietf:params:netconf:base:1.0</capability>` +
+		`<capability>urn:ietf:params:netconf:capability:candidate:1.0</capability>` +
+		`<capability>urn:ietf:params:netconf:capability:confirmed-commit:1.0</capability>` +
+		`<capability>http://xml.juniper.net/netconf/junos/1.0</capability>` +
+		`</capabilities><session-id>` + strconv.Itoa(sessionID) + `</session-id></hello>`
+	if _, err := io.WriteString(channel, hello+msgSeparator); err != nil {
+		return
+	}
+
+	reader := &messageReader{r: channel}
+	// client hello
+	if _, err := reader.next(); err != nil {
+		return
+	}
+	for {
+		msg, err := reader.next()
+		if err != nil {
+			return
+		}
+		reply, closeSession := srv.handleRPC(sessionID, msg)
+		if reply == "" {
+			// connection lost before the reply
+			return
+		}
+		if _, err := io.WriteString(channel, reply+msgSeparator); err != nil {
+			return
+		}
+		if closeSession {
+			return
+		}
+		srv.rebootIfPending()
+	}
+}
+
+// OSVersion returns the Junos version of device (updated after the reboot following a software installation).
+func (srv *Server) OSVersion() string {
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+
+	return srv.config.OSVersion
+}
+
+// rebootIfPending reboots the device if requested by the last RPC
+// (after the ShutdownDelay of Config if set).
+func (srv *Server) rebootIfPending() {
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+
+	if !srv.rebootPending {
+		return
+	}
+	srv.rebootPending = false
+	if srv.config.ShutdownDelay <= 0 {
+		srv.rebootLocked()
+
+		return
+	}
+	halt := srv.haltPending
+	srv.haltPending = false
+	srv.shutdownTimer = time.AfterFunc(srv.config.ShutdownDelay, func() {
+		srv.mutex.Lock()
+		defer srv.mutex.Unlock()
+
+		srv.haltPending = halt
+		srv.rebootLocked()
+	})
+}
+
+// rebootLocked reboots the device with mutex already locked:
+// activates the Junos version installed, closes all connections and keeps the device down
+// for the RebootDelay of Config (or until the server is closed for a halt).
+func (srv *Server) rebootLocked() {
+	if srv.pendingOSVersion != "" {
+		srv.config.OSVersion = srv.pendingOSVersion
+		srv.pendingOSVersion = ""
+	}
+	srv.lockedBy = 0
+	srv.candidate = srv.committed.Clone()
+	srv.downUntil = time.Now().Add(srv.config.RebootDelay)
+	// always a new time of boot, even with a reboot in the same second
+	bootedTime := srv.downUntil.UTC().Truncate(time.Second)
+	if !bootedTime.After(srv.bootedTime) {
+		bootedTime = srv.bootedTime.Add(time.Second)
+	}
+	srv.bootedTime = bootedTime
+	if srv.haltPending {
+		srv.haltPending = false
+		srv.downUntil = time.Now().Add(24 * time.Hour)
+	}
+	for conn := range srv.conns {
+		_ = conn.Close()
+	}
+}
+
+func (srv *Server) releaseLock(sessionID int) {
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+
+	srv.releaseLockLocked(sessionID)
+}
+
+// releaseLockLocked is releaseLock with mutex already locked.
+func (srv *Server) releaseLockLocked(sessionID int) {
+	if srv.lockedBy == sessionID {
+		srv.lockedBy = 0
+		// like a device, discard uncommitted changes when session with lock is closed
+		srv.candidate = srv.committed.Clone()
+	}
+}
+
+// messageReader reads NETCONF 1.0 messages separated by `]]>]]>`.
+type messageReader struct {
+	r   io.Reader
+	buf []byte
+}
+
+func (mr *messageReader) next() ([]byte, error) {
+	chunk := make([]byte, 4096)
+	for {
+		if i := bytes.Index(mr.buf, []byte(msgSeparator)); i >= 0 {
+			msg := bytes.TrimSpace(mr.buf[:i])
+			mr.buf = mr.buf[i+len(msgSeparator):]
+
+			return msg, nil
+		}
+		n, err := mr.r.Read(chunk)
+		if n > 0 {
+			mr.buf = append(mr.buf, chunk[:n]...)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,1084 @@
+package netconfsim_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"html"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/netconfsim"
+)
+
+func TestServerSystemInformation(t *testing.T) {
+	t.Parallel()
+
+	srv := netconfsim.Start(t, netconfsim.Config{
+		HardwareModel: "mx240",
+		OSVersion:     "22.4R3.25",
+	})
+	junSess, err := srv.NewClient().StartNewSession(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer junSess.Close()
+
+	if v := junSess.SystemInformation.HardwareModel; v != "mx240" {
+		t.Errorf("got unexpected hardware model %q", v)
+	}
+	if v := junSess.SystemInformation.OSVersion; v != "22.4R3.25" {
+		t.Errorf("got unexpected os version %q", v)
+	}
+	if !junSess.CheckCompatibilityRouter() {
+		t.Errorf("got unexpected not compatible router")
+	}
+}
+
+func TestServerConfig(t *testing.T) {
+	t.Parallel()
+
+	srv := netconfsim.Start(t, netconfsim.Config{
+		InitialConfig: "set policy-options prefix-list existing 192.0.2.0/25\n",
+	})
+	junSess, err := srv.NewClient().StartNewSession(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer junSess.Close()
+
+	if err := junSess.ConfigLock(t.Context()); err != nil {
+		t.Fatalf("unexpected lock error: %s", err)
+	}
+	if err := junSess.ConfigSet(t.Context(), []string{
+		"set policy-options prefix-list \"testacc\" 192.0.2.128/25",
+		"set policy-options prefix-list \"testacc\" apply-path \"interfaces &lt;*&gt;\"",
+		"delete policy-options prefix-list existing",
+	}); err != nil {
+		t.Fatalf("unexpected set error: %s", err)
+	}
+	showConfig, err := junSess.Command(t.Context(), junos.CmdShowConfig+
+		"policy-options prefix-list \"testacc\""+junos.PipeDisplaySetRelative)
+	if err != nil {
+		t.Fatalf("unexpected command error: %s", err)
+	}
+	if showConfig != junos.EmptyW {
+		t.Errorf("got unexpected output before commit %q", showConfig)
+	}
+
+	warns, err := junSess.CommitConf(t.Context(), "commit from test")
+	if err != nil {
+		t.Fatalf("unexpected commit error: %s", err)
+	}
+	if len(warns) > 0 {
+		t.Errorf("got unexpected commit warnings: %v", warns)
+	}
+	if errs := junSess.ConfigUnlock(t.Context()); len(errs) > 0 {
+		t.Errorf("got unexpected unlock errors: %v", errs)
+	}
+
+	showConfig, err = junSess.Command(t.Context(), junos.CmdShowConfig+
+		"policy-options prefix-list \"testacc\""+junos.PipeDisplaySetRelative)
+	if err != nil {
+		t.Fatalf("unexpected command error: %s", err)
+	}
+	expected := "\n" + junos.XMLStartTagConfigOut + "\n" +
+		"set 192.0.2.128/25\n" +
+		"set apply-path \"interfaces &lt;*&gt;\"\n" +
+		junos.XMLEndTagConfigOut + "\n"
+	if showConfig != expected {
+		t.Errorf("got unexpected output %q, expected %q", showConfig, expected)
+	}
+
+	config, err := junSess.ConfigGet(t.Context(), junos.ConfigFormatSet)
+	if err != nil {
+		t.Fatalf("unexpected get configuration error: %s", err)
+	}
+	if strings.Contains(config, "existing") {
+		t.Errorf("got unexpected deleted line in configuration %q", config)
+	}
+	if !strings.Contains(config, "set policy-options prefix-list testacc 192.0.2.128/25\n") {
+		t.Errorf("got unexpected configuration %q", config)
+	}
+	if history := srv.CommitHistory(); len(history) != 1 || history[0] != "commit from test" {
+		t.Errorf("got unexpected commit history %v", history)
+	}
+}
+
+func TestServerCommitConfirmed(t *testing.T) {
+	t.Parallel()
+
+	srv := netconfsim.Start(t, netconfsim.Config{})
+	client, err := srv.NewClient().WithCommitConfirmed(1)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := client.WithCommitConfirmedWaitPercent(0); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	junSess, err := client.StartNewSession(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer junSess.Close()
+
+	if err := junSess.ConfigLock(t.Context()); err != nil {
+		t.Fatalf("unexpected lock error: %s", err)
+	}
+	if err := junSess.ConfigSet(t.Context(), []string{"set system host-name sim"}); err != nil {
+		t.Fatalf("unexpected set error: %s", err)
+	}
+	if _, err := junSess.CommitConf(t.Context(), "commit confirmed from test"); err != nil {
+		t.Fatalf("unexpected commit error: %s", err)
+	}
+	_ = junSess.ConfigUnlock(t.Context())
+
+	if config := srv.CommittedConfig(); config != "set system host-name sim\n" {
+		t.Errorf("got unexpected committed configuration %q", config)
+	}
+}
+
+func TestServerCommitOptions(t *testing.T) {
+	t.Parallel()
+
+	srv := netconfsim.Start(t, netconfsim.Config{
+		DualRE:        true,
+		InitialConfig: "set system host-name sim\n",
+	})
+	junSess, err := srv.NewClient().StartNewSession(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer junSess.Close()
+
+	if err := junSess.ConfigLock(t.Context()); err != nil {
+		t.Fatalf("unexpected lock error: %s", err)
+	}
+	if err := junSess.ConfigSet(t.Context(), []string{
+		"delete system host-name",
+		"set system host-name sim2",
+	}); err != nil {
+		t.Fatalf("unexpected set error: %s", err)
+	}
+	diff, err := junSess.ConfigCompare(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected compare error: %s", err)
+	}
+	if expected := "[edit]\n-  set system host-name sim\n+  set system host-name sim2"; diff != expected {
+		t.Errorf("got unexpected compare %q, expected %q", diff, expected)
+	}
+	if _, err := junSess.CommitCheck(t.Context()); err != nil {
+		t.Fatalf("unexpected commit check error: %s", err)
+	}
+	if err := junSess.ConfigDiscard(t.Context()); err != nil {
+		t.Fatalf("unexpected discard error: %s", err)
+	}
+	if diff, err := junSess.ConfigCompare(t.Context()); err != nil || diff != "" {
+		t.Errorf("got unexpected compare after discard %q (error %v)", diff, err)
+	}
+	if config := srv.CommittedConfig(); config != "set system host-name sim\n" {
+		t.Errorf("got unexpected committed configuration after check %q", config)
+	}
+
+	if err := junSess.ConfigSet(t.Context(), []string{"set system domain-name example.com"}); err != nil {
+		t.Fatalf("unexpected set error: %s", err)
+	}
+	if _, err := junSess.CommitConfWithOptions(t.Context(), "comment <from> test", junos.CommitOptions{
+		Synchronize:      true,
+		ConfirmedTimeout: 1,
+	}); err != nil {
+		t.Fatalf("unexpected commit error: %s", err)
+	}
+	if _, err := junSess.CommitCheck(t.Context()); err != nil {
+		t.Fatalf("unexpected commit check (to confirm) error: %s", err)
+	}
+	_ = junSess.ConfigUnlock(t.Context())
+
+	if config := srv.CommittedConfig(); config != "set system host-name sim\nset system domain-name example.com\n" {
+		t.Errorf("got unexpected committed configuration %q", config)
+	}
+	if history := srv.CommitHistory(); len(history) != 1 || history[0] != "comment <from> test" {
+		t.Errorf("got unexpected commit history %q", history)
+	}
+}
+
+func TestServerConfigCompareWarning(t *testing.T) {
+	t.Parallel()
+
+	srv := netconfsim.Start(t, netconfsim.Config{
+		InitialConfig: "set system host-name sim\n",
+		RPCWarnings: map[string]string{
+			"get-configuration": "statement has no contents; ignored",
+		},
+	})
+	junSess, err := srv.NewClient().StartNewSession(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer junSess.Close()
+
+	if err := junSess.ConfigLock(t.Context()); err != nil {
+		t.Fatalf("unexpected lock error: %s", err)
+	}
+	defer func() { _ = junSess.ConfigUnlock(t.Context()) }()
+	if err := junSess.ConfigSet(t.Context(), []string{"set system host-name sim2"}); err != nil {
+		t.Fatalf("unexpected set error: %s", err)
+	}
+	diff, err := junSess.ConfigCompare(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected compare error with a warning: %s", err)
+	}
+	if !strings.Contains(diff, "+  set system host-name sim2") {
+		t.Errorf("got unexpected compare %q", diff)
+	}
+}
+
+func TestServerLock(t *testing.T) {
+	t.Parallel()
+
+	srv := netconfsim.Start(t, netconfsim.Config{})
+	junSess, err := srv.NewClient().StartNewSession(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// without retry to get the error immediately
+	client2, _ := srv.NewClient().WithRetryMaxElapsedTime(0)
+	junSess2, err := client2.StartNewSession(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := junSess.ConfigLock(t.Context()); err != nil {
+		t.Fatalf("unexpected lock error: %s", err)
+	}
+	err = junSess2.ConfigSet(t.Context(), []string{"set system host-name sim"})
+	var transientErr *junos.TransientError
+	if !errors.As(err, &transientErr) || transientErr.Kind != junos.RetryLockDenied {
+		t.Errorf("expected lock denied error with candidate locked by other session, got %v", err)
+	}
+	junSess2.Close()
+
+	if err := junSess.ConfigSet(t.Context(), []string{"set system host-name sim"}); err != nil {
+		t.Fatalf("unexpected set error: %s", err)
+	}
+	if config := srv.CandidateConfig(); config != "set system host-name sim\n" {
+		t.Errorf("got unexpected candidate configuration %q", config)
+	}
+	junSess.Close()
+
+	if config := srv.CandidateConfig(); config != "" {
+		t.Errorf("got unexpected candidate configuration after close without commit %q", config)
+	}
+}
+
+func TestServerRetryLockDenied(t *testing.T) {
+	t.Parallel()
+
+	srv := netconfsim.Start(t, netconfsim.Config{})
+	junSess, err := srv.NewClient().StartNewSession(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer junSess.Close()
+	client2, _ := srv.NewClient().WithRetryInitialInterval(1)
+	junSess2, err := client2.StartNewSession(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer junSess2.Close()
+
+	if err := junSess.ConfigLock(t.Context()); err != nil {
+		t.Fatalf("unexpected lock error: %s", err)
+	}
+	time.AfterFunc(500*time.Millisecond, func() { _ = junSess.ConfigUnlock(t.Context()) })
+	if err := junSess2.ConfigSet(t.Context(), []string{"set system host-name sim"}); err != nil {
+		t.Errorf("unexpected set error after retries: %s", err)
+	}
+	if config := srv.CandidateConfig(); config != "set system host-name sim\n" {
+		t.Errorf("got unexpected candidate configuration %q", config)
+	}
+}
+
+func TestServerCommandNoRetry(t *testing.T) {
+	t.Parallel()
+
+	srv := netconfsim.Start(t, netconfsim.Config{
+		CommandErrors: map[string]string{
+			"clear arp": "graceful switchover in progress",
+		},
+	})
+	client, _ := srv.NewClient().WithRetryInitialInterval(1)
+	client, _ = client.WithRetryMaxElapsedTime(3)
+	junSess, err := client.StartNewSession(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer junSess.Close()
+
+	count := srv.RPCCount("command")
+	_, err = junSess.CommandFormat(t.Context(), "clear arp", junos.ConfigFormatText)
+	var transientErr *junos.TransientError
+	if !errors.As(err, &transientErr) || transientErr.Kind != junos.RetrySwitchover {
+		t.Errorf("expected switchover error, got %v", err)
+	}
+	if sent := srv.RPCCount("command") - count; sent < 2 {
+		t.Errorf("expected command retried, got %d command(s) sent", sent)
+	}
+
+	count = srv.RPCCount("command")
+	_, err = junSess.CommandFormatNoRetry(t.Context(), "clear arp", junos.ConfigFormatText)
+	if !errors.As(err, &transientErr) || transientErr.Kind != junos.RetrySwitchover {
+		t.Errorf("expected switchover error, got %v", err)
+	}
+	if sent := srv.RPCCount("command") - count; sent != 1 {
+		t.Errorf("expected command sent without retry, got %d command(s) sent", sent)
+	}
+}
+
+func TestServerRetryBackoff(t *testing.T) {
+	t.Parallel()
+
+	srv := netconfsim.Start(t, netconfsim.Config{
+		Commands: map[string]string{
+			"show chassis alarms": "No alarms currently active\n",
+		},
+		CommandErrors: map[string]string{
+			"show chassis alarms": "graceful switchover in progress",
+			"show system alarms":  "graceful switchover in progress",
+		},
+		CommandFailureCount: map[string]int{
+			"show chassis alarms": 2,
+		},
+	})
+	client, _ := srv.NewClient().WithRetryMaxInterval(1)
+	junSess, err := client.StartNewSession(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer junSess.Close()
+	client2, _ := srv.NewClient().WithRetryMaxElapsedTime(2)
+	junSess2, err := client2.StartNewSession(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer junSess2.Close()
+
+	// retried until the end of transient errors
+	count := srv.RPCCount("command")
+	output, err := junSess.Command(t.Context(), "show chassis alarms")
+	if err != nil {
+		t.Errorf("unexpected error after retries: %s", err)
+	}
+	if !strings.Contains(output, "No alarms currently active") {
+		t.Errorf("got unexpected output %q", output)
+	}
+	if sent := srv.RPCCount("command") - count; sent != 3 {
+		t.Errorf("expected command sent 3 times, got %d", sent)
+	}
+
+	// no longer retried after the maximum elapsed time
+	start := time.Now()
+	count = srv.RPCCount("command")
+	_, err = junSess2.Command(t.Context(), "show system alarms")
+	var transientErr *junos.TransientError
+	if !errors.As(err, &transientErr) || transientErr.Kind != junos.RetrySwitchover {
+		t.Errorf("expected switchover error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 3*time.Second {
+		t.Errorf("expected retries stopped after 2s, got %s", elapsed)
+	}
+	if sent := srv.RPCCount("command") - count; sent < 2 {
+		t.Errorf("expected command retried, got %d command(s) sent", sent)
+	}
+}
+
+func TestServerRetryTransport(t *testing.T) {
+	t.Parallel()
+
+	srv := netconfsim.Start(t, netconfsim.Config{
+		Commands: map[string]string{
+			"show chassis alarms": "No alarms currently active\n",
+		},
+		CommandCloses: []string{
+			"show chassis alarms",
+			"show system alarms",
+		},
+		CommandFailureCount: map[string]int{
+			"show chassis alarms": 1,
+		},
+	})
+	junSess, err := srv.NewClient().StartNewSession(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer junSess.Close()
+
+	// retried after a reconnection
+	count := srv.RPCCount("command")
+	output, err := junSess.Command(t.Context(), "show chassis alarms")
+	if err != nil {
+		t.Errorf("unexpected error after reconnection: %s", err)
+	}
+	if !strings.Contains(output, "No alarms currently active") {
+		t.Errorf("got unexpected output %q", output)
+	}
+	if sent := srv.RPCCount("command") - count; sent != 2 {
+		t.Errorf("expected command sent 2 times, got %d", sent)
+	}
+
+	// not retried with the configuration locked by the session
+	if err := junSess.ConfigLock(t.Context()); err != nil {
+		t.Fatalf("unexpected lock error: %s", err)
+	}
+	count = srv.RPCCount("command")
+	_, err = junSess.Command(t.Context(), "show system alarms")
+	var transientErr *junos.TransientError
+	if !errors.As(err, &transientErr) || transientErr.Kind != junos.RetryTransport {
+		t.Errorf("expected transport error, got %v", err)
+	}
+	if sent := srv.RPCCount("command") - count; sent != 1 {
+		t.Errorf("expected command sent without retry, got %d command(s) sent", sent)
+	}
+}
+
+func TestServerConfigSetErrors(t *testing.T) {
+	t.Parallel()
+
+	srv := netconfsim.Start(t, netconfsim.Config{})
+	junSess, err := srv.NewClient().StartNewSession(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer junSess.Close()
+	if err := junSess.ConfigLock(t.Context()); err != nil {
+		t.Fatalf("unexpected lock error: %s", err)
+	}
+	defer func() { _ = junSess.ConfigUnlock(t.Context()) }()
+
+	err = junSess.ConfigSet(t.Context(), []string{
+		"set system host-name sim",
+		"sett system domain-name example.com",
+		"delete interfaces ge-0/0/0 description",
+	})
+	if err == nil {
+		t.Fatalf("expected error with a bad line")
+	}
+	if !strings.Contains(err.Error(), "syntax error") ||
+		!strings.Contains(err.Error(), `in line "sett system domain-name example.com"`) {
+		t.Errorf("got unexpected error without the bad line %q", err)
+	}
+	if strings.Contains(err.Error(), "statement not found") {
+		t.Errorf("got unexpected warning in error %q", err)
+	}
+
+	if err := junSess.ConfigSet(t.Context(), []string{
+		"delete interfaces ge-0/0/0 description",
+	}); err != nil {
+		t.Fatalf("unexpected error with a warning: %s", err)
+	}
+	warnings, err := junSess.CommitConf(t.Context(), "test")
+	if err != nil {
+		t.Fatalf("unexpected commit error: %s", err)
+	}
+	if len(warnings) != 2 {
+		t.Fatalf("got %d warnings with commit, want 2 (of each delete line): %q", len(warnings), warnings)
+	}
+	for _, w := range warnings {
+		if !strings.Contains(w.Error(), "statement not found") ||
+			!strings.Contains(w.Error(), `in line "delete interfaces ge-0/0/0 description"`) {
+			t.Errorf("got unexpected warning %q", w)
+		}
+	}
+	if warnings, _ := junSess.CommitConf(t.Context(), "test"); len(warnings) != 0 {
+		t.Errorf("got unexpected warnings already returned with previous commit %q", warnings)
+	}
+}
+
+func TestServerCommandFormat(t *testing.T) {
+	t.Parallel()
+
+	srv := netconfsim.Start(t, netconfsim.Config{
+		Commands: map[string]string{
+			"show system uptime": "Current time: 2026-01-01 00:00:00 UTC\n",
+		},
+		RPCs: map[string]string{
+			"get-system-uptime-information": "<system-uptime-information>" +
+				"<current-time><date-time>2026-01-01 00:00:00 UTC</date-time></current-time>" +
+				"</system-uptime-information>",
+		},
+	})
+	junSess, err := srv.NewClient().StartNewSession(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer junSess.Close()
+
+	output, err := junSess.CommandFormat(t.Context(), "show system uptime", junos.ConfigFormatText)
+	if err != nil {
+		t.Fatalf("unexpected command error: %s", err)
+	}
+	if output != "Current time: 2026-01-01 00:00:00 UTC\n" {
+		t.Errorf("got unexpected text output %q", output)
+	}
+	output, err = junSess.CommandFormat(t.Context(), "show system uptime", junos.ConfigFormatJSON)
+	if err != nil {
+		t.Fatalf("unexpected command error: %s", err)
+	}
+	if output != `{"output":"Current time: 2026-01-01 00:00:00 UTC\n"}` {
+		t.Errorf("got unexpected json output %q", output)
+	}
+	output, err = junSess.RPCFormat(t.Context(), "<get-system-uptime-information/>", junos.ConfigFormatXML)
+	if err != nil {
+		t.Fatalf("unexpected rpc error: %s", err)
+	}
+	if !strings.HasPrefix(output, "<system-uptime-information>") {
+		t.Errorf("got unexpected xml output %q", output)
+	}
+	output, err = junSess.RPCFormat(t.Context(), "<get-system-uptime-information/>", junos.ConfigFormatText)
+	if err != nil {
+		t.Fatalf("unexpected rpc error: %s", err)
+	}
+	if !strings.Contains(output, "<date-time>2026-01-01 00:00:00 UTC</date-time>") {
+		t.Errorf("got unexpected text output %q", output)
+	}
+
+	if _, err := junSess.CommandFormat(t.Context(), "show unknown", junos.ConfigFormatText); err == nil {
+		t.Errorf("expected error with an unknown command")
+	}
+	if _, err := junSess.RPCFormat(t.Context(), "get-system-uptime-information", junos.ConfigFormatText); err == nil {
+		t.Errorf("expected error with a RPC not in XML")
+	}
+	if _, err := junSess.CommandFormat(t.Context(), "show system uptime", "yaml"); err == nil {
+		t.Errorf("expected error with an unknown format")
+	}
+}
+
+func TestServerFileTransfer(t *testing.T) {
+	t.Parallel()
+
+	filesDir := t.TempDir()
+	srv := netconfsim.Start(t, netconfsim.Config{
+		FilesDir: filesDir,
+	})
+	client := srv.NewClient()
+	junSess, err := client.StartNewSession(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer junSess.Close()
+	sftpClt, err := client.NewSFTPClient(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected sftp error: %s", err)
+	}
+	defer sftpClt.Close()
+
+	localDir := t.TempDir()
+	content := []byte("#!/usr/bin/env slax\nversion 1.2;\n")
+	if err := os.WriteFile(filepath.Join(localDir, "op.slax"), content, 0o600); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	sum, err := sftpClt.Upload(t.Context(), filepath.Join(localDir, "op.slax"), "/var/db/scripts/op/op.slax", 0o640)
+	if err != nil {
+		t.Fatalf("unexpected upload error: %s", err)
+	}
+	if expected := fmt.Sprintf("%x", sha256.Sum256(content)); sum != expected {
+		t.Errorf("got unexpected checksum of upload %q, expected %q", sum, expected)
+	}
+	info, err := os.Stat(filepath.Join(filesDir, "var", "db", "scripts", "op", "op.slax"))
+	if err != nil {
+		t.Fatalf("uploaded file not found: %s", err)
+	}
+	if info.Mode().Perm() != 0o640 {
+		t.Errorf("got unexpected permissions of uploaded file %s", info.Mode().Perm())
+	}
+	deviceSum, err := junSess.FileChecksumSHA256(t.Context(), "/var/db/scripts/op/op.slax")
+	if err != nil {
+		t.Fatalf("unexpected checksum error: %s", err)
+	}
+	if deviceSum != sum {
+		t.Errorf("got unexpected checksum on device %q, expected %q", deviceSum, sum)
+	}
+
+	sum, err = sftpClt.Download(t.Context(), "/var/db/scripts/op/op.slax", filepath.Join(localDir, "download.slax"), 0)
+	if err != nil {
+		t.Fatalf("unexpected download error: %s", err)
+	}
+	if sum != deviceSum {
+		t.Errorf("got unexpected checksum of download %q, expected %q", sum, deviceSum)
+	}
+	if downloaded, _ := os.ReadFile(filepath.Join(localDir, "download.slax")); string(downloaded) != string(content) {
+		t.Errorf("got unexpected downloaded content %q", downloaded)
+	}
+
+	if _, err := junSess.FileChecksumSHA256(t.Context(), "/var/tmp/missing"); err == nil {
+		t.Errorf("expected checksum error with a missing file")
+	}
+	if _, err := sftpClt.Download(t.Context(), "/var/tmp/missing", filepath.Join(localDir, "missing"), 0); err == nil {
+		t.Errorf("expected download error with a missing file")
+	}
+}
+
+func TestServerSoftwareAdd(t *testing.T) {
+	t.Parallel()
+
+	filesDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(filesDir, "var", "tmp"), 0o755); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	pkg := "/var/tmp/junos-install-vsrx3-x86-64-24.2R1.17.tgz"
+	if err := os.WriteFile(filepath.Join(filesDir, filepath.FromSlash(pkg)), []byte("package"), 0o600); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	srv := netconfsim.Start(t, netconfsim.Config{
+		FilesDir:    filesDir,
+		RebootDelay: 200 * time.Millisecond,
+	})
+	junSess, err := srv.NewClient().StartNewSession(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer junSess.Close()
+
+	if _, err := junSess.SoftwareAdd(t.Context(), "/var/tmp/missing.tgz", junos.SoftwareAddOptions{}); err == nil {
+		t.Errorf("expected error with a missing package")
+	}
+	output, err := junSess.SoftwareAdd(t.Context(), pkg, junos.SoftwareAddOptions{Reboot: true})
+	if err != nil {
+		t.Fatalf("unexpected software add error: %s", err)
+	}
+	if !strings.Contains(output, "Rebooting") {
+		t.Errorf("got unexpected output %q", output)
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	defer cancel()
+	failedTries := 0
+	if err := junSess.WaitReconnect(ctx, 50*time.Millisecond, "", func(error) { failedTries++ }); err != nil {
+		t.Fatalf("unexpected reconnect error: %s", err)
+	}
+	if failedTries == 0 {
+		t.Errorf("got unexpected reconnect without wait for the reboot of device")
+	}
+	if v := junSess.SystemInformation.OSVersion; v != "24.2R1.17" {
+		t.Errorf("got unexpected os version after reboot %q", v)
+	}
+	if _, err := junSess.Command(t.Context(), junos.CmdShowConfig+"system"+junos.PipeDisplaySet); err != nil {
+		t.Errorf("unexpected command error after reconnect: %s", err)
+	}
+}
+
+func TestServerRebootHalt(t *testing.T) {
+	t.Parallel()
+
+	srv := netconfsim.Start(t, netconfsim.Config{
+		RebootDelay: 200 * time.Millisecond,
+	})
+	client := srv.NewClient()
+	junSess, err := client.StartNewSession(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer junSess.Close()
+
+	status, err := junSess.Reboot(t.Context(), junos.ShutdownOptions{In: 5, Message: "scheduled <test>"})
+	if err != nil {
+		t.Fatalf("unexpected reboot error: %s", err)
+	}
+	if status != "Shutdown at 5 minutes" {
+		t.Errorf("got unexpected status of scheduled reboot %q", status)
+	}
+	if _, err := junSess.Reboot(t.Context(), junos.ShutdownOptions{}); err != nil {
+		t.Fatalf("unexpected reboot error: %s", err)
+	}
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	defer cancel()
+	failedTries := 0
+	if err := junSess.WaitReconnect(ctx, 50*time.Millisecond, "", func(error) { failedTries++ }); err != nil {
+		t.Fatalf("unexpected reconnect error: %s", err)
+	}
+	if failedTries == 0 {
+		t.Errorf("got unexpected reconnect without wait for the reboot of device")
+	}
+
+	if _, err := junSess.Halt(t.Context(), junos.ShutdownOptions{}); err != nil {
+		t.Fatalf("unexpected halt error: %s", err)
+	}
+	ctx, cancel = context.WithTimeout(t.Context(), 300*time.Millisecond)
+	defer cancel()
+	if err := junSess.WaitReconnect(ctx, 50*time.Millisecond, "", nil); err == nil {
+		t.Errorf("expected reconnect error after halt")
+	}
+}
+
+func TestServerRebootShutdownDelay(t *testing.T) {
+	t.Parallel()
+
+	srv := netconfsim.Start(t, netconfsim.Config{
+		RebootDelay:   200 * time.Millisecond,
+		ShutdownDelay: 300 * time.Millisecond,
+	})
+	junSess, err := srv.NewClient().StartNewSession(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer junSess.Close()
+
+	for _, withBootedTime := range []bool{true, false} {
+		bootedTime, err := junSess.SystemBootedTime(t.Context())
+		if err != nil {
+			t.Fatalf("unexpected booted time error: %s", err)
+		}
+		if _, err := junSess.Reboot(t.Context(), junos.ShutdownOptions{}); err != nil {
+			t.Fatalf("unexpected reboot error: %s", err)
+		}
+		waitBootedTime := ""
+		if withBootedTime {
+			waitBootedTime = bootedTime
+		}
+		ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+		failedTries := 0
+		err = junSess.WaitReconnect(ctx, 50*time.Millisecond, waitBootedTime, func(error) { failedTries++ })
+		cancel()
+		if err != nil {
+			t.Fatalf("unexpected reconnect error: %s", err)
+		}
+		// the device is still available during the first tries
+		if failedTries < 2 {
+			t.Errorf("got unexpected reconnect without wait for the reboot of device (booted time %t)", withBootedTime)
+		}
+		newBootedTime, err := junSess.SystemBootedTime(t.Context())
+		if err != nil {
+			t.Fatalf("unexpected booted time error after reconnect: %s", err)
+		}
+		if newBootedTime == bootedTime {
+			t.Errorf("got unexpected same booted time %q after reconnect", newBootedTime)
+		}
+	}
+}
+
+func TestServerPingTraceroute(t *testing.T) {
+	t.Parallel()
+
+	srv := netconfsim.Start(t, netconfsim.Config{
+		PingLoss: map[string]int{
+			"192.0.2.1": 0,
+			"192.0.2.2": 40,
+		},
+	})
+	client := srv.NewClient()
+	junSess, err := client.StartNewSession(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer junSess.Close()
+
+	size := int64(1400)
+	result, err := junSess.Ping(t.Context(), junos.PingOptions{
+		Host:          "192.0.2.1",
+		Count:         3,
+		Size:          &size,
+		DoNotFragment: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected ping error: %s", err)
+	}
+	expected := junos.PingResult{
+		TargetIP:          "192.0.2.1",
+		ProbesSent:        3,
+		ResponsesReceived: 3,
+		RTTMinimum:        1,
+		RTTMaximum:        1.2,
+		RTTAverage:        1.1,
+	}
+	if result != expected {
+		t.Errorf("got unexpected ping result %+v, expected %+v", result, expected)
+	}
+	result, err = junSess.Ping(t.Context(), junos.PingOptions{Host: "192.0.2.2"})
+	if err != nil {
+		t.Fatalf("unexpected ping error: %s", err)
+	}
+	if result.PacketLoss != 40 || result.ResponsesReceived != 3 {
+		t.Errorf("got unexpected ping result with loss %+v", result)
+	}
+	result, err = junSess.Ping(t.Context(), junos.PingOptions{Host: "192.0.2.3", RoutingInstance: "<ri>"})
+	if err != nil {
+		t.Fatalf("unexpected ping error: %s", err)
+	}
+	if result.PacketLoss != 100 || result.ResponsesReceived != 0 || result.RTTAverage != 0 {
+		t.Errorf("got unexpected ping result without response %+v", result)
+	}
+
+	traceroute, err := junSess.Traceroute(t.Context(), junos.TracerouteOptions{Host: "192.0.2.1", NoResolve: true})
+	if err != nil {
+		t.Fatalf("unexpected traceroute error: %s", err)
+	}
+	if !traceroute.Success || len(traceroute.Hops) != 2 {
+		t.Fatalf("got unexpected traceroute result %+v", traceroute)
+	}
+	if hop := traceroute.Hops[1]; hop.TTL != 2 || !slices.Equal(hop.Addresses, []string{"192.0.2.1"}) ||
+		hop.RTTAverage != 2 {
+		t.Errorf("got unexpected traceroute hop %+v", hop)
+	}
+	traceroute, err = junSess.Traceroute(t.Context(), junos.TracerouteOptions{Host: "192.0.2.3", TTL: 2})
+	if err != nil {
+		t.Fatalf("unexpected traceroute error: %s", err)
+	}
+	if traceroute.Success || len(traceroute.Hops) != 2 || len(traceroute.Hops[1].Addresses) != 0 {
+		t.Errorf("got unexpected traceroute result without response %+v", traceroute)
+	}
+}
+
+func TestServerRescueConfig(t *testing.T) {
+	t.Parallel()
+
+	srv := netconfsim.Start(t, netconfsim.Config{
+		InitialConfig: "set system host-name sim\nset interfaces ge-0/0/0 description \"to <lan>\"\n",
+	})
+	client := srv.NewClient()
+	junSess, err := client.StartNewSession(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer junSess.Close()
+
+	rescue, err := junSess.RescueConfigGet(t.Context(), junos.ConfigFormatSet)
+	if err != nil {
+		t.Fatalf("unexpected error to get rescue configuration: %s", err)
+	}
+	if rescue != "" {
+		t.Errorf("got unexpected rescue configuration before save %q", rescue)
+	}
+
+	if err := junSess.RescueConfigSave(t.Context()); err != nil {
+		t.Fatalf("unexpected error to save rescue configuration: %s", err)
+	}
+	rescue, err = junSess.RescueConfigGet(t.Context(), junos.ConfigFormatSet)
+	if err != nil {
+		t.Fatalf("unexpected error to get rescue configuration: %s", err)
+	}
+	committed, err := junSess.ConfigGet(t.Context(), junos.ConfigFormatSet)
+	if err != nil {
+		t.Fatalf("unexpected error to get configuration: %s", err)
+	}
+	if rescue != strings.TrimSpace(html.UnescapeString(committed)) {
+		t.Errorf("got unexpected rescue configuration %q, expected %q", rescue, committed)
+	}
+
+	if err := junSess.RescueConfigDelete(t.Context()); err != nil {
+		t.Fatalf("unexpected error to delete rescue configuration: %s", err)
+	}
+	rescue, err = junSess.RescueConfigGet(t.Context(), junos.ConfigFormatSet)
+	if err != nil {
+		t.Fatalf("unexpected error to get rescue configuration: %s", err)
+	}
+	if rescue != "" {
+		t.Errorf("got unexpected rescue configuration after delete %q", rescue)
+	}
+}
+
+func TestServerPlatform(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		config         netconfsim.Config
+		expectPlatform junos.Platform
+	}
+	tests := map[string]testCase{
+		"vsrx": {
+			config: netconfsim.Config{
+				HardwareModel: "vsrx",
+			},
+			expectPlatform: junos.Platform{
+				Family:  junos.PlatformSRX,
+				Virtual: true,
+			},
+		},
+		"mx_dual_re": {
+			config: netconfsim.Config{
+				HardwareModel: "mx480",
+				DualRE:        true,
+			},
+			expectPlatform: junos.Platform{
+				Family: junos.PlatformMX,
+				DualRE: true,
+			},
+		},
+		"ex_els": {
+			config: netconfsim.Config{
+				HardwareModel:  "ex4300-48t",
+				SwitchingStyle: junos.SwitchingELS,
+			},
+			expectPlatform: junos.Platform{
+				Family:         junos.PlatformEX,
+				SwitchingStyle: junos.SwitchingELS,
+			},
+		},
+		"ex_non_els": {
+			config: netconfsim.Config{
+				HardwareModel:  "ex2200-24t-4g",
+				OSVersion:      "12.3R12-S21",
+				SwitchingStyle: junos.SwitchingNonELS,
+			},
+			expectPlatform: junos.Platform{
+				Family:         junos.PlatformEX,
+				SwitchingStyle: junos.SwitchingNonELS,
+			},
+		},
+		"ptx_evolved": {
+			config: netconfsim.Config{
+				HardwareModel: "ptx10003-160c",
+				OSVersion:     "22.4R3-S2.11-EVO",
+			},
+			expectPlatform: junos.Platform{
+				Family:  junos.PlatformPTX,
+				Evolved: true,
+			},
+		},
+		"qfx_evolved_packages": {
+			config: netconfsim.Config{
+				HardwareModel: "qfx5130-32cd",
+				OSVersion:     "23.4R2-S3.9",
+				Evolved:       true,
+			},
+			expectPlatform: junos.Platform{
+				Family:  junos.PlatformQFX,
+				Evolved: true,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := netconfsim.Start(t, tc.config)
+			client := srv.NewClient()
+			junSess, err := client.StartNewSession(t.Context())
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			defer junSess.Close()
+
+			if junSess.Platform != tc.expectPlatform {
+				t.Errorf("got unexpected platform %+v, want %+v", junSess.Platform, tc.expectPlatform)
+			}
+
+			// the extra RPCs of detection are not sent again with a new session of same client
+			softwareCount := srv.RPCCount("get-software-information")
+			routeEngineCount := srv.RPCCount("get-route-engine-information")
+			vlanCount := srv.RPCCount("get-vlan-information")
+			junSess2, err := client.StartNewSession(t.Context())
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			defer junSess2.Close()
+			if junSess2.Platform != tc.expectPlatform {
+				t.Errorf("got unexpected platform on second session %+v, want %+v", junSess2.Platform, tc.expectPlatform)
+			}
+			if srv.RPCCount("get-software-information") != softwareCount ||
+				srv.RPCCount("get-route-engine-information") != routeEngineCount ||
+				srv.RPCCount("get-vlan-information") != vlanCount {
+				t.Errorf("got unexpected extra RPCs of platform detection on second session")
+			}
+			if tc.expectPlatform.SwitchingStyle == junos.SwitchingNonELS {
+				if err := junSess.CheckFeature(junos.FeatureSwitchingELS); err == nil {
+					t.Errorf("got unexpected no error with ELS feature on non-ELS device")
+				}
+				if err := junSess.CheckFeature(junos.FeatureSwitchingNonELS); err != nil {
+					t.Errorf("got unexpected error with non-ELS feature: %s", err)
+				}
+			}
+		})
+	}
+}
+
+func TestServerRPCAbort(t *testing.T) {
+	t.Parallel()
+
+	t.Run("rpc_timeout", func(t *testing.T) {
+		t.Parallel()
+
+		srv := netconfsim.Start(t, netconfsim.Config{
+			CommandDelay: 3 * time.Second,
+		})
+		client, err := srv.NewClient().WithRPCTimeout(1)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		junSess, err := client.StartNewSession(t.Context())
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		defer junSess.Close()
+
+		start := time.Now()
+		_, err = junSess.Command(t.Context(), junos.CmdShowConfig+"system"+junos.PipeDisplaySet)
+		if err == nil {
+			t.Fatalf("got unexpected no error")
+		}
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("got unexpected error: %s", err)
+		}
+		if elapsed := time.Since(start); elapsed >= 3*time.Second {
+			t.Errorf("got unexpected elapsed time %s, the RPC is not aborted", elapsed)
+		}
+		if _, err := junSess.Command(t.Context(), junos.CmdShowConfig+"system"+junos.PipeDisplaySet); err == nil {
+			t.Errorf("got unexpected no error with aborted session")
+		}
+	})
+	t.Run("context_canceled", func(t *testing.T) {
+		t.Parallel()
+
+		srv := netconfsim.Start(t, netconfsim.Config{
+			CommandDelay: 3 * time.Second,
+		})
+		junSess, err := srv.NewClient().StartNewSession(t.Context())
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		defer junSess.Close()
+
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+		time.AfterFunc(500*time.Millisecond, cancel)
+		_, err = junSess.Command(ctx, junos.CmdShowConfig+"system"+junos.PipeDisplaySet)
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("got unexpected error: %v", err)
+		}
+	})
+}
+
+func TestServerClear(t *testing.T) {
+	t.Parallel()
+
+	srv := netconfsim.Start(t, netconfsim.Config{
+		Commands: map[string]string{
+			"clear arp": "192.0.2.1 deleted\n",
+		},
+	})
+	client := srv.NewClient()
+	junSess, err := client.StartNewSession(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer junSess.Close()
+
+	output, err := junSess.Clear(t.Context(), junos.ClearOptions{Target: junos.ClearTargetARP})
+	if err != nil {
+		t.Fatalf("unexpected error to clear arp: %s", err)
+	}
+	if output != "192.0.2.1 deleted" {
+		t.Errorf("got unexpected output of clear arp %q", output)
+	}
+
+	_, err = junSess.Clear(t.Context(), junos.ClearOptions{Target: junos.ClearTargetIPsecSecurityAssocs})
+	if err == nil || !strings.Contains(err.Error(), "clear security ipsec security-associations") {
+		t.Errorf("expected error with the command of unsupported clear, got %v", err)
+	}
+}
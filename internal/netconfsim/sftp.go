@@ -0,0 +1,133 @@
+package netconfsim
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"html"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/pkg/sftp"
+)
+
+// devicePath returns the local path of a file on the simulated device.
+func (srv *Server) devicePath(name string) string {
+	return filepath.Join(srv.config.FilesDir, filepath.FromSlash(path.Clean("/"+name)))
+}
+
+// handleSFTP serves the SFTP subsystem with the files of device.
+func (srv *Server) handleSFTP(channel io.ReadWriteCloser) {
+	handler := &sftpHandler{srv: srv}
+	server := sftp.NewRequestServer(channel, sftp.Handlers{
+		FileGet:  handler,
+		FilePut:  handler,
+		FileCmd:  handler,
+		FileList: handler,
+	})
+	defer server.Close()
+	_ = server.Serve()
+}
+
+// sftpHandler: handlers of SFTP requests with the files of device.
+type sftpHandler struct {
+	srv *Server
+}
+
+func (h *sftpHandler) Fileread(r *sftp.Request) (io.ReaderAt, error) {
+	return os.Open(h.srv.devicePath(r.Filepath))
+}
+
+func (h *sftpHandler) Filewrite(r *sftp.Request) (io.WriterAt, error) {
+	flags := os.O_WRONLY | os.O_CREATE
+	if r.Pflags().Trunc {
+		flags |= os.O_TRUNC
+	}
+	devicePath := h.srv.devicePath(r.Filepath)
+	if err := os.MkdirAll(filepath.Dir(devicePath), 0o755); err != nil {
+		return nil, err
+	}
+
+	return os.OpenFile(devicePath, flags, 0o644)
+}
+
+func (h *sftpHandler) Filecmd(r *sftp.Request) error {
+	devicePath := h.srv.devicePath(r.Filepath)
+	switch r.Method {
+	case "Setstat":
+		if r.AttrFlags().Permissions {
+			return os.Chmod(devicePath, r.Attributes().FileMode().Perm())
+		}
+
+		return nil
+	case "Remove":
+		return os.Remove(devicePath)
+	case "Mkdir":
+		return os.Mkdir(devicePath, 0o755)
+	case "Rename":
+		return os.Rename(devicePath, h.srv.devicePath(r.Target))
+	default:
+		return sftp.ErrSSHFxOpUnsupported
+	}
+}
+
+func (h *sftpHandler) Filelist(r *sftp.Request) (sftp.ListerAt, error) {
+	switch r.Method {
+	case "Stat", "Lstat":
+		info, err := os.Stat(h.srv.devicePath(r.Filepath))
+		if err != nil {
+			return nil, err
+		}
+
+		return fileInfos{info}, nil
+	default:
+		return nil, sftp.ErrSSHFxOpUnsupported
+	}
+}
+
+type fileInfos []os.FileInfo
+
+func (infos fileInfos) ListAt(list []os.FileInfo, offset int64) (int, error) {
+	if offset >= int64(len(infos)) {
+		return 0, io.EOF
+	}
+	n := copy(list, infos[offset:])
+	if n < len(list) {
+		return n, io.EOF
+	}
+
+	return n, nil
+}
+
+// sha256Checksum answers to get-sha256-checksum-information with a file of device.
+func (srv *Server) sha256Checksum(op *rpcOperation) string {
+	var checksumPath struct {
+		Path string `xml:"path"`
+	}
+	if err := xml.Unmarshal([]byte("<checksum>"+op.Inner+"</checksum>"), &checksumPath); err != nil {
+		return rpcError("protocol", "malformed-message", "error", err.Error())
+	}
+	if srv.config.FilesDir == "" {
+		return rpcError("protocol", "operation-not-supported", "error",
+			"files of device not available in simulator")
+	}
+	data, err := os.ReadFile(srv.devicePath(checksumPath.Path))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return rpcError("protocol", "operation-failed", "error",
+				"Could not open file: "+checksumPath.Path)
+		}
+
+		return rpcError("protocol", "operation-failed", "error", err.Error())
+	}
+	sum := sha256.Sum256(data)
+
+	return "<checksum-information><file-checksum>" +
+		"<computation-method>SHA256</computation-method>" +
+		"<input-file>" + html.EscapeString(checksumPath.Path) + "</input-file>" +
+		"<checksum>" + hex.EncodeToString(sum[:]) + "</checksum>" +
+		"</file-checksum></checksum-information>"
+}
@@ -0,0 +1,86 @@
+package netconfsim
+
+import (
+	"encoding/xml"
+	"html"
+	"os"
+	"path"
+	"regexp"
+	"strconv"
+)
+
+// packageVersionRegexp: Junos version in name of package.
+//
+//nolint:gochecknoglobals
+var packageVersionRegexp = regexp.MustCompile(`[0-9]+\.[0-9]+[RXDF][0-9]+(?:[.-][0-9A-Z]+)*`)
+
+type rpcRequestPackageAdd struct {
+	PackageName string    `xml:"package-name"`
+	Reboot      *struct{} `xml:"reboot"`
+	NoValidate  *struct{} `xml:"no-validate"`
+}
+
+// packageAdd answers to request-package-add: the Junos version in name of package is installed
+// and activated at the next reboot (requested with <reboot/>).
+//
+// The package need to exist in the files of device if FilesDir of Config is set.
+func (srv *Server) packageAdd(op *rpcOperation) string {
+	var request rpcRequestPackageAdd
+	if err := xml.Unmarshal([]byte("<request>"+op.Inner+"</request>"), &request); err != nil {
+		return rpcError("protocol", "malformed-message", "error", err.Error())
+	}
+	if srv.config.FilesDir != "" {
+		if _, err := os.Stat(srv.devicePath(request.PackageName)); err != nil {
+			return "<output>Fetching package " + html.EscapeString(request.PackageName) + " ...</output>" +
+				rpcError("application", "operation-failed", "error",
+					"Failed to fetch "+request.PackageName+": file not found") +
+				"<package-result>1</package-result>"
+		}
+	}
+	version := packageVersionRegexp.FindString(path.Base(request.PackageName))
+	if version == "" {
+		return "<output>Verifying package " + html.EscapeString(request.PackageName) + " ...</output>" +
+			"<output>ERROR: package is not a Junos software package</output>" +
+			"<package-result>1</package-result>"
+	}
+	srv.pendingOSVersion = version
+	output := "<output>Installing package '" + html.EscapeString(request.PackageName) + "' ...</output>"
+	if request.NoValidate == nil {
+		output += "<output>Validating against current configuration ...</output>"
+	}
+	if request.Reboot != nil {
+		srv.rebootPending = true
+		output += "<output>Rebooting ...</output>"
+	} else {
+		output += "<output>WARNING: A reboot is required to install the software</output>"
+	}
+
+	return output + "<package-result>0</package-result>"
+}
+
+type rpcRequestShutdown struct {
+	In int    `xml:"in"`
+	At string `xml:"at"`
+}
+
+// requestShutdown answers to request-reboot (or request-halt with halt):
+// the device is rebooted (or halted) after the reply if the shutdown is not scheduled later.
+func (srv *Server) requestShutdown(op *rpcOperation, halt bool) string {
+	var request rpcRequestShutdown
+	if err := xml.Unmarshal([]byte("<request>"+op.Inner+"</request>"), &request); err != nil {
+		return rpcError("protocol", "malformed-message", "error", err.Error())
+	}
+	status := "Shutdown NOW!"
+	switch {
+	case request.In > 0:
+		status = "Shutdown at " + strconv.Itoa(request.In) + " minutes"
+	case request.At != "":
+		status = "Shutdown at " + html.EscapeString(request.At)
+	default:
+		srv.rebootPending = true
+		srv.haltPending = halt
+	}
+
+	return "<request-reboot-results><request-reboot-status>" + status +
+		"</request-reboot-status></request-reboot-results>"
+}
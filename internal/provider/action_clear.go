@@ -0,0 +1,302 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action                   = &clearAction{}
+	_ action.ActionWithConfigure      = &clearAction{}
+	_ action.ActionWithValidateConfig = &clearAction{}
+)
+
+type clearAction struct {
+	client *junos.Client
+}
+
+func newClearAction() action.Action {
+	return &clearAction{}
+}
+
+func (act *clearAction) typeName() string {
+	return providerName + "_clear"
+}
+
+func (act *clearAction) junosClient() *junos.Client {
+	return act.client
+}
+
+func (act *clearAction) Metadata(
+	_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_clear"
+}
+
+func (act *clearAction) Configure(
+	ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedActionConfigureType(ctx, req, resp)
+
+		return
+	}
+	act.client = client
+}
+
+func (act *clearAction) Schema(
+	_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Run a `clear` operational command on device (BGP neighbors, IKE/IPsec security associations, " +
+			"flow sessions, ARP entries or interfaces statistics).",
+		Attributes: map[string]schema.Attribute{
+			"target": schema.StringAttribute{
+				Required:    true,
+				Description: "What to clear: `" + strings.Join(junos.ClearTargets(), "`, `") + "`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(junos.ClearTargets()...),
+				},
+			},
+			"neighbor": schema.StringAttribute{
+				Optional:    true,
+				Description: "Address of the BGP neighbor to clear (all neighbors without it).",
+				Validators: []validator.String{
+					tfvalidator.StringIPAddress(),
+				},
+			},
+			"soft": schema.StringAttribute{
+				Optional:    true,
+				Description: "Soft clear of BGP neighbor: `" + junos.ClearBGPSoft + "` or `" + junos.ClearBGPSoftInbound + "`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(junos.ClearBGPSoft, junos.ClearBGPSoftInbound),
+				},
+			},
+			"routing_instance": schema.StringAttribute{
+				Optional:    true,
+				Description: "Routing instance of BGP neighbors or ARP entries to clear.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+					tfvalidator.StringFormat(tfvalidator.DefaultFormat),
+				},
+			},
+			"peer_address": schema.StringAttribute{
+				Optional:    true,
+				Description: "Address of the peer of IKE security associations to clear.",
+				Validators: []validator.String{
+					tfvalidator.StringIPAddress(),
+				},
+			},
+			"interface": schema.StringAttribute{
+				Optional: true,
+				Description: "Interface of flow sessions, ARP entries or statistics to clear " +
+					"(statistics of all interfaces without it).",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					tfvalidator.StringFormat(tfvalidator.InterfaceFormat),
+				},
+			},
+			"source_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Source prefix (or address) of flow sessions to clear.",
+				Validators: []validator.String{
+					stringvalidator.Any(
+						tfvalidator.StringCIDR(),
+						tfvalidator.StringIPAddress(),
+					),
+				},
+			},
+			"destination_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Destination prefix (or address) of flow sessions to clear.",
+				Validators: []validator.String{
+					stringvalidator.Any(
+						tfvalidator.StringCIDR(),
+						tfvalidator.StringIPAddress(),
+					),
+				},
+			},
+			"source_port": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Source port of flow sessions to clear.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"destination_port": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Destination port of flow sessions to clear.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				Optional:    true,
+				Description: "IP protocol (name or number) of flow sessions to clear.",
+				Validators: []validator.String{
+					stringvalidator.Any(
+						stringvalidator.OneOf(
+							"ah", "egp", "esp", "gre", "icmp", "icmp6", "igmp", "ipip",
+							"ospf", "pim", "rsvp", "sctp", "tcp", "udp",
+						),
+						tfvalidator.StringNumberRange(0, 255),
+					),
+				},
+			},
+			"application": schema.StringAttribute{
+				Optional:    true,
+				Description: "Application of flow sessions to clear.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					tfvalidator.StringFormat(tfvalidator.DefaultFormat),
+				},
+			},
+		},
+	}
+}
+
+type clearActionData struct {
+	Target            types.String `tfsdk:"target"`
+	Neighbor          types.String `tfsdk:"neighbor"`
+	Soft              types.String `tfsdk:"soft"`
+	RoutingInstance   types.String `tfsdk:"routing_instance"`
+	PeerAddress       types.String `tfsdk:"peer_address"`
+	Interface         types.String `tfsdk:"interface"`
+	SourcePrefix      types.String `tfsdk:"source_prefix"`
+	DestinationPrefix types.String `tfsdk:"destination_prefix"`
+	SourcePort        types.Int64  `tfsdk:"source_port"`
+	DestinationPort   types.Int64  `tfsdk:"destination_port"`
+	Protocol          types.String `tfsdk:"protocol"`
+	Application       types.String `tfsdk:"application"`
+}
+
+func (actData *clearActionData) options() junos.ClearOptions {
+	return junos.ClearOptions{
+		Target:            actData.Target.ValueString(),
+		Neighbor:          actData.Neighbor.ValueString(),
+		Soft:              actData.Soft.ValueString(),
+		RoutingInstance:   actData.RoutingInstance.ValueString(),
+		PeerAddress:       actData.PeerAddress.ValueString(),
+		Interface:         actData.Interface.ValueString(),
+		SourcePrefix:      actData.SourcePrefix.ValueString(),
+		DestinationPrefix: actData.DestinationPrefix.ValueString(),
+		SourcePort:        actData.SourcePort.ValueInt64(),
+		DestinationPort:   actData.DestinationPort.ValueInt64(),
+		Protocol:          actData.Protocol.ValueString(),
+		Application:       actData.Application.ValueString(),
+	}
+}
+
+func (act *clearAction) ValidateConfig(
+	ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse,
+) {
+	var config clearActionData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Target.IsNull() || config.Target.IsUnknown() {
+		return
+	}
+	target := config.Target.ValueString()
+	for _, filter := range []struct {
+		name    string
+		value   attr.Value
+		targets []string
+	}{
+		{"neighbor", config.Neighbor, []string{junos.ClearTargetBGPNeighbor}},
+		{"soft", config.Soft, []string{junos.ClearTargetBGPNeighbor}},
+		{"routing_instance", config.RoutingInstance, []string{junos.ClearTargetBGPNeighbor, junos.ClearTargetARP}},
+		{"peer_address", config.PeerAddress, []string{junos.ClearTargetIKESecurityAssocs}},
+		{"interface", config.Interface, []string{
+			junos.ClearTargetFlowSession, junos.ClearTargetARP, junos.ClearTargetInterfacesStatistics,
+		}},
+		{"source_prefix", config.SourcePrefix, []string{junos.ClearTargetFlowSession}},
+		{"destination_prefix", config.DestinationPrefix, []string{junos.ClearTargetFlowSession}},
+		{"source_port", config.SourcePort, []string{junos.ClearTargetFlowSession}},
+		{"destination_port", config.DestinationPort, []string{junos.ClearTargetFlowSession}},
+		{"protocol", config.Protocol, []string{junos.ClearTargetFlowSession}},
+		{"application", config.Application, []string{junos.ClearTargetFlowSession}},
+	} {
+		if filter.value.IsNull() || slices.Contains(filter.targets, target) {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root(filter.name),
+			tfdiag.ConflictConfigErrSummary,
+			fmt.Sprintf("%s cannot be configured when target = %q, only with target %s",
+				filter.name, target, strings.Join(filter.targets, ", ")),
+		)
+	}
+}
+
+func (act *clearAction) Invoke(
+	ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse,
+) {
+	var config clearActionData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options := config.options()
+	cmd, err := options.Command()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("target"), tfdiag.ClearErrSummary, err.Error())
+
+		return
+	}
+
+	clt := act.junosClient()
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Starting session to device",
+	})
+	junSess, err := clt.StartNewSession(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+		return
+	}
+	defer junSess.Close()
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Running %q", cmd),
+	})
+	output, err := junSess.Clear(ctx, options)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.ClearErrSummary, err.Error())
+
+		return
+	}
+	if output != "" {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Output of %q:\n%s", cmd, output),
+		})
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Clear completed",
+	})
+}
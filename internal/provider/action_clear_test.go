@@ -0,0 +1,48 @@
+package provider_test
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccActionClear_basic(t *testing.T) {
+	testaccInterface := junos.DefaultInterfaceTestAcc
+	if iface := os.Getenv("TESTACC_INTERFACE"); iface != "" {
+		testaccInterface = iface
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				// 1
+				ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+				ConfigDirectory:          config.TestStepDirectory(),
+				ConfigVariables: map[string]config.Variable{
+					"interface": config.StringVariable(testaccInterface),
+				},
+			},
+			{
+				// 2
+				ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+				ConfigDirectory:          config.TestStepDirectory(),
+				ExpectError:              regexp.MustCompile(`soft cannot be configured when target = "arp"`),
+			},
+			{
+				// 3
+				ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+				ConfigDirectory:          config.TestStepDirectory(),
+				ExpectError:              regexp.MustCompile(`string has an unauthorized character: " "`),
+			},
+		},
+	})
+}
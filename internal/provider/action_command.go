@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action                   = &commandAction{}
+	_ action.ActionWithConfigure      = &commandAction{}
+	_ action.ActionWithValidateConfig = &commandAction{}
+)
+
+type commandAction struct {
+	client *junos.Client
+}
+
+func newCommandAction() action.Action {
+	return &commandAction{}
+}
+
+func (act *commandAction) typeName() string {
+	return providerName + "_command"
+}
+
+func (act *commandAction) junosClient() *junos.Client {
+	return act.client
+}
+
+func (act *commandAction) Metadata(
+	_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_command"
+}
+
+func (act *commandAction) Configure(
+	ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedActionConfigureType(ctx, req, resp)
+
+		return
+	}
+	act.client = client
+}
+
+func (act *commandAction) Schema(
+	_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Run operational commands or RPCs on device.",
+		Attributes: map[string]schema.Attribute{
+			"commands": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "List of operational commands (CLI) to run in order.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(
+						stringvalidator.LengthAtLeast(1),
+					),
+				},
+			},
+			"rpcs": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "List of operational RPCs (XML elements) to run in order, after the `commands`.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(
+						stringvalidator.LengthAtLeast(1),
+					),
+				},
+			},
+			"format": schema.StringAttribute{
+				Optional:    true,
+				Description: "The format of output of commands and RPCs. Defaults to 'text'.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						junos.ConfigFormatText,
+						junos.ConfigFormatXML,
+						junos.ConfigFormatJSON,
+					),
+				},
+			},
+			"expect": schema.StringAttribute{
+				Optional: true,
+				Description: "A regular expression that the output of each command and RPC must match, " +
+					"the action fails (and the next commands are not run) on a mismatch.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+type commandActionData struct {
+	Commands []types.String `tfsdk:"commands"`
+	RPCs     []types.String `tfsdk:"rpcs"`
+	Format   types.String   `tfsdk:"format"`
+	Expect   types.String   `tfsdk:"expect"`
+}
+
+func (act *commandAction) ValidateConfig(
+	ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse,
+) {
+	var config commandActionData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Commands == nil && config.RPCs == nil {
+		resp.Diagnostics.AddError(
+			tfdiag.MissingConfigErrSummary,
+			"at least one of commands or rpcs must be specified",
+		)
+	}
+	if !config.Expect.IsNull() && !config.Expect.IsUnknown() {
+		if _, err := regexp.Compile(config.Expect.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expect"),
+				"Invalid Regular Expression",
+				fmt.Sprintf("expect is not a valid regular expression: %s", err),
+			)
+		}
+	}
+}
+
+func (act *commandAction) Invoke(
+	ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse,
+) {
+	var config commandActionData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	format := config.Format.ValueString()
+	if format == "" {
+		format = junos.ConfigFormatText
+	}
+	var expect *regexp.Regexp
+	if v := config.Expect.ValueString(); v != "" {
+		var err error
+		expect, err = regexp.Compile(v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expect"),
+				"Invalid Regular Expression",
+				fmt.Sprintf("expect is not a valid regular expression: %s", err),
+			)
+
+			return
+		}
+	}
+
+	clt := act.junosClient()
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Starting session to device",
+	})
+	junSess, err := clt.StartNewSession(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+		return
+	}
+	defer junSess.Close()
+
+	type run struct {
+		attrPath path.Path
+		kind     string
+		value    string
+		exec     func(context.Context, string, string) (string, error)
+	}
+	runs := make([]run, 0, len(config.Commands)+len(config.RPCs))
+	for i, v := range config.Commands {
+		runs = append(runs, run{
+			path.Root("commands").AtListIndex(i), "command", v.ValueString(), junSess.CommandFormatNoRetry,
+		})
+	}
+	for i, v := range config.RPCs {
+		runs = append(runs, run{path.Root("rpcs").AtListIndex(i), "RPC", v.ValueString(), junSess.RPCFormatNoRetry})
+	}
+
+	for _, r := range runs {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Running %s %q", r.kind, r.value),
+		})
+		output, err := r.exec(ctx, r.value, format)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(r.attrPath, tfdiag.CommandErrSummary, err.Error())
+
+			return
+		}
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Output of %s %q:\n%s", r.kind, r.value, output),
+		})
+		if expect != nil && !expect.MatchString(output) {
+			resp.Diagnostics.AddAttributeError(
+				r.attrPath,
+				tfdiag.CommandOutputErrSummary,
+				fmt.Sprintf("output of %s %q doesn't match expect %q:\n%s", r.kind, r.value, expect.String(), output),
+			)
+
+			return
+		}
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Commands completed",
+	})
+}
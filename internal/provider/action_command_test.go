@@ -0,0 +1,32 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccActionCommand_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				// 1
+				ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+				ConfigDirectory:          config.TestStepDirectory(),
+			},
+			{
+				// 2
+				ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+				ConfigDirectory:          config.TestStepDirectory(),
+				ExpectError:              regexp.MustCompile(`doesn't match expect`),
+			},
+		},
+	})
+}
@@ -1,7 +1,9 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"encoding/xml"
 	"fmt"
 	"os"
 	"strings"
@@ -10,9 +12,11 @@ import (
 	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
 	"github.com/jeremmfr/terraform-provider-junos/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -20,8 +24,9 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ action.Action              = &commitFileAction{}
-	_ action.ActionWithConfigure = &commitFileAction{}
+	_ action.Action                   = &commitFileAction{}
+	_ action.ActionWithConfigure      = &commitFileAction{}
+	_ action.ActionWithValidateConfig = &commitFileAction{}
 )
 
 type commitFileAction struct {
@@ -65,33 +70,249 @@ func (act *commitFileAction) Configure(
 func (act *commitFileAction) Schema(
 	_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse,
 ) {
+	attributes := commitOptionsActionAttributes()
+	attributes["filename"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The path of the file to load.",
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+	attributes["format"] = schema.StringAttribute{
+		Optional: true,
+		Description: "Format of the file: `" + junos.ConfigFormatSet + "` (set/delete lines, default), " +
+			"`" + junos.ConfigFormatText + "` (configuration in text format " +
+			"like the file generated with the `fake_setfile_text` provider argument), " +
+			"`" + junos.ConfigFormatJSON + "` (configuration in JSON format) " +
+			"or `" + junos.ConfigFormatXML + "` (configuration in XML format with `<configuration>` as root element " +
+			"or Junos XML `<load-configuration>` RPCs with set/delete lines " +
+			"like the file generated with the `fake_setfile_xml` provider argument).",
+		Validators: []validator.String{
+			stringvalidator.OneOf(
+				junos.ConfigFormatSet,
+				junos.ConfigFormatText,
+				junos.ConfigFormatJSON,
+				junos.ConfigFormatXML,
+			),
+		},
+	}
+	attributes["append_lines"] = schema.ListAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Description: "List of set/delete lines to load after the file.",
+	}
+	attributes["clear_file_after_commit"] = schema.BoolAttribute{
+		Optional:    true,
+		Description: "Truncate file after successful commit.",
+	}
 	resp.Schema = schema.Schema{
-		Description: "Load a file with set/delete lines on device and commit.",
-		Attributes: map[string]schema.Attribute{
-			"filename": schema.StringAttribute{
-				Required:    true,
-				Description: "The path of the file to load.",
-				Validators: []validator.String{
-					stringvalidator.LengthAtLeast(1),
-				},
-			},
-			"append_lines": schema.ListAttribute{
-				ElementType: types.StringType,
-				Optional:    true,
-				Description: "List of lines to append to the lines in the loaded file.",
+		Description: "Load a file with configuration on device and commit.",
+		Attributes:  attributes,
+	}
+}
+
+// commitOptionsActionAttributes returns the attributes of the options of commit
+// for the actions that load a configuration.
+func commitOptionsActionAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"check_only": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Only check the loaded configuration (`commit check`) then discard it, without commit.",
+		},
+		"show_compare": schema.BoolAttribute{
+			Optional: true,
+			Description: "Send the differences between the candidate and the active configuration " +
+				"(`show | compare`) as progress before the commit.",
+		},
+		"commit_confirmed": schema.Int64Attribute{
+			Optional: true,
+			Description: "Commit with the `confirmed` option and this timeout (in minutes) " +
+				"without sending the confirmation (to confirm later with the `" + providerName + "_commit_confirm` action), " +
+				"instead of the `commit_confirmed` provider argument.",
+			Validators: []validator.Int64{
+				int64validator.Between(1, 65535),
 			},
-			"clear_file_after_commit": schema.BoolAttribute{
-				Optional:    true,
-				Description: "Truncate file after successful commit.",
+		},
+		"comment": schema.StringAttribute{
+			Optional:    true,
+			Description: "Comment (log message) of commit.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
 			},
 		},
+		"synchronize": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Commit on both routing engines.",
+		},
 	}
 }
 
 type commitFileActionData struct {
 	Filename             types.String   `tfsdk:"filename"`
+	Format               types.String   `tfsdk:"format"`
 	AppendLines          []types.String `tfsdk:"append_lines"`
 	ClearFileAfterCommit types.Bool     `tfsdk:"clear_file_after_commit"`
+	CheckOnly            types.Bool     `tfsdk:"check_only"`
+	ShowCompare          types.Bool     `tfsdk:"show_compare"`
+	CommitConfirmed      types.Int64    `tfsdk:"commit_confirmed"`
+	Comment              types.String   `tfsdk:"comment"`
+	Synchronize          types.Bool     `tfsdk:"synchronize"`
+}
+
+// commitActionOptions: options of commit of the actions that load a configuration.
+type commitActionOptions struct {
+	CheckOnly       types.Bool
+	ShowCompare     types.Bool
+	CommitConfirmed types.Int64
+	Comment         types.String
+	Synchronize     types.Bool
+}
+
+func (actData *commitFileActionData) commitOptions() commitActionOptions {
+	return commitActionOptions{
+		CheckOnly:       actData.CheckOnly,
+		ShowCompare:     actData.ShowCompare,
+		CommitConfirmed: actData.CommitConfirmed,
+		Comment:         actData.Comment,
+		Synchronize:     actData.Synchronize,
+	}
+}
+
+func (act *commitFileAction) ValidateConfig(
+	ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse,
+) {
+	var config commitFileActionData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.commitOptions().validate(&resp.Diagnostics)
+	if config.CheckOnly.ValueBool() && config.ClearFileAfterCommit.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("clear_file_after_commit"),
+			tfdiag.ConflictConfigErrSummary,
+			"clear_file_after_commit cannot be true with check_only",
+		)
+	}
+}
+
+// validate checks that the options of commit are not set with check_only.
+func (opts commitActionOptions) validate(diags *diag.Diagnostics) {
+	if !opts.CheckOnly.ValueBool() {
+		return
+	}
+	if !opts.CommitConfirmed.IsNull() {
+		diags.AddAttributeError(
+			path.Root("commit_confirmed"),
+			tfdiag.ConflictConfigErrSummary,
+			"commit_confirmed cannot be set with check_only",
+		)
+	}
+	if !opts.Comment.IsNull() {
+		diags.AddAttributeError(
+			path.Root("comment"),
+			tfdiag.ConflictConfigErrSummary,
+			"comment cannot be set with check_only",
+		)
+	}
+	if opts.Synchronize.ValueBool() {
+		diags.AddAttributeError(
+			path.Root("synchronize"),
+			tfdiag.ConflictConfigErrSummary,
+			"synchronize cannot be true with check_only",
+		)
+	}
+}
+
+// checkOrCommit sends the differences of candidate configuration with show_compare,
+// then checks and discards the candidate configuration with check_only or commits it.
+//
+// With check_only, the candidate configuration is always discarded, even after an error.
+//
+// Returns true if the configuration has been committed.
+func (opts commitActionOptions) checkOrCommit(
+	ctx context.Context, junSess *junos.Session, logMessage string, resp *action.InvokeResponse,
+) bool {
+	if opts.CheckOnly.ValueBool() {
+		// discard the candidate configuration even after an error of compare or check
+		defer func() {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: "Discarding candidate configuration",
+			})
+			if err := junSess.ConfigDiscard(ctx); err != nil {
+				resp.Diagnostics.AddError(tfdiag.ConfigDiscardErrSummary, err.Error())
+			}
+			if !resp.Diagnostics.HasError() {
+				resp.SendProgress(action.InvokeProgressEvent{
+					Message: "Configuration checked",
+				})
+			}
+		}()
+	}
+	if opts.ShowCompare.ValueBool() {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: "Comparing candidate configuration",
+		})
+		diff, err := junSess.ConfigCompare(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(tfdiag.ConfigReadErrSummary, err.Error())
+
+			return false
+		}
+		if diff == "" {
+			diff = "no differences"
+		}
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: "Differences of candidate configuration:\n" + diff,
+		})
+	}
+
+	if opts.CheckOnly.ValueBool() {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: "Checking configuration",
+		})
+		warns, err := junSess.CommitCheck(ctx)
+		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigCommitWarnSummary, warns)...)
+		if err != nil {
+			resp.Diagnostics.AddError(tfdiag.ConfigCommitErrSummary, err.Error())
+		}
+
+		return false
+	}
+
+	if v := opts.Comment.ValueString(); v != "" {
+		logMessage = v
+	}
+	commitOptions := junos.CommitOptions{
+		Synchronize:      opts.Synchronize.ValueBool(),
+		ConfirmedTimeout: int(opts.CommitConfirmed.ValueInt64()),
+	}
+	if commitOptions.ConfirmedTimeout > 0 {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Committing configuration with confirmed option (%d minutes)",
+				commitOptions.ConfirmedTimeout),
+		})
+	} else {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: "Committing configuration",
+		})
+	}
+	warns, err := junSess.CommitConfWithOptions(ctx, logMessage, commitOptions)
+	resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigCommitWarnSummary, warns)...)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.ConfigCommitErrSummary, err.Error())
+
+		return false
+	}
+	if commitOptions.ConfirmedTimeout > 0 {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Configuration committed, need to be confirmed within %d minutes",
+				commitOptions.ConfirmedTimeout),
+		})
+	}
+
+	return true
 }
 
 func (act *commitFileAction) Invoke(
@@ -106,12 +327,16 @@ func (act *commitFileAction) Invoke(
 	resp.SendProgress(action.InvokeProgressEvent{
 		Message: "Reading configuration file",
 	})
-	configSet, err := config.readFile()
+	configFile, err := config.readFile()
 	if err != nil {
 		resp.Diagnostics.AddAttributeError(path.Root("filename"), tfdiag.ConfigSetErrSummary, err.Error())
 
 		return
 	}
+	configSet := configFile.lines
+	if configFile.format != junos.ConfigFormatSet {
+		configSet = make([]string, 0, len(config.AppendLines))
+	}
 	for _, v := range config.AppendLines {
 		configSet = append(configSet, v.ValueString())
 	}
@@ -137,26 +362,29 @@ func (act *commitFileAction) Invoke(
 		return
 	}
 	defer func() {
-		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock())...)
+		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock(ctx))...)
 	}()
 
 	resp.SendProgress(action.InvokeProgressEvent{
 		Message: "Loading configuration",
 	})
-	if err := junSess.ConfigSet(configSet); err != nil {
-		resp.Diagnostics.AddError(tfdiag.ConfigSetErrSummary, err.Error())
+	if configFile.format != junos.ConfigFormatSet {
+		err := junSess.ConfigLoad(ctx, junos.LoadConfigActionMerge, configFile.format, configFile.content)
+		if err != nil {
+			resp.Diagnostics.AddError(tfdiag.ConfigSetErrSummary, err.Error())
 
-		return
+			return
+		}
 	}
+	if len(configSet) > 0 {
+		if err := junSess.ConfigSet(ctx, configSet); err != nil {
+			resp.Diagnostics.AddError(tfdiag.ConfigSetErrSummary, err.Error())
 
-	resp.SendProgress(action.InvokeProgressEvent{
-		Message: "Committing configuration",
-	})
-	warns, err := junSess.CommitConf(ctx, "commit a file with action "+act.typeName())
-	resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigCommitWarnSummary, warns)...)
-	if err != nil {
-		resp.Diagnostics.AddError(tfdiag.ConfigCommitErrSummary, err.Error())
+			return
+		}
+	}
 
+	if !config.commitOptions().checkOrCommit(ctx, junSess, "commit a file with action "+act.typeName(), resp) {
 		return
 	}
 
@@ -174,21 +402,67 @@ func (act *commitFileAction) Invoke(
 	}
 }
 
-func (actData *commitFileActionData) readFile() ([]string, error) {
+// commitFileContent: configuration read in file,
+// set/delete lines with the set format or the content to load with the other formats.
+type commitFileContent struct {
+	format  string
+	lines   []string
+	content string
+}
+
+func (actData *commitFileActionData) readFile() (commitFileContent, error) {
 	filename := actData.Filename.ValueString()
 	if err := utils.ReplaceTildeToHomeDir(&filename); err != nil {
-		return []string{}, err
+		return commitFileContent{}, err
 	}
 
 	if _, err := os.Stat(filename); os.IsNotExist(err) {
-		return []string{}, fmt.Errorf("file %q doesn't exist", filename)
+		return commitFileContent{}, fmt.Errorf("file %q doesn't exist", filename)
 	}
 	fileReadByte, err := os.ReadFile(filename)
 	if err != nil {
-		return []string{}, fmt.Errorf("could not read file %q: %w", filename, err)
+		return commitFileContent{}, fmt.Errorf("could not read file %q: %w", filename, err)
 	}
 
-	return strings.Split(string(fileReadByte), "\n"), nil
+	switch format := actData.Format.ValueString(); format {
+	case junos.ConfigFormatText, junos.ConfigFormatJSON:
+		return commitFileContent{format: format, content: string(fileReadByte)}, nil
+	case junos.ConfigFormatXML:
+		if xmlRootElementIs(fileReadByte, "configuration") {
+			return commitFileContent{format: format, content: string(fileReadByte)}, nil
+		}
+		lines, err := junos.FakeSetFileXMLLines(fileReadByte)
+		if err != nil {
+			return commitFileContent{}, fmt.Errorf("could not read XML file %q: %w", filename, err)
+		}
+
+		return commitFileContent{format: junos.ConfigFormatSet, lines: lines}, nil
+	}
+
+	lines := make([]string, 0)
+	for line := range strings.SplitSeq(string(fileReadByte), "\n") {
+		// comments, like the headers of sections in fake set file
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	return commitFileContent{format: junos.ConfigFormatSet, lines: lines}, nil
+}
+
+// xmlRootElementIs returns if the first element of XML content has name.
+func xmlRootElementIs(content []byte, name string) bool {
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return false
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			return start.Name.Local == name
+		}
+	}
 }
 
 func (actData *commitFileActionData) cleanFile() error {
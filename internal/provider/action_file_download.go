@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &fileDownloadAction{}
+	_ action.ActionWithConfigure = &fileDownloadAction{}
+)
+
+type fileDownloadAction struct {
+	client *junos.Client
+}
+
+func newFileDownloadAction() action.Action {
+	return &fileDownloadAction{}
+}
+
+func (act *fileDownloadAction) typeName() string {
+	return providerName + "_file_download"
+}
+
+func (act *fileDownloadAction) junosClient() *junos.Client {
+	return act.client
+}
+
+func (act *fileDownloadAction) Metadata(
+	_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_file_download"
+}
+
+func (act *fileDownloadAction) Configure(
+	ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedActionConfigureType(ctx, req, resp)
+
+		return
+	}
+	act.client = client
+}
+
+func (act *fileDownloadAction) Schema(
+	_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Download a file from device with SFTP and verify its SHA-256 checksum on device.",
+		Attributes: map[string]schema.Attribute{
+			"source": schema.StringAttribute{
+				Required:    true,
+				Description: "The path of the file on device.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"destination": schema.StringAttribute{
+				Required:    true,
+				Description: "The local path where to write the file.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"permissions": schema.StringAttribute{
+				Optional:    true,
+				Description: "The permissions (in octal like `0644`) to set on the local file. Defaults to `0644`.",
+				Validators:  filePermissionsValidators(),
+			},
+		},
+	}
+}
+
+type fileDownloadActionData struct {
+	Source      types.String `tfsdk:"source"`
+	Destination types.String `tfsdk:"destination"`
+	Permissions types.String `tfsdk:"permissions"`
+}
+
+func (act *fileDownloadAction) Invoke(
+	ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse,
+) {
+	var config fileDownloadActionData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	perm, err := parseFilePermissions(config.Permissions.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("permissions"), "Bad Value Error", err.Error())
+
+		return
+	}
+
+	clt := act.junosClient()
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Starting session to device",
+	})
+	junSess, err := clt.StartNewSession(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+		return
+	}
+	defer junSess.Close()
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Reading checksum of file on device",
+	})
+	deviceChecksum, err := junSess.FileChecksumSHA256(ctx, config.Source.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.FileTransferErrSummary, err.Error())
+
+		return
+	}
+
+	sftpClt, err := clt.NewSFTPClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+		return
+	}
+	defer sftpClt.Close()
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Downloading %q to %q", config.Source.ValueString(), config.Destination.ValueString()),
+	})
+	checksum, err := sftpClt.Download(ctx, config.Source.ValueString(), config.Destination.ValueString(), perm)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.FileTransferErrSummary, err.Error())
+
+		return
+	}
+	if checksum != deviceChecksum {
+		resp.Diagnostics.AddError(
+			tfdiag.FileTransferErrSummary,
+			fmt.Sprintf("SHA-256 checksum of downloaded data (%s) doesn't match the file %q on device (%s)",
+				checksum, config.Source.ValueString(), deviceChecksum),
+		)
+
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "File downloaded (SHA-256 " + checksum + ")",
+	})
+}
@@ -0,0 +1,50 @@
+package provider_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccActionFileDownload_basic(t *testing.T) {
+	tempDir := t.TempDir()
+	sourceFile := filepath.Join(tempDir, "testacc_file_download.txt")
+	destinationFile := filepath.Join(tempDir, "testacc_file_download.downloaded.txt")
+	if err := os.WriteFile(sourceFile, []byte("testacc_file_download\n"), 0o600); err != nil {
+		t.Fatalf("writing source file: %s", err)
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				// 1
+				ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+				ConfigDirectory:          config.TestStepDirectory(),
+				ConfigVariables: map[string]config.Variable{
+					"source_file":      config.StringVariable(sourceFile),
+					"destination_file": config.StringVariable(destinationFile),
+				},
+				Check: func(_ *terraform.State) error {
+					content, err := os.ReadFile(destinationFile)
+					if err != nil {
+						return fmt.Errorf("reading downloaded file: %w", err)
+					}
+					if string(content) != "testacc_file_download\n" {
+						return fmt.Errorf("got unexpected content of downloaded file %q", content)
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
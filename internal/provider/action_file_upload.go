@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &fileUploadAction{}
+	_ action.ActionWithConfigure = &fileUploadAction{}
+)
+
+type fileUploadAction struct {
+	client *junos.Client
+}
+
+func newFileUploadAction() action.Action {
+	return &fileUploadAction{}
+}
+
+func (act *fileUploadAction) typeName() string {
+	return providerName + "_file_upload"
+}
+
+func (act *fileUploadAction) junosClient() *junos.Client {
+	return act.client
+}
+
+func (act *fileUploadAction) Metadata(
+	_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_file_upload"
+}
+
+func (act *fileUploadAction) Configure(
+	ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedActionConfigureType(ctx, req, resp)
+
+		return
+	}
+	act.client = client
+}
+
+func (act *fileUploadAction) Schema(
+	_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Upload a file to device with SFTP and verify its SHA-256 checksum on device.",
+		Attributes: map[string]schema.Attribute{
+			"source": schema.StringAttribute{
+				Required:    true,
+				Description: "The local path of the file to upload.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"destination": schema.StringAttribute{
+				Required:    true,
+				Description: "The path of the file on device.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"permissions": schema.StringAttribute{
+				Optional:    true,
+				Description: "The permissions (in octal like `0644`) to set on the file on device.",
+				Validators:  filePermissionsValidators(),
+			},
+		},
+	}
+}
+
+type fileUploadActionData struct {
+	Source      types.String `tfsdk:"source"`
+	Destination types.String `tfsdk:"destination"`
+	Permissions types.String `tfsdk:"permissions"`
+}
+
+func (act *fileUploadAction) Invoke(
+	ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse,
+) {
+	var config fileUploadActionData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	perm, err := parseFilePermissions(config.Permissions.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("permissions"), "Bad Value Error", err.Error())
+
+		return
+	}
+
+	clt := act.junosClient()
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Starting session to device",
+	})
+	junSess, err := clt.StartNewSession(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+		return
+	}
+	defer junSess.Close()
+	sftpClt, err := clt.NewSFTPClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+		return
+	}
+	defer sftpClt.Close()
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Uploading %q to %q and verifying its checksum on device",
+			config.Source.ValueString(), config.Destination.ValueString()),
+	})
+	checksum, err := fileUploadWithChecksum(ctx, sftpClt, junSess,
+		config.Source.ValueString(), config.Destination.ValueString(), perm)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.FileTransferErrSummary, err.Error())
+
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "File uploaded (SHA-256 " + checksum + ")",
+	})
+}
+
+// fileUploadWithChecksum uploads the local file to device
+// and verifies the SHA-256 checksum of file on device with the checksum of data uploaded.
+func fileUploadWithChecksum(
+	ctx context.Context,
+	sftpClt *junos.SFTPClient,
+	junSess *junos.Session,
+	source, destination string,
+	perm os.FileMode,
+) (
+	string, error,
+) {
+	checksum, err := sftpClt.Upload(ctx, source, destination, perm)
+	if err != nil {
+		return "", err
+	}
+	deviceChecksum, err := junSess.FileChecksumSHA256(ctx, destination)
+	if err != nil {
+		return "", err
+	}
+	if deviceChecksum != checksum {
+		return "", fmt.Errorf("SHA-256 checksum of file %q on device (%s) doesn't match the uploaded data (%s)",
+			destination, deviceChecksum, checksum)
+	}
+
+	return checksum, nil
+}
+
+// filePermissionsValidators returns the validators of a permissions attribute in octal.
+func filePermissionsValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.RegexMatches(regexp.MustCompile(`^0?[0-7]{3}$`),
+			"must be permissions in octal like `0644`"),
+	}
+}
+
+// parseFilePermissions returns the mode of permissions in octal (0 if empty).
+func parseFilePermissions(permissions string) (os.FileMode, error) {
+	if permissions == "" {
+		return 0, nil
+	}
+	perm, err := strconv.ParseUint(permissions, 8, 32)
+	if err != nil {
+		return 0, fmt.Errorf("bad permissions %q: %w", permissions, err)
+	}
+
+	return os.FileMode(perm).Perm(), nil
+}
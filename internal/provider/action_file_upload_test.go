@@ -0,0 +1,44 @@
+package provider_test
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccActionFileUpload_basic(t *testing.T) {
+	sourceFile := filepath.Join(t.TempDir(), "testacc_file_upload.txt")
+	if err := os.WriteFile(sourceFile, []byte("testacc_file_upload\n"), 0o600); err != nil {
+		t.Fatalf("writing source file: %s", err)
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				// 1
+				ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+				ConfigDirectory:          config.TestStepDirectory(),
+				ConfigVariables: map[string]config.Variable{
+					"source_file": config.StringVariable(sourceFile),
+				},
+			},
+			{
+				// 2
+				ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+				ConfigDirectory:          config.TestStepDirectory(),
+				ConfigVariables: map[string]config.Variable{
+					"source_file": config.StringVariable(sourceFile),
+				},
+				ExpectError: regexp.MustCompile(`opening local file`),
+			},
+		},
+	})
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action                   = &haltAction{}
+	_ action.ActionWithConfigure      = &haltAction{}
+	_ action.ActionWithValidateConfig = &haltAction{}
+)
+
+type haltAction struct {
+	client *junos.Client
+}
+
+func newHaltAction() action.Action {
+	return &haltAction{}
+}
+
+func (act *haltAction) typeName() string {
+	return providerName + "_halt"
+}
+
+func (act *haltAction) junosClient() *junos.Client {
+	return act.client
+}
+
+func (act *haltAction) Metadata(
+	_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_halt"
+}
+
+func (act *haltAction) Configure(
+	ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedActionConfigureType(ctx, req, resp)
+
+		return
+	}
+	act.client = client
+}
+
+func (act *haltAction) Schema(
+	_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Halt the device (`request system halt`).",
+		Attributes:  shutdownActionAttributes("halt"),
+	}
+}
+
+type haltActionData struct {
+	In                 types.Int64  `tfsdk:"in"`
+	At                 types.String `tfsdk:"at"`
+	Member             types.Int64  `tfsdk:"member"`
+	Node               types.String `tfsdk:"node"`
+	BothRoutingEngines types.Bool   `tfsdk:"both_routing_engines"`
+	Message            types.String `tfsdk:"message"`
+}
+
+func (act *haltAction) ValidateConfig(
+	ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse,
+) {
+	var config haltActionData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Member.IsNull() && !config.Node.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("member"),
+			tfdiag.ConflictConfigErrSummary,
+			"member and node cannot be configured together",
+		)
+	}
+}
+
+func (act *haltAction) Invoke(
+	ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse,
+) {
+	var config haltActionData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clt := act.junosClient()
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Starting session to device",
+	})
+	junSess, err := clt.StartNewSession(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+		return
+	}
+	defer junSess.Close()
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Requesting halt",
+	})
+	status, err := junSess.Halt(ctx, junos.ShutdownOptions{
+		In:                 config.In.ValueInt64(),
+		At:                 config.At.ValueString(),
+		Member:             config.Member.ValueInt64Pointer(),
+		Node:               config.Node.ValueString(),
+		BothRoutingEngines: config.BothRoutingEngines.ValueBool(),
+		Message:            config.Message.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.ShutdownErrSummary, err.Error())
+
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Halt requested: " + status,
+	})
+}
@@ -63,48 +63,63 @@ func (act *loadConfigAction) Configure(
 func (act *loadConfigAction) Schema(
 	_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse,
 ) {
+	attributes := commitOptionsActionAttributes()
+	attributes["config"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The configuration to load and apply.",
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+	attributes["action"] = schema.StringAttribute{
+		Optional:    true,
+		Description: "Specify how to load the configuration data. Defaults to 'merge'.",
+		Validators: []validator.String{
+			stringvalidator.OneOf(
+				junos.LoadConfigActionMerge,
+				junos.LoadConfigActionOverride,
+				junos.LoadConfigActionReplace,
+				junos.LoadConfigActionSet,
+				junos.LoadConfigActionUpdate,
+			),
+		},
+	}
+	attributes["format"] = schema.StringAttribute{
+		Optional:    true,
+		Description: "The format used for the configuration data. Defaults to 'text'.",
+		Validators: []validator.String{
+			stringvalidator.OneOf(
+				junos.ConfigFormatText,
+				junos.ConfigFormatJSON,
+				junos.ConfigFormatXML,
+			),
+		},
+	}
 	resp.Schema = schema.Schema{
 		Description: "Load an arbitrary configuration and commit it.",
-		Attributes: map[string]schema.Attribute{
-			"config": schema.StringAttribute{
-				Required:    true,
-				Description: "The configuration to load and apply.",
-				Validators: []validator.String{
-					stringvalidator.LengthAtLeast(1),
-				},
-			},
-			"action": schema.StringAttribute{
-				Optional:    true,
-				Description: "Specify how to load the configuration data. Defaults to 'merge'.",
-				Validators: []validator.String{
-					stringvalidator.OneOf(
-						junos.LoadConfigActionMerge,
-						junos.LoadConfigActionOverride,
-						junos.LoadConfigActionReplace,
-						junos.LoadConfigActionSet,
-						junos.LoadConfigActionUpdate,
-					),
-				},
-			},
-			"format": schema.StringAttribute{
-				Optional:    true,
-				Description: "The format used for the configuration data. Defaults to 'text'.",
-				Validators: []validator.String{
-					stringvalidator.OneOf(
-						junos.ConfigFormatText,
-						junos.ConfigFormatJSON,
-						junos.ConfigFormatXML,
-					),
-				},
-			},
-		},
+		Attributes:  attributes,
 	}
 }
 
 type loadConfigActionData struct {
-	Config types.String `tfsdk:"config"`
-	Action types.String `tfsdk:"action"`
-	Format types.String `tfsdk:"format"`
+	Config          types.String `tfsdk:"config"`
+	Action          types.String `tfsdk:"action"`
+	Format          types.String `tfsdk:"format"`
+	CheckOnly       types.Bool   `tfsdk:"check_only"`
+	ShowCompare     types.Bool   `tfsdk:"show_compare"`
+	CommitConfirmed types.Int64  `tfsdk:"commit_confirmed"`
+	Comment         types.String `tfsdk:"comment"`
+	Synchronize     types.Bool   `tfsdk:"synchronize"`
+}
+
+func (actData *loadConfigActionData) commitOptions() commitActionOptions {
+	return commitActionOptions{
+		CheckOnly:       actData.CheckOnly,
+		ShowCompare:     actData.ShowCompare,
+		CommitConfirmed: actData.CommitConfirmed,
+		Comment:         actData.Comment,
+		Synchronize:     actData.Synchronize,
+	}
 }
 
 func (act *loadConfigAction) ValidateConfig(
@@ -130,6 +145,7 @@ func (act *loadConfigAction) ValidateConfig(
 			)
 		}
 	}
+	config.commitOptions().validate(&resp.Diagnostics)
 }
 
 func (act *loadConfigAction) Invoke(
@@ -181,26 +197,19 @@ func (act *loadConfigAction) Invoke(
 		return
 	}
 	defer func() {
-		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock())...)
+		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock(ctx))...)
 	}()
 
 	resp.SendProgress(action.InvokeProgressEvent{
 		Message: "Loading configuration",
 	})
-	if err := junSess.ConfigLoad(actionValue, format, config.Config.ValueString()); err != nil {
+	if err := junSess.ConfigLoad(ctx, actionValue, format, config.Config.ValueString()); err != nil {
 		resp.Diagnostics.AddError(tfdiag.ConfigSetErrSummary, err.Error())
 
 		return
 	}
 
-	resp.SendProgress(action.InvokeProgressEvent{
-		Message: "Committing configuration",
-	})
-	warns, err := junSess.CommitConf(ctx, "load a config with action "+act.typeName())
-	resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigCommitWarnSummary, warns)...)
-	if err != nil {
-		resp.Diagnostics.AddError(tfdiag.ConfigCommitErrSummary, err.Error())
-
+	if !config.commitOptions().checkOrCommit(ctx, junSess, "load a config with action "+act.typeName(), resp) {
 		return
 	}
 
@@ -67,6 +67,38 @@ func TestAccActionLoadConfig_basic(t *testing.T) {
 							"applications.#", "0"),
 					),
 				},
+				{
+					// 7
+					ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+					ConfigDirectory:          config.TestStepDirectory(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.junos_applications.testacc",
+							"applications.#", "0"),
+					),
+				},
+				{
+					// 8
+					ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+					ConfigDirectory:          config.TestStepDirectory(),
+				},
+				{
+					// 9
+					ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+					ConfigDirectory:          config.TestStepDirectory(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.junos_applications.testacc",
+							"applications.#", "1"),
+					),
+				},
+				{
+					// 10
+					ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+					ConfigDirectory:          config.TestStepDirectory(),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.junos_applications.testacc",
+							"applications.#", "0"),
+					),
+				},
 			},
 		})
 	}
@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const defaultPingCount = 5
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &pingAction{}
+	_ action.ActionWithConfigure = &pingAction{}
+)
+
+type pingAction struct {
+	client *junos.Client
+}
+
+func newPingAction() action.Action {
+	return &pingAction{}
+}
+
+func (act *pingAction) typeName() string {
+	return providerName + "_ping"
+}
+
+func (act *pingAction) junosClient() *junos.Client {
+	return act.client
+}
+
+func (act *pingAction) Metadata(
+	_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_ping"
+}
+
+func (act *pingAction) Configure(
+	ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedActionConfigureType(ctx, req, resp)
+
+		return
+	}
+	act.client = client
+}
+
+func (act *pingAction) Schema(
+	_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Ping a host from the device and fail when the packet loss exceeds a threshold.",
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				Required:    true,
+				Description: "Hostname or IP address of remote host.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"count": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of ping requests to send. Defaults to `5`.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 2000000000),
+				},
+			},
+			"size": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Size of request packets (bytes).",
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65468),
+				},
+			},
+			"source": schema.StringAttribute{
+				Optional:    true,
+				Description: "Source address of echo request.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"routing_instance": schema.StringAttribute{
+				Optional:    true,
+				Description: "Routing instance for ping attempt.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"interface": schema.StringAttribute{
+				Optional:    true,
+				Description: "Source interface (multicast, all-ones, unrouted packets).",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"do_not_fragment": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Don't fragment echo request packets (IPv4).",
+			},
+			"max_packet_loss": schema.Float64Attribute{
+				Optional: true,
+				Description: "Maximum packet loss (in percent) accepted, the action fails when the loss exceeds it. " +
+					"Without it, the action fails only when no response is received.",
+				Validators: []validator.Float64{
+					float64validator.Between(0, 100),
+				},
+			},
+		},
+	}
+}
+
+type pingActionData struct {
+	Host            types.String  `tfsdk:"host"`
+	Count           types.Int64   `tfsdk:"count"`
+	Size            types.Int64   `tfsdk:"size"`
+	Source          types.String  `tfsdk:"source"`
+	RoutingInstance types.String  `tfsdk:"routing_instance"`
+	Interface       types.String  `tfsdk:"interface"`
+	DoNotFragment   types.Bool    `tfsdk:"do_not_fragment"`
+	MaxPacketLoss   types.Float64 `tfsdk:"max_packet_loss"`
+}
+
+func (act *pingAction) Invoke(
+	ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse,
+) {
+	var config pingActionData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	count := int64(defaultPingCount)
+	if !config.Count.IsNull() {
+		count = config.Count.ValueInt64()
+	}
+	host := config.Host.ValueString()
+
+	clt := act.junosClient()
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Starting session to device",
+	})
+	junSess, err := clt.StartNewSession(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+		return
+	}
+	defer junSess.Close()
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Pinging %s (%d requests)", host, count),
+	})
+	result, err := junSess.Ping(ctx, junos.PingOptions{
+		Host:            host,
+		Count:           count,
+		Size:            config.Size.ValueInt64Pointer(),
+		Source:          config.Source.ValueString(),
+		RoutingInstance: config.RoutingInstance.ValueString(),
+		Interface:       config.Interface.ValueString(),
+		DoNotFragment:   config.DoNotFragment.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.PingErrSummary, err.Error())
+
+		return
+	}
+	statistics := pingStatistics(host, result)
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: statistics,
+	})
+
+	switch {
+	case !config.MaxPacketLoss.IsNull():
+		if maxPacketLoss := config.MaxPacketLoss.ValueFloat64(); result.PacketLoss > maxPacketLoss {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_packet_loss"),
+				tfdiag.PingErrSummary,
+				fmt.Sprintf("packet loss of ping %s (%g%%) exceeds max_packet_loss (%g%%)\n%s",
+					host, result.PacketLoss, maxPacketLoss, statistics),
+			)
+		}
+	case result.ResponsesReceived == 0:
+		resp.Diagnostics.AddError(
+			tfdiag.PingErrSummary,
+			fmt.Sprintf("no response to ping %s\n%s", host, statistics),
+		)
+	}
+}
+
+// pingStatistics returns the statistics of ping like the output of CLI.
+func pingStatistics(host string, result junos.PingResult) string {
+	statistics := fmt.Sprintf("--- %s ping statistics ---\n"+
+		"%d packets transmitted, %d packets received, %g%% packet loss",
+		host, result.ProbesSent, result.ResponsesReceived, result.PacketLoss,
+	)
+	if result.ResponsesReceived > 0 {
+		statistics += fmt.Sprintf("\nround-trip min/avg/max/stddev = %.3f/%.3f/%.3f/%.3f ms",
+			result.RTTMinimum, result.RTTAverage, result.RTTMaximum, result.RTTStddev,
+		)
+	}
+
+	return statistics
+}
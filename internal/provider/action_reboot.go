@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action                   = &rebootAction{}
+	_ action.ActionWithConfigure      = &rebootAction{}
+	_ action.ActionWithValidateConfig = &rebootAction{}
+)
+
+type rebootAction struct {
+	client *junos.Client
+}
+
+func newRebootAction() action.Action {
+	return &rebootAction{}
+}
+
+func (act *rebootAction) typeName() string {
+	return providerName + "_reboot"
+}
+
+func (act *rebootAction) junosClient() *junos.Client {
+	return act.client
+}
+
+func (act *rebootAction) Metadata(
+	_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_reboot"
+}
+
+func (act *rebootAction) Configure(
+	ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedActionConfigureType(ctx, req, resp)
+
+		return
+	}
+	act.client = client
+}
+
+func (act *rebootAction) Schema(
+	_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse,
+) {
+	attributes := shutdownActionAttributes("reboot")
+	attributes["wait"] = schema.BoolAttribute{
+		Optional:    true,
+		Description: "Wait for the device to be available after the reboot.",
+	}
+	attributes["wait_timeout"] = schema.Int64Attribute{
+		Optional:    true,
+		Description: "Time (in seconds) to wait for the device after the reboot. Defaults to 1800.",
+		Validators: []validator.Int64{
+			int64validator.Between(60, 7200),
+		},
+	}
+	resp.Schema = schema.Schema{
+		Description: "Reboot the device (`request system reboot`) and optionally wait for it.",
+		Attributes:  attributes,
+	}
+}
+
+// shutdownActionAttributes returns the attributes of the options of reboot or halt.
+func shutdownActionAttributes(shutdown string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"in": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Number of minutes to wait before the " + shutdown + ".",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+				int64validator.ConflictsWith(path.MatchRoot("at")),
+			},
+		},
+		"at": schema.StringAttribute{
+			Optional:    true,
+			Description: "Time at which to " + shutdown + " the device (like `yymmddhhmm` or `hh:mm`).",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"member": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Member of virtual chassis to " + shutdown + " (all members by default).",
+			Validators: []validator.Int64{
+				int64validator.Between(0, 9),
+			},
+		},
+		"node": schema.StringAttribute{
+			Optional:    true,
+			Description: "Node of chassis cluster to " + shutdown + " (like `local`, `all`, `0` or `1`).",
+			Validators: []validator.String{
+				stringvalidator.OneOf("all", "local", "primary", "0", "1"),
+			},
+		},
+		"both_routing_engines": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Apply the " + shutdown + " to both routing engines.",
+		},
+		"message": schema.StringAttribute{
+			Optional:    true,
+			Description: "Message to display to all system users.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+	}
+}
+
+type rebootActionData struct {
+	In                 types.Int64  `tfsdk:"in"`
+	At                 types.String `tfsdk:"at"`
+	Member             types.Int64  `tfsdk:"member"`
+	Node               types.String `tfsdk:"node"`
+	BothRoutingEngines types.Bool   `tfsdk:"both_routing_engines"`
+	Message            types.String `tfsdk:"message"`
+	Wait               types.Bool   `tfsdk:"wait"`
+	WaitTimeout        types.Int64  `tfsdk:"wait_timeout"`
+}
+
+func (config *rebootActionData) shutdownOptions() junos.ShutdownOptions {
+	return junos.ShutdownOptions{
+		In:                 config.In.ValueInt64(),
+		At:                 config.At.ValueString(),
+		Member:             config.Member.ValueInt64Pointer(),
+		Node:               config.Node.ValueString(),
+		BothRoutingEngines: config.BothRoutingEngines.ValueBool(),
+		Message:            config.Message.ValueString(),
+	}
+}
+
+func (act *rebootAction) ValidateConfig(
+	ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse,
+) {
+	var config rebootActionData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Member.IsNull() && !config.Node.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("member"),
+			tfdiag.ConflictConfigErrSummary,
+			"member and node cannot be configured together",
+		)
+	}
+	if config.Wait.ValueBool() && (!config.In.IsNull() || !config.At.IsNull()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("wait"),
+			tfdiag.ConflictConfigErrSummary,
+			"wait cannot be true with a reboot scheduled later (in or at)",
+		)
+	}
+	// the time of last boot compared after the reconnection is the one of the first member or node
+	// so it changes with the reboot only when all members or nodes are rebooted
+	if config.Wait.ValueBool() && !config.Member.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("wait"),
+			tfdiag.ConflictConfigErrSummary,
+			"wait cannot be true with the reboot of a single member",
+		)
+	}
+	if config.Wait.ValueBool() && !config.Node.IsNull() && !config.Node.IsUnknown() &&
+		config.Node.ValueString() != "all" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("wait"),
+			tfdiag.ConflictConfigErrSummary,
+			"wait cannot be true with the reboot of a single node (node need to be all)",
+		)
+	}
+	if !config.WaitTimeout.IsNull() && !config.Wait.IsUnknown() && !config.Wait.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("wait_timeout"),
+			tfdiag.MissingConfigErrSummary,
+			"wait must be true with wait_timeout",
+		)
+	}
+}
+
+func (act *rebootAction) Invoke(
+	ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse,
+) {
+	var config rebootActionData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clt := act.junosClient()
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Starting session to device",
+	})
+	junSess, err := clt.StartNewSession(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+		return
+	}
+	defer junSess.Close()
+
+	bootedTime := ""
+	if config.Wait.ValueBool() {
+		bootedTime = readBootedTimeBeforeReboot(ctx, junSess, resp)
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Requesting reboot",
+	})
+	status, err := junSess.Reboot(ctx, config.shutdownOptions())
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.ShutdownErrSummary, err.Error())
+
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Reboot requested: " + status,
+	})
+	if !config.Wait.ValueBool() {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Waiting for device to reboot",
+	})
+	waitTimeout := int64(defaultRebootWaitTimeout)
+	if v := config.WaitTimeout.ValueInt64(); v != 0 {
+		waitTimeout = v
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, time.Duration(waitTimeout)*time.Second)
+	defer cancel()
+	start := time.Now()
+	if err := junSess.WaitReconnect(waitCtx, rebootReconnectInterval, bootedTime, func(err error) {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Device not yet available after %s: %s", time.Since(start).Round(time.Second), err),
+		})
+	}); err != nil {
+		resp.Diagnostics.AddError(tfdiag.ShutdownErrSummary, err.Error())
+
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Device available after %s with Junos version %s",
+			time.Since(start).Round(time.Second), junSess.SystemInformation.OSVersion),
+	})
+}
+
+// readBootedTimeBeforeReboot returns the time of the last boot of device
+// to detect the reboot when waiting for the reconnection (empty if not available).
+func readBootedTimeBeforeReboot(
+	ctx context.Context, junSess *junos.Session, resp *action.InvokeResponse,
+) string {
+	bootedTime, err := junSess.SystemBootedTime(ctx)
+	if err != nil {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Time of last boot of device not available (%s), "+
+				"the reconnection will only be accepted after the device has been unavailable", err),
+		})
+
+		return ""
+	}
+
+	return bootedTime
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	rescueConfigOperationSave   = "save"
+	rescueConfigOperationDelete = "delete"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &rescueConfigAction{}
+	_ action.ActionWithConfigure = &rescueConfigAction{}
+)
+
+type rescueConfigAction struct {
+	client *junos.Client
+}
+
+func newRescueConfigAction() action.Action {
+	return &rescueConfigAction{}
+}
+
+func (act *rescueConfigAction) typeName() string {
+	return providerName + "_rescue_config"
+}
+
+func (act *rescueConfigAction) junosClient() *junos.Client {
+	return act.client
+}
+
+func (act *rescueConfigAction) Metadata(
+	_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_rescue_config"
+}
+
+func (act *rescueConfigAction) Configure(
+	ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedActionConfigureType(ctx, req, resp)
+
+		return
+	}
+	act.client = client
+}
+
+func (act *rescueConfigAction) Schema(
+	_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Save the active configuration as rescue configuration or delete the rescue configuration.",
+		Attributes: map[string]schema.Attribute{
+			"operation": schema.StringAttribute{
+				Optional:    true,
+				Description: "Operation on rescue configuration. Defaults to `save`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(rescueConfigOperationSave, rescueConfigOperationDelete),
+				},
+			},
+		},
+	}
+}
+
+type rescueConfigActionData struct {
+	Operation types.String `tfsdk:"operation"`
+}
+
+func (act *rescueConfigAction) Invoke(
+	ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse,
+) {
+	var config rescueConfigActionData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clt := act.junosClient()
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Starting session to device",
+	})
+	junSess, err := clt.StartNewSession(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+		return
+	}
+	defer junSess.Close()
+
+	if config.Operation.ValueString() == rescueConfigOperationDelete {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: "Deleting rescue configuration",
+		})
+		if err := junSess.RescueConfigDelete(ctx); err != nil {
+			resp.Diagnostics.AddError(tfdiag.RescueConfigErrSummary, err.Error())
+
+			return
+		}
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: "Rescue configuration deleted",
+		})
+
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Saving active configuration as rescue configuration",
+	})
+	if err := junSess.RescueConfigSave(ctx); err != nil {
+		resp.Diagnostics.AddError(tfdiag.RescueConfigErrSummary, err.Error())
+
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Rescue configuration saved",
+	})
+}
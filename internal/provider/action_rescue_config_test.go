@@ -0,0 +1,51 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccActionRescueConfig_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				// 1
+				ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+				ConfigDirectory:          config.TestStepDirectory(),
+			},
+			{
+				// 2
+				ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+				ConfigDirectory:          config.TestStepDirectory(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.junos_rescue_config.testacc", "exists", "true"),
+					resource.TestCheckResourceAttr("data.junos_rescue_config.testacc", "format", "set"),
+					resource.TestCheckResourceAttr("data.junos_rescue_config.testacc", "differs_from_active", "false"),
+					resource.TestCheckResourceAttrSet("data.junos_rescue_config.testacc_text", "config"),
+				),
+			},
+			{
+				// 3
+				ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+				ConfigDirectory:          config.TestStepDirectory(),
+			},
+			{
+				// 4
+				ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+				ConfigDirectory:          config.TestStepDirectory(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.junos_rescue_config.testacc", "exists", "false"),
+					resource.TestCheckNoResourceAttr("data.junos_rescue_config.testacc", "config"),
+					resource.TestCheckResourceAttr("data.junos_rescue_config.testacc", "differs_from_active", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,274 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// defaultRebootWaitTimeout: default time to wait the device after a reboot (in seconds).
+	defaultRebootWaitTimeout = 1800
+	// rebootReconnectInterval: time between each try to reconnect to device after a reboot.
+	rebootReconnectInterval = 30 * time.Second
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action                   = &softwareInstallAction{}
+	_ action.ActionWithConfigure      = &softwareInstallAction{}
+	_ action.ActionWithValidateConfig = &softwareInstallAction{}
+)
+
+type softwareInstallAction struct {
+	client *junos.Client
+}
+
+func newSoftwareInstallAction() action.Action {
+	return &softwareInstallAction{}
+}
+
+func (act *softwareInstallAction) typeName() string {
+	return providerName + "_software_install"
+}
+
+func (act *softwareInstallAction) junosClient() *junos.Client {
+	return act.client
+}
+
+func (act *softwareInstallAction) Metadata(
+	_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_software_install"
+}
+
+func (act *softwareInstallAction) Configure(
+	ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedActionConfigureType(ctx, req, resp)
+
+		return
+	}
+	act.client = client
+}
+
+func (act *softwareInstallAction) Schema(
+	_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Install a Junos software package on device (`request system software add`) " +
+			"and optionally reboot the device and wait for it.",
+		Attributes: map[string]schema.Attribute{
+			"package": schema.StringAttribute{
+				Required:    true,
+				Description: "The path of the package on device.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"source": schema.StringAttribute{
+				Optional: true,
+				Description: "The local path of the package to upload to device (at the `package` path) " +
+					"with SFTP before the installation.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"validate": schema.BoolAttribute{
+				Optional: true,
+				Description: "Validate (`true`) or not (`false`) the package against the current configuration. " +
+					"Defaults to the behavior of device.",
+			},
+			"no_copy": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Don't save a copy of the package on device.",
+			},
+			"reboot": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Reboot the device after the installation and wait for it.",
+			},
+			"reboot_wait_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Time (in seconds) to wait for the device after the reboot. Defaults to 1800.",
+				Validators: []validator.Int64{
+					int64validator.Between(60, 7200),
+				},
+			},
+			"expected_version": schema.StringAttribute{
+				Optional:    true,
+				Description: "The Junos version expected on device after the reboot, the action fails on mismatch.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+type softwareInstallActionData struct {
+	Package           types.String `tfsdk:"package"`
+	Source            types.String `tfsdk:"source"`
+	Validate          types.Bool   `tfsdk:"validate"`
+	NoCopy            types.Bool   `tfsdk:"no_copy"`
+	Reboot            types.Bool   `tfsdk:"reboot"`
+	RebootWaitTimeout types.Int64  `tfsdk:"reboot_wait_timeout"`
+	ExpectedVersion   types.String `tfsdk:"expected_version"`
+}
+
+func (act *softwareInstallAction) ValidateConfig(
+	ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse,
+) {
+	var config softwareInstallActionData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Reboot.IsUnknown() || config.Reboot.ValueBool() {
+		return
+	}
+	if !config.RebootWaitTimeout.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("reboot_wait_timeout"),
+			tfdiag.MissingConfigErrSummary,
+			"reboot must be true with reboot_wait_timeout",
+		)
+	}
+	if !config.ExpectedVersion.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expected_version"),
+			tfdiag.MissingConfigErrSummary,
+			"reboot must be true with expected_version",
+		)
+	}
+}
+
+func (act *softwareInstallAction) Invoke(
+	ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse,
+) {
+	var config softwareInstallActionData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clt := act.junosClient()
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Starting session to device",
+	})
+	junSess, err := clt.StartNewSession(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+		return
+	}
+	defer junSess.Close()
+	previousVersion := junSess.SystemInformation.OSVersion
+
+	if v := config.Source.ValueString(); v != "" {
+		sftpClt, err := clt.NewSFTPClient(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+			return
+		}
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Uploading %q to %q and verifying its checksum on device", v, config.Package.ValueString()),
+		})
+		_, err = fileUploadWithChecksum(ctx, sftpClt, junSess, v, config.Package.ValueString(), 0)
+		_ = sftpClt.Close()
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("source"), tfdiag.FileTransferErrSummary, err.Error())
+
+			return
+		}
+	}
+
+	bootedTime := ""
+	if config.Reboot.ValueBool() {
+		bootedTime = readBootedTimeBeforeReboot(ctx, junSess, resp)
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Installing package %q (Junos version %s on device)", config.Package.ValueString(), previousVersion),
+	})
+	output, err := junSess.SoftwareAdd(ctx, config.Package.ValueString(), junos.SoftwareAddOptions{
+		Validate: config.Validate.ValueBoolPointer(),
+		NoCopy:   config.NoCopy.ValueBool(),
+		Reboot:   config.Reboot.ValueBool(),
+	})
+	if output != "" {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: "Output of installation:\n" + output,
+		})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.SoftwareInstallErrSummary, err.Error())
+
+		return
+	}
+	if !config.Reboot.ValueBool() {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: "Package installed, the new software will be active after the next reboot",
+		})
+
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Waiting for device to reboot",
+	})
+	waitTimeout := int64(defaultRebootWaitTimeout)
+	if v := config.RebootWaitTimeout.ValueInt64(); v != 0 {
+		waitTimeout = v
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, time.Duration(waitTimeout)*time.Second)
+	defer cancel()
+	start := time.Now()
+	if err := junSess.WaitReconnect(waitCtx, rebootReconnectInterval, bootedTime, func(err error) {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Device not yet available after %s: %s", time.Since(start).Round(time.Second), err),
+		})
+	}); err != nil {
+		resp.Diagnostics.AddError(tfdiag.SoftwareInstallErrSummary, err.Error())
+
+		return
+	}
+
+	newVersion := junSess.SystemInformation.OSVersion
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Device available after %s with Junos version %s",
+			time.Since(start).Round(time.Second), newVersion),
+	})
+	if v := config.ExpectedVersion.ValueString(); v != "" && v != newVersion {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expected_version"),
+			tfdiag.SoftwareInstallErrSummary,
+			fmt.Sprintf("Junos version on device after reboot is %s, expected %s", newVersion, v),
+		)
+
+		return
+	}
+	if newVersion == previousVersion {
+		resp.Diagnostics.AddWarning(
+			tfdiag.SoftwareInstallWarnSummary,
+			fmt.Sprintf("Junos version on device unchanged (%s) after installation and reboot", newVersion),
+		)
+	}
+}
@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &tracerouteAction{}
+	_ action.ActionWithConfigure = &tracerouteAction{}
+)
+
+type tracerouteAction struct {
+	client *junos.Client
+}
+
+func newTracerouteAction() action.Action {
+	return &tracerouteAction{}
+}
+
+func (act *tracerouteAction) typeName() string {
+	return providerName + "_traceroute"
+}
+
+func (act *tracerouteAction) junosClient() *junos.Client {
+	return act.client
+}
+
+func (act *tracerouteAction) Metadata(
+	_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_traceroute"
+}
+
+func (act *tracerouteAction) Configure(
+	ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedActionConfigureType(ctx, req, resp)
+
+		return
+	}
+	act.client = client
+}
+
+func (act *tracerouteAction) Schema(
+	_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Trace the route to a host from the device and fail when the host is not reached.",
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				Required:    true,
+				Description: "Hostname or IP address of remote host.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"source": schema.StringAttribute{
+				Optional:    true,
+				Description: "Source address to use in outgoing traceroute packets.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"routing_instance": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of routing instance for traceroute attempt.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"interface": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of interface to use for outgoing traffic.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"ttl": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum time-to-live value (maximum number of hops).",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 255),
+				},
+			},
+			"wait": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of seconds to wait for response.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 86400),
+				},
+			},
+			"no_resolve": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Don't attempt to print addresses symbolically.",
+			},
+		},
+	}
+}
+
+type tracerouteActionData struct {
+	Host            types.String `tfsdk:"host"`
+	Source          types.String `tfsdk:"source"`
+	RoutingInstance types.String `tfsdk:"routing_instance"`
+	Interface       types.String `tfsdk:"interface"`
+	TTL             types.Int64  `tfsdk:"ttl"`
+	Wait            types.Int64  `tfsdk:"wait"`
+	NoResolve       types.Bool   `tfsdk:"no_resolve"`
+}
+
+func (act *tracerouteAction) Invoke(
+	ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse,
+) {
+	var config tracerouteActionData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	host := config.Host.ValueString()
+
+	clt := act.junosClient()
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Starting session to device",
+	})
+	junSess, err := clt.StartNewSession(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+		return
+	}
+	defer junSess.Close()
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Tracing route to " + host,
+	})
+	result, err := junSess.Traceroute(ctx, junos.TracerouteOptions{
+		Host:            host,
+		Source:          config.Source.ValueString(),
+		RoutingInstance: config.RoutingInstance.ValueString(),
+		Interface:       config.Interface.ValueString(),
+		TTL:             config.TTL.ValueInt64(),
+		Wait:            config.Wait.ValueInt64(),
+		NoResolve:       config.NoResolve.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.TracerouteErrSummary, err.Error())
+
+		return
+	}
+	hops := tracerouteHops(host, result)
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: hops,
+	})
+
+	if !result.Success {
+		resp.Diagnostics.AddError(
+			tfdiag.TracerouteErrSummary,
+			fmt.Sprintf("%s not reached\n%s", host, hops),
+		)
+	}
+}
+
+// tracerouteHops returns the hops of traceroute like the output of CLI.
+func tracerouteHops(host string, result junos.TracerouteResult) string {
+	var hops strings.Builder
+	hops.WriteString("traceroute to " + host)
+	if result.TargetIP != "" && result.TargetIP != host {
+		hops.WriteString(" (" + result.TargetIP + ")")
+	}
+	for _, hop := range result.Hops {
+		fmt.Fprintf(&hops, "\n%2d  ", hop.TTL)
+		if len(hop.Addresses) == 0 {
+			hops.WriteString("*")
+
+			continue
+		}
+		fmt.Fprintf(&hops, "%s  %.3f ms", strings.Join(hop.Addresses, ", "), hop.RTTAverage)
+	}
+
+	return hops.String()
+}
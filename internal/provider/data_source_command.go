@@ -0,0 +1,290 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &commandDataSource{}
+	_ datasource.DataSourceWithConfigure      = &commandDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &commandDataSource{}
+)
+
+type commandDataSource struct {
+	client *junos.Client
+}
+
+func (dsc *commandDataSource) typeName() string {
+	return providerName + "_command"
+}
+
+func (dsc *commandDataSource) junosClient() *junos.Client {
+	return dsc.client
+}
+
+func newCommandDataSource() datasource.DataSource {
+	return &commandDataSource{}
+}
+
+func (dsc *commandDataSource) Metadata(
+	_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = dsc.typeName()
+}
+
+func (dsc *commandDataSource) Configure(
+	ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedDataSourceConfigureType(ctx, req, resp)
+
+		return
+	}
+	dsc.client = client
+}
+
+func (dsc *commandDataSource) Schema(
+	_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Get the output of a read-only operational command or RPC on the Junos device.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "An identifier for the data source with format `<command or rpc>`.",
+			},
+			"command": schema.StringAttribute{
+				Optional:    true,
+				Description: "The `show` operational command (CLI) to run.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("rpc")),
+				},
+			},
+			"rpc": schema.StringAttribute{
+				Optional: true,
+				Description: "The read-only operational RPC (XML element) to run: `" +
+					strings.Join(junos.ReadOnlyRPCs(), "`, `") + "`.",
+			},
+			"format": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The format of output. Defaults to 'text'.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						junos.ConfigFormatText,
+						junos.ConfigFormatXML,
+						junos.ConfigFormatJSON,
+					),
+				},
+			},
+			"output": schema.StringAttribute{
+				Computed:    true,
+				Description: "The raw output in the requested format.",
+			},
+			"output_json": schema.DynamicAttribute{
+				Computed:    true,
+				Description: "The output parsed when format is `json`.",
+			},
+		},
+	}
+}
+
+type commandDataSourceData struct {
+	ID         types.String  `tfsdk:"id"`
+	Command    types.String  `tfsdk:"command"`
+	RPC        types.String  `tfsdk:"rpc"`
+	Format     types.String  `tfsdk:"format"`
+	Output     types.String  `tfsdk:"output"`
+	OutputJSON types.Dynamic `tfsdk:"output_json"`
+}
+
+func (dsc *commandDataSource) ValidateConfig(
+	ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse,
+) {
+	var config commandDataSourceData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Command.IsNull() && !config.Command.IsUnknown() {
+		if err := junos.CheckReadOnlyCommand(config.Command.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("command"),
+				"Bad Value Error",
+				err.Error(),
+			)
+		}
+	}
+	if !config.RPC.IsNull() && !config.RPC.IsUnknown() {
+		if err := junos.CheckReadOnlyRPC(config.RPC.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rpc"),
+				"Bad Value Error",
+				err.Error(),
+			)
+		}
+	}
+}
+
+func (dsc *commandDataSource) Read(
+	ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse,
+) {
+	var data commandDataSourceData
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var _ dataSourceDataReadWithoutArg = &data
+	defaultDataSourceRead(
+		ctx,
+		dsc,
+		nil,
+		&data,
+		resp,
+	)
+}
+
+func (dscData *commandDataSourceData) fillID() {
+	if v := dscData.Command.ValueString(); v != "" {
+		dscData.ID = types.StringValue(v)
+	} else {
+		dscData.ID = types.StringValue(dscData.RPC.ValueString())
+	}
+}
+
+func (dscData *commandDataSourceData) read(
+	ctx context.Context, junSess *junos.Session,
+) error {
+	if v := dscData.Format.ValueString(); v == "" {
+		dscData.Format = types.StringValue(junos.ConfigFormatText)
+	}
+	format := dscData.Format.ValueString()
+
+	var output string
+	if cmd := dscData.Command.ValueString(); cmd != "" {
+		if err := junos.CheckReadOnlyCommand(cmd); err != nil {
+			return err
+		}
+		var err error
+		output, err = junSess.CommandFormat(ctx, cmd, format)
+		if err != nil {
+			return fmt.Errorf("running command %q: %w", cmd, err)
+		}
+	} else {
+		rpc := dscData.RPC.ValueString()
+		if err := junos.CheckReadOnlyRPC(rpc); err != nil {
+			return err
+		}
+		var err error
+		output, err = junSess.RPCFormat(ctx, rpc, format)
+		if err != nil {
+			return fmt.Errorf("running RPC %q: %w", rpc, err)
+		}
+	}
+
+	dscData.Output = types.StringValue(output)
+	dscData.OutputJSON = types.DynamicNull()
+	if format == junos.ConfigFormatJSON {
+		value, err := jsonToAttrValue(ctx, output)
+		if err != nil {
+			return fmt.Errorf("parsing json output: %w", err)
+		}
+		dscData.OutputJSON = types.DynamicValue(value)
+	}
+
+	return nil
+}
+
+// jsonToAttrValue converts a JSON document to a value with objects, tuples, strings, numbers and bools.
+func jsonToAttrValue(ctx context.Context, document string) (attr.Value, error) {
+	decoder := json.NewDecoder(strings.NewReader(document))
+	decoder.UseNumber()
+	var data any
+	if err := decoder.Decode(&data); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("unexpected data after the JSON document")
+	}
+
+	return jsonDataToAttrValue(ctx, data)
+}
+
+func jsonDataToAttrValue(ctx context.Context, data any) (attr.Value, error) {
+	switch v := data.(type) {
+	case nil:
+		return types.StringNull(), nil
+	case bool:
+		return types.BoolValue(v), nil
+	case string:
+		return types.StringValue(v), nil
+	case json.Number:
+		number, _, err := big.ParseFloat(v.String(), 10, 512, big.ToNearestEven)
+		if err != nil {
+			return nil, fmt.Errorf("parsing number %q: %w", v, err)
+		}
+
+		return types.NumberValue(number), nil
+	case []any:
+		elemTypes := make([]attr.Type, len(v))
+		elems := make([]attr.Value, len(v))
+		for i, e := range v {
+			value, err := jsonDataToAttrValue(ctx, e)
+			if err != nil {
+				return nil, err
+			}
+			elemTypes[i] = value.Type(ctx)
+			elems[i] = value
+		}
+		tuple, diags := types.TupleValue(elemTypes, elems)
+		if diags.HasError() {
+			return nil, errors.New("converting JSON array to tuple")
+		}
+
+		return tuple, nil
+	case map[string]any:
+		attrTypes := make(map[string]attr.Type, len(v))
+		attrs := make(map[string]attr.Value, len(v))
+		for k, e := range v {
+			value, err := jsonDataToAttrValue(ctx, e)
+			if err != nil {
+				return nil, err
+			}
+			attrTypes[k] = value.Type(ctx)
+			attrs[k] = value
+		}
+		object, diags := types.ObjectValue(attrTypes, attrs)
+		if diags.HasError() {
+			return nil, errors.New("converting JSON object to object")
+		}
+
+		return object, nil
+	}
+
+	return nil, fmt.Errorf("unexpected JSON value %v", data)
+}
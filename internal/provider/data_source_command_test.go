@@ -0,0 +1,43 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccDataSourceCommand_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: config.TestStepDirectory(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.junos_command.test_text", "id", "show version"),
+					resource.TestCheckResourceAttr("data.junos_command.test_text", "format", "text"),
+					resource.TestMatchResourceAttr("data.junos_command.test_text", "output",
+						regexp.MustCompile(`Junos`)),
+					resource.TestCheckNoResourceAttr("data.junos_command.test_text", "output_json"),
+					resource.TestCheckResourceAttr("data.junos_command.test_xml", "format", "xml"),
+					resource.TestMatchResourceAttr("data.junos_command.test_xml", "output",
+						regexp.MustCompile(`^<software-information>`)),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.junos_command.test_json",
+						tfjsonpath.New("output_json").AtMapKey("software-information"),
+						knownvalue.NotNull()),
+				},
+			},
+			{
+				ConfigDirectory: config.TestStepDirectory(),
+				ExpectError:     regexp.MustCompile(`not a read-only command`),
+			},
+		},
+	})
+}
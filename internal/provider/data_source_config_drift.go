@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/configtree"
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &configDriftDataSource{}
+	_ datasource.DataSourceWithConfigure = &configDriftDataSource{}
+)
+
+type configDriftDataSource struct {
+	client *junos.Client
+}
+
+func (dsc *configDriftDataSource) typeName() string {
+	return providerName + "_config_drift"
+}
+
+func (dsc *configDriftDataSource) junosClient() *junos.Client {
+	return dsc.client
+}
+
+func newConfigDriftDataSource() datasource.DataSource {
+	return &configDriftDataSource{}
+}
+
+func (dsc *configDriftDataSource) Metadata(
+	_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = dsc.typeName()
+}
+
+func (dsc *configDriftDataSource) Configure(
+	ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedDataSourceConfigureType(ctx, req, resp)
+
+		return
+	}
+	dsc.client = client
+}
+
+func (dsc *configDriftDataSource) Schema(
+	_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Get the lines of committed configuration, under hierarchy levels, " +
+			"that are not owned by a resource managed with the provider.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "An identifier for the data source.",
+			},
+			"hierarchies": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "Hierarchy levels (without `set` word) to compare.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.NoNullValues(),
+					listvalidator.ValueStringsAre(
+						stringvalidator.LengthAtLeast(1),
+					),
+				},
+			},
+			"drift": schema.BoolAttribute{
+				Computed:    true,
+				Description: "At least one line under the hierarchy levels is not owned by a resource.",
+			},
+			"unmanaged_lines": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Set lines under the hierarchy levels not owned by a resource.",
+			},
+		},
+	}
+}
+
+type configDriftDataSourceData struct {
+	ID                 types.String       `tfsdk:"id"`
+	Hierarchies        []types.String     `tfsdk:"hierarchies"`
+	Drift              types.Bool         `tfsdk:"drift"`
+	UnmanagedLines     []types.String     `tfsdk:"unmanaged_lines"`
+	managedHierarchies []managedHierarchy `tfsdk:"-"`
+}
+
+func (dsc *configDriftDataSource) Read(
+	ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse,
+) {
+	var data configDriftDataSourceData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.managedHierarchies = managedHierarchies(dsc.client)
+
+	var _ dataSourceDataReadWithoutArg = &data
+	defaultDataSourceRead(
+		ctx,
+		dsc,
+		nil,
+		&data,
+		resp,
+	)
+}
+
+func (dscData *configDriftDataSourceData) fillID() {
+	dscData.ID = types.StringValue("config_drift")
+}
+
+func (dscData *configDriftDataSourceData) read(
+	ctx context.Context, junSess *junos.Session,
+) error {
+	config, err := junSess.ConfigGet(ctx, junos.ConfigFormatSet)
+	if err != nil {
+		return fmt.Errorf("getting configuration: %w", err)
+	}
+
+	hierarchies := make([][]string, len(dscData.Hierarchies))
+	for i, v := range dscData.Hierarchies {
+		hierarchies[i] = configtree.SplitWords(strings.TrimPrefix(strings.TrimSpace(v.ValueString()), junos.SetLS))
+	}
+
+	dscData.UnmanagedLines = make([]types.String, 0)
+	for item := range strings.SplitSeq(config, "\n") {
+		item = strings.TrimSpace(item)
+		itemTrim, ok := strings.CutPrefix(item, junos.SetLS)
+		if !ok {
+			continue
+		}
+		words := configtree.SplitWords(itemTrim)
+		underHierarchy := false
+		for _, hierarchy := range hierarchies {
+			if configtree.HasPrefix(words, hierarchy) {
+				underHierarchy = true
+
+				break
+			}
+		}
+		if !underHierarchy {
+			continue
+		}
+		managed := false
+		for _, hierarchy := range dscData.managedHierarchies {
+			if hierarchy.owns(words) {
+				managed = true
+
+				break
+			}
+		}
+		if !managed {
+			dscData.UnmanagedLines = append(dscData.UnmanagedLines, types.StringValue(item))
+		}
+	}
+	dscData.Drift = types.BoolValue(len(dscData.UnmanagedLines) > 0)
+
+	return nil
+}
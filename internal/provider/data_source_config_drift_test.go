@@ -0,0 +1,46 @@
+package provider_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceConfigDrift_basic(t *testing.T) {
+	testaccInterface := junos.DefaultInterfaceTestAcc
+	if iface := os.Getenv("TESTACC_INTERFACE"); iface != "" {
+		testaccInterface = iface
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: config.TestStepDirectory(),
+				ConfigVariables: map[string]config.Variable{
+					"interface": config.StringVariable(testaccInterface),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.junos_config_drift.testacc",
+						"drift", "true"),
+					resource.TestCheckTypeSetElemAttr("data.junos_config_drift.testacc",
+						"unmanaged_lines.*",
+						"set policy-options prefix-list testacc_config_drift_unmanaged 192.0.2.128/25"),
+					resource.TestCheckTypeSetElemAttr("data.junos_config_drift.testacc",
+						"unmanaged_lines.*",
+						"set interfaces "+testaccInterface+" unit 100 description testacc_config_drift_unmanaged"),
+				),
+			},
+			{
+				ConfigDirectory: config.TestStepDirectory(),
+				ConfigVariables: map[string]config.Variable{
+					"interface": config.StringVariable(testaccInterface),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &pingDataSource{}
+	_ datasource.DataSourceWithConfigure = &pingDataSource{}
+)
+
+type pingDataSource struct {
+	client *junos.Client
+}
+
+func (dsc *pingDataSource) typeName() string {
+	return providerName + "_ping"
+}
+
+func (dsc *pingDataSource) junosClient() *junos.Client {
+	return dsc.client
+}
+
+func newPingDataSource() datasource.DataSource {
+	return &pingDataSource{}
+}
+
+func (dsc *pingDataSource) Metadata(
+	_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = dsc.typeName()
+}
+
+func (dsc *pingDataSource) Configure(
+	ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedDataSourceConfigureType(ctx, req, resp)
+
+		return
+	}
+	dsc.client = client
+}
+
+func (dsc *pingDataSource) Schema(
+	_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Ping a host from the Junos device and get the loss and RTT statistics.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				Description: "An identifier for the data source with format " +
+					"`<host>` or `<host>" + junos.IDSeparator + "<routing_instance>`.",
+			},
+			"host": schema.StringAttribute{
+				Required:    true,
+				Description: "Hostname or IP address of remote host.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"count": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Number of ping requests to send. Defaults to `5`.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 2000000000),
+				},
+			},
+			"size": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Size of request packets (bytes).",
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65468),
+				},
+			},
+			"source": schema.StringAttribute{
+				Optional:    true,
+				Description: "Source address of echo request.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"routing_instance": schema.StringAttribute{
+				Optional:    true,
+				Description: "Routing instance for ping attempt.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"interface": schema.StringAttribute{
+				Optional:    true,
+				Description: "Source interface (multicast, all-ones, unrouted packets).",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"do_not_fragment": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Don't fragment echo request packets (IPv4).",
+			},
+			"target_ip": schema.StringAttribute{
+				Computed:    true,
+				Description: "IP address of remote host.",
+			},
+			"probes_sent": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of ping requests sent.",
+			},
+			"responses_received": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of ping responses received.",
+			},
+			"packet_loss": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Packet loss (in percent).",
+			},
+			"rtt_minimum": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Minimum round-trip time (in milliseconds), null without response.",
+			},
+			"rtt_maximum": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Maximum round-trip time (in milliseconds), null without response.",
+			},
+			"rtt_average": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Average round-trip time (in milliseconds), null without response.",
+			},
+			"rtt_stddev": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Standard deviation of round-trip time (in milliseconds), null without response.",
+			},
+		},
+	}
+}
+
+type pingDataSourceData struct {
+	ID                types.String  `tfsdk:"id"`
+	Host              types.String  `tfsdk:"host"`
+	Count             types.Int64   `tfsdk:"count"`
+	Size              types.Int64   `tfsdk:"size"`
+	Source            types.String  `tfsdk:"source"`
+	RoutingInstance   types.String  `tfsdk:"routing_instance"`
+	Interface         types.String  `tfsdk:"interface"`
+	DoNotFragment     types.Bool    `tfsdk:"do_not_fragment"`
+	TargetIP          types.String  `tfsdk:"target_ip"`
+	ProbesSent        types.Int64   `tfsdk:"probes_sent"`
+	ResponsesReceived types.Int64   `tfsdk:"responses_received"`
+	PacketLoss        types.Float64 `tfsdk:"packet_loss"`
+	RTTMinimum        types.Float64 `tfsdk:"rtt_minimum"`
+	RTTMaximum        types.Float64 `tfsdk:"rtt_maximum"`
+	RTTAverage        types.Float64 `tfsdk:"rtt_average"`
+	RTTStddev         types.Float64 `tfsdk:"rtt_stddev"`
+}
+
+func (dsc *pingDataSource) Read(
+	ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse,
+) {
+	var data pingDataSourceData
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var _ dataSourceDataReadWithoutArg = &data
+	defaultDataSourceRead(
+		ctx,
+		dsc,
+		nil,
+		&data,
+		resp,
+	)
+}
+
+func (dscData *pingDataSourceData) fillID() {
+	if v := dscData.RoutingInstance.ValueString(); v != "" {
+		dscData.ID = types.StringValue(dscData.Host.ValueString() + junos.IDSeparator + v)
+	} else {
+		dscData.ID = types.StringValue(dscData.Host.ValueString())
+	}
+}
+
+func (dscData *pingDataSourceData) read(
+	ctx context.Context, junSess *junos.Session,
+) error {
+	if dscData.Count.IsNull() || dscData.Count.IsUnknown() {
+		dscData.Count = types.Int64Value(defaultPingCount)
+	}
+	result, err := junSess.Ping(ctx, junos.PingOptions{
+		Host:            dscData.Host.ValueString(),
+		Count:           dscData.Count.ValueInt64(),
+		Size:            dscData.Size.ValueInt64Pointer(),
+		Source:          dscData.Source.ValueString(),
+		RoutingInstance: dscData.RoutingInstance.ValueString(),
+		Interface:       dscData.Interface.ValueString(),
+		DoNotFragment:   dscData.DoNotFragment.ValueBool(),
+	})
+	if err != nil {
+		return err
+	}
+
+	dscData.TargetIP = types.StringValue(result.TargetIP)
+	dscData.ProbesSent = types.Int64Value(result.ProbesSent)
+	dscData.ResponsesReceived = types.Int64Value(result.ResponsesReceived)
+	dscData.PacketLoss = types.Float64Value(result.PacketLoss)
+	if result.ResponsesReceived > 0 {
+		dscData.RTTMinimum = types.Float64Value(result.RTTMinimum)
+		dscData.RTTMaximum = types.Float64Value(result.RTTMaximum)
+		dscData.RTTAverage = types.Float64Value(result.RTTAverage)
+		dscData.RTTStddev = types.Float64Value(result.RTTStddev)
+	} else {
+		dscData.RTTMinimum = types.Float64Null()
+		dscData.RTTMaximum = types.Float64Null()
+		dscData.RTTAverage = types.Float64Null()
+		dscData.RTTStddev = types.Float64Null()
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourcePing_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: config.TestStepDirectory(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.junos_ping.testacc", "id", "127.0.0.1"),
+					resource.TestCheckResourceAttr("data.junos_ping.testacc", "target_ip", "127.0.0.1"),
+					resource.TestCheckResourceAttr("data.junos_ping.testacc", "probes_sent", "3"),
+					resource.TestCheckResourceAttr("data.junos_ping.testacc", "responses_received", "3"),
+					resource.TestCheckResourceAttr("data.junos_ping.testacc", "packet_loss", "0"),
+					resource.TestCheckResourceAttrSet("data.junos_ping.testacc", "rtt_average"),
+					resource.TestCheckResourceAttr("data.junos_ping.testacc_default", "count", "5"),
+				),
+			},
+		},
+	})
+}
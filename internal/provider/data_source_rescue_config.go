@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/configtree"
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &rescueConfigDataSource{}
+	_ datasource.DataSourceWithConfigure = &rescueConfigDataSource{}
+)
+
+type rescueConfigDataSource struct {
+	client *junos.Client
+}
+
+func (dsc *rescueConfigDataSource) typeName() string {
+	return providerName + "_rescue_config"
+}
+
+func (dsc *rescueConfigDataSource) junosClient() *junos.Client {
+	return dsc.client
+}
+
+func newRescueConfigDataSource() datasource.DataSource {
+	return &rescueConfigDataSource{}
+}
+
+func (dsc *rescueConfigDataSource) Metadata(
+	_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = dsc.typeName()
+}
+
+func (dsc *rescueConfigDataSource) Configure(
+	ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedDataSourceConfigureType(ctx, req, resp)
+
+		return
+	}
+	dsc.client = client
+}
+
+func (dsc *rescueConfigDataSource) Schema(
+	_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Get the rescue configuration of the Junos device " +
+			"and whether it differs from the active committed configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "An identifier for the data source.",
+			},
+			"format": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The format of `config`. Defaults to 'set'.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(junos.ConfigFormatSet, junos.ConfigFormatText),
+				},
+			},
+			"exists": schema.BoolAttribute{
+				Computed:    true,
+				Description: "The device has a rescue configuration.",
+			},
+			"config": schema.StringAttribute{
+				Computed:    true,
+				Description: "The rescue configuration in the requested format (null without rescue configuration).",
+			},
+			"differs_from_active": schema.BoolAttribute{
+				Computed: true,
+				Description: "The rescue configuration differs from the active committed configuration " +
+					"(true without rescue configuration).",
+			},
+		},
+	}
+}
+
+type rescueConfigDataSourceData struct {
+	ID                types.String `tfsdk:"id"`
+	Format            types.String `tfsdk:"format"`
+	Exists            types.Bool   `tfsdk:"exists"`
+	Config            types.String `tfsdk:"config"`
+	DiffersFromActive types.Bool   `tfsdk:"differs_from_active"`
+}
+
+func (dsc *rescueConfigDataSource) Read(
+	ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse,
+) {
+	var data rescueConfigDataSourceData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var _ dataSourceDataReadWithoutArg = &data
+	defaultDataSourceRead(
+		ctx,
+		dsc,
+		nil,
+		&data,
+		resp,
+	)
+}
+
+func (dscData *rescueConfigDataSourceData) fillID() {
+	dscData.ID = types.StringValue("rescue_config")
+}
+
+func (dscData *rescueConfigDataSourceData) read(
+	ctx context.Context, junSess *junos.Session,
+) error {
+	if v := dscData.Format.ValueString(); v == "" {
+		dscData.Format = types.StringValue(junos.ConfigFormatSet)
+	}
+
+	rescueSet, err := junSess.RescueConfigGet(ctx, junos.ConfigFormatSet)
+	if err != nil {
+		return err
+	}
+	if rescueSet == "" {
+		dscData.Exists = types.BoolValue(false)
+		dscData.Config = types.StringNull()
+		dscData.DiffersFromActive = types.BoolValue(true)
+
+		return nil
+	}
+	dscData.Exists = types.BoolValue(true)
+	if format := dscData.Format.ValueString(); format == junos.ConfigFormatSet {
+		dscData.Config = types.StringValue(rescueSet)
+	} else {
+		rescue, err := junSess.RescueConfigGet(ctx, format)
+		if err != nil {
+			return err
+		}
+		dscData.Config = types.StringValue(rescue)
+	}
+
+	active, err := junSess.ConfigGet(ctx, junos.ConfigFormatSet)
+	if err != nil {
+		return fmt.Errorf("getting configuration: %w", err)
+	}
+	rescueTree, err := configtree.Parse(rescueSet)
+	if err != nil {
+		return fmt.Errorf("parsing rescue configuration: %w", err)
+	}
+	activeTree, err := configtree.ParseOutput(active)
+	if err != nil {
+		return fmt.Errorf("parsing configuration: %w", err)
+	}
+	rescueLines := rescueTree.Lines()
+	activeLines := activeTree.Lines()
+	slices.Sort(rescueLines)
+	slices.Sort(activeLines)
+	dscData.DiffersFromActive = types.BoolValue(!slices.Equal(rescueLines, activeLines))
+
+	return nil
+}
@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &tracerouteDataSource{}
+	_ datasource.DataSourceWithConfigure = &tracerouteDataSource{}
+)
+
+type tracerouteDataSource struct {
+	client *junos.Client
+}
+
+func (dsc *tracerouteDataSource) typeName() string {
+	return providerName + "_traceroute"
+}
+
+func (dsc *tracerouteDataSource) junosClient() *junos.Client {
+	return dsc.client
+}
+
+func newTracerouteDataSource() datasource.DataSource {
+	return &tracerouteDataSource{}
+}
+
+func (dsc *tracerouteDataSource) Metadata(
+	_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = dsc.typeName()
+}
+
+func (dsc *tracerouteDataSource) Configure(
+	ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedDataSourceConfigureType(ctx, req, resp)
+
+		return
+	}
+	dsc.client = client
+}
+
+func (dsc *tracerouteDataSource) Schema(
+	_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Trace the route to a host from the Junos device and get the hops.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				Description: "An identifier for the data source with format " +
+					"`<host>` or `<host>" + junos.IDSeparator + "<routing_instance>`.",
+			},
+			"host": schema.StringAttribute{
+				Required:    true,
+				Description: "Hostname or IP address of remote host.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"source": schema.StringAttribute{
+				Optional:    true,
+				Description: "Source address to use in outgoing traceroute packets.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"routing_instance": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of routing instance for traceroute attempt.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"interface": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of interface to use for outgoing traffic.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"ttl": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum time-to-live value (maximum number of hops).",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 255),
+				},
+			},
+			"wait": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of seconds to wait for response.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 86400),
+				},
+			},
+			"no_resolve": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Don't attempt to print addresses symbolically.",
+			},
+			"target_ip": schema.StringAttribute{
+				Computed:    true,
+				Description: "IP address of remote host.",
+			},
+			"success": schema.BoolAttribute{
+				Computed:    true,
+				Description: "The remote host has been reached.",
+			},
+			"hops": schema.ListAttribute{
+				Computed:    true,
+				Description: "For each hop, the addresses that answered and the average round-trip time.",
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"ttl":         types.Int64Type,
+						"addresses":   types.ListType{}.WithElementType(types.StringType),
+						"rtt_average": types.Float64Type,
+					},
+				},
+			},
+		},
+	}
+}
+
+type tracerouteDataSourceData struct {
+	ID              types.String                    `tfsdk:"id"`
+	Host            types.String                    `tfsdk:"host"`
+	Source          types.String                    `tfsdk:"source"`
+	RoutingInstance types.String                    `tfsdk:"routing_instance"`
+	Interface       types.String                    `tfsdk:"interface"`
+	TTL             types.Int64                     `tfsdk:"ttl"`
+	Wait            types.Int64                     `tfsdk:"wait"`
+	NoResolve       types.Bool                      `tfsdk:"no_resolve"`
+	TargetIP        types.String                    `tfsdk:"target_ip"`
+	Success         types.Bool                      `tfsdk:"success"`
+	Hops            []tracerouteDataSourceBlockHops `tfsdk:"hops"`
+}
+
+type tracerouteDataSourceBlockHops struct {
+	TTL        types.Int64    `tfsdk:"ttl"`
+	Addresses  []types.String `tfsdk:"addresses"`
+	RTTAverage types.Float64  `tfsdk:"rtt_average"`
+}
+
+func (dsc *tracerouteDataSource) Read(
+	ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse,
+) {
+	var data tracerouteDataSourceData
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var _ dataSourceDataReadWithoutArg = &data
+	defaultDataSourceRead(
+		ctx,
+		dsc,
+		nil,
+		&data,
+		resp,
+	)
+}
+
+func (dscData *tracerouteDataSourceData) fillID() {
+	if v := dscData.RoutingInstance.ValueString(); v != "" {
+		dscData.ID = types.StringValue(dscData.Host.ValueString() + junos.IDSeparator + v)
+	} else {
+		dscData.ID = types.StringValue(dscData.Host.ValueString())
+	}
+}
+
+func (dscData *tracerouteDataSourceData) read(
+	ctx context.Context, junSess *junos.Session,
+) error {
+	result, err := junSess.Traceroute(ctx, junos.TracerouteOptions{
+		Host:            dscData.Host.ValueString(),
+		Source:          dscData.Source.ValueString(),
+		RoutingInstance: dscData.RoutingInstance.ValueString(),
+		Interface:       dscData.Interface.ValueString(),
+		TTL:             dscData.TTL.ValueInt64(),
+		Wait:            dscData.Wait.ValueInt64(),
+		NoResolve:       dscData.NoResolve.ValueBool(),
+	})
+	if err != nil {
+		return err
+	}
+
+	dscData.TargetIP = types.StringValue(result.TargetIP)
+	dscData.Success = types.BoolValue(result.Success)
+	dscData.Hops = make([]tracerouteDataSourceBlockHops, len(result.Hops))
+	for i, hop := range result.Hops {
+		dscData.Hops[i].TTL = types.Int64Value(hop.TTL)
+		dscData.Hops[i].Addresses = make([]types.String, len(hop.Addresses))
+		for j, address := range hop.Addresses {
+			dscData.Hops[i].Addresses[j] = types.StringValue(address)
+		}
+		if len(hop.Addresses) > 0 {
+			dscData.Hops[i].RTTAverage = types.Float64Value(hop.RTTAverage)
+		} else {
+			dscData.Hops[i].RTTAverage = types.Float64Null()
+		}
+	}
+
+	return nil
+}
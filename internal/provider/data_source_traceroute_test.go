@@ -0,0 +1,26 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceTraceroute_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: config.TestStepDirectory(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.junos_traceroute.testacc", "id", "127.0.0.1"),
+					resource.TestCheckResourceAttr("data.junos_traceroute.testacc", "success", "true"),
+					resource.TestCheckResourceAttr("data.junos_traceroute.testacc", "hops.#", "1"),
+					resource.TestCheckResourceAttr("data.junos_traceroute.testacc", "hops.0.addresses.0", "127.0.0.1"),
+				),
+			},
+		},
+	})
+}
@@ -51,7 +51,7 @@ func defaultDataSourceRead(
 	}
 	defer junSess.Close()
 
-	junos.MutexLock()
+	junSess.ReadLock()
 	if data0, ok := data.(dataSourceDataReadWithoutArg); ok {
 		err = data0.read(ctx, junSess)
 	}
@@ -61,7 +61,7 @@ func defaultDataSourceRead(
 	if data1and2, ok := data.(dataSourceDataReadWith1String2Bool); ok {
 		err = data1and2.read(ctx, mainAttrValues[0].(string), mainAttrValues[1].(bool), mainAttrValues[2].(bool), junSess)
 	}
-	junos.MutexUnlock()
+	junSess.ReadUnlock()
 	if err != nil {
 		resp.Diagnostics.AddError(tfdiag.ReadErrSummary, err.Error())
 
@@ -89,11 +89,11 @@ func defaultDataSourceReadFromResource(
 	}
 	defer junSess.Close()
 
-	junos.MutexLock()
+	junSess.ReadLock()
 	if data1, ok := rscData.(resourceDataReadFrom1String); ok {
 		err = data1.read(ctx, mainAttrValues[0], junSess)
 	}
-	junos.MutexUnlock()
+	junSess.ReadUnlock()
 	if err != nil {
 		resp.Diagnostics.AddError(tfdiag.ReadErrSummary, err.Error())
 
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// listResourceIDs: func to generate the `id` of each resource found on device.
+type listResourceIDs func(context.Context, *junos.Session) ([]string, error)
+
+func defaultListResourceSchemaDescription(
+	rsc resourceJunosNameable,
+) string {
+	return "List " + rsc.junosName() + " configured on device."
+}
+
+type listResourceConfigRoutingInstance struct {
+	RoutingInstance types.String `tfsdk:"routing_instance"`
+}
+
+func (listResourceConfigRoutingInstance) attributesSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"routing_instance": schema.StringAttribute{
+			Optional:    true,
+			Description: "Routing instance where to list resources if not root level. Defaults to `default`.",
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 63),
+				tfvalidator.StringFormat(tfvalidator.DefaultFormat),
+			},
+		},
+	}
+}
+
+func (config *listResourceConfigRoutingInstance) routingInstance() string {
+	if v := config.RoutingInstance.ValueString(); v != "" {
+		return v
+	}
+
+	return junos.DefaultW
+}
+
+// defaultListResourceList streams a result for each id returned by listIDs
+// with the identity of resource (required by Terraform for each result of a list)
+// and, when requested, the resource read with data generated by newData.
+//
+// An object removed from device between the listing and the read is skipped.
+func defaultListResourceList(
+	ctx context.Context,
+	rsc junosResourceWithIdentity,
+	listIDs listResourceIDs,
+	newData func() resourceDataNullID,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	stream.Results = func(push func(list.ListResult) bool) {
+		junSess, err := rsc.junosClient().StartNewSession(ctx)
+		if err != nil {
+			var diags diag.Diagnostics
+			diags.AddError(tfdiag.StartSessErrSummary, err.Error())
+			push(list.ListResult{Diagnostics: diags})
+
+			return
+		}
+		defer junSess.Close()
+
+		junSess.ReadLock()
+		ids, err := listIDs(ctx, junSess)
+		junSess.ReadUnlock()
+		if err != nil {
+			var diags diag.Diagnostics
+			diags.AddError(tfdiag.ConfigReadErrSummary, err.Error())
+			push(list.ListResult{Diagnostics: diags})
+
+			return
+		}
+
+		attributes := rsc.identityAttributes()
+		pushed := int64(0)
+		alreadyPushed := make(map[string]struct{})
+		for _, id := range ids {
+			if req.Limit > 0 && pushed >= req.Limit {
+				return
+			}
+			if _, ok := alreadyPushed[id]; ok {
+				continue
+			}
+			alreadyPushed[id] = struct{}{}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = id
+			result.Diagnostics.Append(defaultResourceIdentitySetFromID(ctx, rsc, id, result.Identity)...)
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				idList := strings.SplitN(id, junos.IDSeparator, len(attributes))
+				mainAttrValues := make([]any, len(attributes))
+				for i, attr := range attributes {
+					mainAttrValues[i] = attr.defaultValue
+					if i < len(idList) {
+						mainAttrValues[i] = idList[i]
+					}
+				}
+
+				data := newData()
+				junSess.ReadLock()
+				err := defaultResourceDataRead(ctx, mainAttrValues, data, junSess)
+				junSess.ReadUnlock()
+				switch {
+				case err != nil:
+					result.Diagnostics.AddError(tfdiag.ConfigReadErrSummary, err.Error())
+				case data.nullID():
+					// removed from device since the listing
+					continue
+				default:
+					result.Diagnostics.Append(result.Resource.Set(ctx, data)...)
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+			pushed++
+		}
+	}
+}
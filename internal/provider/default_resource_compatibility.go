@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// compatibilityRequirement: attribute or block that needs a feature of compatibility registry.
+type compatibilityRequirement struct {
+	path    path.Path
+	feature string
+}
+
+// resourceDataCompatibilityRequirements: resource data with attributes or blocks
+// available only on some platform families or Junos versions.
+type resourceDataCompatibilityRequirements interface {
+	compatibilityRequirements() []compatibilityRequirement
+}
+
+// defaultResourceCheckCompatibility checks the requirements of data, if any, with the device
+// and adds an attribute error for each requirement not respected.
+//
+// Return false if an error was added.
+func defaultResourceCheckCompatibility(
+	data any, junSess *junos.Session, diags *diag.Diagnostics,
+) bool {
+	dataRequirements, ok := data.(resourceDataCompatibilityRequirements)
+	if !ok {
+		return true
+	}
+
+	compatible := true
+	for _, requirement := range dataRequirements.compatibilityRequirements() {
+		if err := junSess.CheckFeature(requirement.feature); err != nil {
+			diags.AddAttributeError(requirement.path, tfdiag.CompatibilityErrSummary, err.Error())
+			compatible = false
+		}
+	}
+
+	return compatible
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// resourceIdentityAttribute: an element of the resource identity
+// in the same position as in the `id` attribute (split with junos.IDSeparator).
+type resourceIdentityAttribute struct {
+	name        string
+	description string
+	// value to use when the element is missing in `id`,
+	// the attribute is optional for import when it is set
+	defaultValue string
+	// element is not in `id` when value is empty,
+	// the attribute is optional for import
+	omitEmptyInID bool
+}
+
+type junosResourceWithIdentity interface {
+	junosResource
+	identityAttributes() []resourceIdentityAttribute
+}
+
+func defaultResourceIdentitySchema(
+	rsc junosResourceWithIdentity,
+) identityschema.Schema {
+	attributes := make(map[string]identityschema.Attribute)
+	for _, attr := range rsc.identityAttributes() {
+		attributes[attr.name] = identityschema.StringAttribute{
+			RequiredForImport: attr.defaultValue == "" && !attr.omitEmptyInID,
+			OptionalForImport: attr.defaultValue != "" || attr.omitEmptyInID,
+			Description:       attr.description,
+		}
+	}
+
+	return identityschema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// defaultResourceIdentitySetFromID set all attributes of identity with elements of id.
+func defaultResourceIdentitySetFromID(
+	ctx context.Context,
+	rsc junosResource,
+	id string,
+	identity *tfsdk.ResourceIdentity,
+) (
+	diags diag.Diagnostics,
+) {
+	if identity == nil {
+		return diags
+	}
+	rscWithIdentity, ok := rsc.(junosResourceWithIdentity)
+	if !ok {
+		return diags
+	}
+
+	attributes := rscWithIdentity.identityAttributes()
+	idList := strings.SplitN(id, junos.IDSeparator, len(attributes))
+	missing := len(attributes) - len(idList)
+	i := 0
+	for _, attr := range attributes {
+		value := attr.defaultValue
+		switch {
+		case attr.omitEmptyInID && missing > 0:
+			missing--
+		case i < len(idList):
+			if idList[i] != "" {
+				value = idList[i]
+			}
+			i++
+		}
+		diags.Append(identity.SetAttribute(ctx, path.Root(attr.name), types.StringValue(value))...)
+	}
+
+	return diags
+}
+
+// defaultResourceImportStateIDFromIdentity generate `req.ID` with attributes of identity
+// when import is made with identity instead of id.
+func defaultResourceImportStateIDFromIdentity(
+	ctx context.Context,
+	rsc junosResource,
+	req *resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) (
+	ok bool,
+) {
+	if req.ID != "" || req.Identity == nil {
+		return true
+	}
+	rscWithIdentity, ok := rsc.(junosResourceWithIdentity)
+	if !ok {
+		return true
+	}
+
+	idList := make([]string, 0)
+	for _, attr := range rscWithIdentity.identityAttributes() {
+		var value types.String
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(attr.name), &value)...)
+		if resp.Diagnostics.HasError() {
+			return false
+		}
+		switch {
+		case value.ValueString() != "":
+			idList = append(idList, value.ValueString())
+		case attr.omitEmptyInID:
+			continue
+		case attr.defaultValue != "":
+			idList = append(idList, attr.defaultValue)
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attr.name),
+				"Missing Identity Attribute",
+				fmt.Sprintf("%q must be specified to import %s", attr.name, rsc.typeName()),
+			)
+
+			return false
+		}
+	}
+	req.ID = strings.Join(idList, junos.IDSeparator)
+
+	return true
+}
+
+// defaultResourceIdentitySetFromState set all attributes of identity with elements of `id` attribute in state.
+func defaultResourceIdentitySetFromState(
+	ctx context.Context,
+	rsc junosResource,
+	state tfsdk.State,
+	identity *tfsdk.ResourceIdentity,
+) (
+	diags diag.Diagnostics,
+) {
+	if identity == nil {
+		return diags
+	}
+	if _, ok := rsc.(junosResourceWithIdentity); !ok {
+		return diags
+	}
+
+	var id types.String
+	diags.Append(state.GetAttribute(ctx, path.Root("id"), &id)...)
+	if diags.HasError() || id.ValueString() == "" {
+		return diags
+	}
+
+	diags.Append(defaultResourceIdentitySetFromID(ctx, rsc, id.ValueString(), identity)...)
+
+	return diags
+}
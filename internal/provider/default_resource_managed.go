@@ -0,0 +1,313 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/configtree"
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// managedResourceHierarchies: registry of resource types with func to generate,
+// from the `id` of a resource, the hierarchy prefixes (as words without `set`)
+// of configuration owned by the resource.
+//
+//nolint:gochecknoglobals
+var managedResourceHierarchies = map[string]func(id string) [][]string{
+	providerName + "_application": func(id string) [][]string {
+		return [][]string{{"applications", "application", id}}
+	},
+	providerName + "_application_set": func(id string) [][]string {
+		return [][]string{{"applications", "application-set", id}}
+	},
+	providerName + "_applications": func(_ string) [][]string {
+		return [][]string{{"applications"}}
+	},
+	providerName + "_applications_ordered": func(_ string) [][]string {
+		return [][]string{{"applications"}}
+	},
+	providerName + "_bgp_group": func(id string) [][]string {
+		idList := strings.Split(id, junos.IDSeparator)
+		if len(idList) < 2 {
+			return nil
+		}
+
+		return [][]string{
+			managedResourceRoutingInstancePrefix(idList[1], "protocols", "bgp", "group", idList[0]),
+		}
+	},
+	providerName + "_bgp_neighbor": func(id string) [][]string {
+		idList := strings.Split(id, junos.IDSeparator)
+		if len(idList) < 3 {
+			return nil
+		}
+
+		return [][]string{
+			managedResourceRoutingInstancePrefix(idList[1], "protocols", "bgp", "group", idList[2], "neighbor", idList[0]),
+		}
+	},
+	providerName + "_firewall_filter": func(id string) [][]string {
+		idList := strings.Split(id, junos.IDSeparator)
+		if len(idList) < 2 {
+			return nil
+		}
+
+		return [][]string{{"firewall", "family", idList[1], "filter", idList[0]}}
+	},
+	providerName + "_firewall_policer": func(id string) [][]string {
+		return [][]string{{"firewall", "policer", id}}
+	},
+	providerName + "_interface_logical": func(id string) [][]string {
+		name, unit, ok := strings.Cut(id, ".")
+		if !ok {
+			return nil
+		}
+
+		return [][]string{{"interfaces", name, "unit", unit}}
+	},
+	providerName + "_interface_physical": func(id string) [][]string {
+		return [][]string{
+			{"interfaces", id},
+			// ethernet-switching options of the resource are set on unit 0
+			{"interfaces", id, "unit", "0", "family", "ethernet-switching"},
+		}
+	},
+	providerName + "_policyoptions_as_path": func(id string) [][]string {
+		return [][]string{{"policy-options", "as-path", id}}
+	},
+	providerName + "_policyoptions_as_path_group": func(id string) [][]string {
+		return [][]string{{"policy-options", "as-path-group", id}}
+	},
+	providerName + "_policyoptions_community": func(id string) [][]string {
+		return [][]string{{"policy-options", "community", id}}
+	},
+	providerName + "_policyoptions_policy_statement": func(id string) [][]string {
+		return [][]string{{"policy-options", "policy-statement", id}}
+	},
+	providerName + "_policyoptions_prefix_list": func(id string) [][]string {
+		return [][]string{{"policy-options", "prefix-list", id}}
+	},
+	providerName + "_routing_instance": func(id string) [][]string {
+		return [][]string{{"routing-instances", id}}
+	},
+	providerName + "_security_address_book": func(id string) [][]string {
+		return [][]string{{"security", "address-book", id}}
+	},
+	providerName + "_security_address_book_ordered": func(id string) [][]string {
+		return [][]string{{"security", "address-book", id}}
+	},
+	providerName + "_security_global_policy": func(_ string) [][]string {
+		return [][]string{{"security", "policies", "global"}}
+	},
+	providerName + "_security_global_policy_unordered": func(_ string) [][]string {
+		return [][]string{{"security", "policies", "global"}}
+	},
+	providerName + "_security_nat_destination": func(id string) [][]string {
+		return [][]string{{"security", "nat", "destination", "rule-set", id}}
+	},
+	providerName + "_security_nat_destination_pool": func(id string) [][]string {
+		return [][]string{{"security", "nat", "destination", "pool", id}}
+	},
+	providerName + "_security_nat_source": func(id string) [][]string {
+		return [][]string{{"security", "nat", "source", "rule-set", id}}
+	},
+	providerName + "_security_nat_source_pool": func(id string) [][]string {
+		return [][]string{{"security", "nat", "source", "pool", id}}
+	},
+	providerName + "_security_nat_static": func(id string) [][]string {
+		return [][]string{{"security", "nat", "static", "rule-set", id}}
+	},
+	providerName + "_security_nat_static_rule": func(id string) [][]string {
+		idList := strings.Split(id, junos.IDSeparator)
+		if len(idList) < 2 {
+			return nil
+		}
+
+		return [][]string{{"security", "nat", "static", "rule-set", idList[0], "rule", idList[1]}}
+	},
+	providerName + "_security_policy": func(id string) [][]string {
+		idList := strings.Split(id, junos.IDSeparator)
+		if len(idList) < 2 {
+			return nil
+		}
+
+		return [][]string{{"security", "policies", "from-zone", idList[0], "to-zone", idList[1]}}
+	},
+	providerName + "_security_policy_unordered": func(id string) [][]string {
+		idList := strings.Split(id, junos.IDSeparator)
+		if len(idList) < 2 {
+			return nil
+		}
+
+		return [][]string{{"security", "policies", "from-zone", idList[0], "to-zone", idList[1]}}
+	},
+	providerName + "_security_zone": func(id string) [][]string {
+		return [][]string{{"security", "zones", "security-zone", id}}
+	},
+	providerName + "_security_zone_book_address": func(id string) [][]string {
+		idList := strings.Split(id, junos.IDSeparator)
+		if len(idList) < 2 {
+			return nil
+		}
+
+		return [][]string{{"security", "zones", "security-zone", idList[0], "address-book", "address", idList[1]}}
+	},
+	providerName + "_security_zone_book_address_set": func(id string) [][]string {
+		idList := strings.Split(id, junos.IDSeparator)
+		if len(idList) < 2 {
+			return nil
+		}
+
+		return [][]string{{"security", "zones", "security-zone", idList[0], "address-book", "address-set", idList[1]}}
+	},
+	providerName + "_security_zone_ordered": func(id string) [][]string {
+		return [][]string{{"security", "zones", "security-zone", id}}
+	},
+	providerName + "_static_route": func(id string) [][]string {
+		idList := strings.Split(id, junos.IDSeparator)
+		if len(idList) < 2 {
+			return nil
+		}
+		if idList[1] == junos.DefaultW {
+			if strings.Contains(idList[0], ":") {
+				return [][]string{{"routing-options", "rib", "inet6.0", "static", "route", idList[0]}}
+			}
+
+			return [][]string{{"routing-options", "static", "route", idList[0]}}
+		}
+		if strings.Contains(idList[0], ":") {
+			return [][]string{{
+				"routing-instances", idList[1], "routing-options", "rib", idList[1] + ".inet6.0", "static", "route", idList[0],
+			}}
+		}
+
+		return [][]string{{"routing-instances", idList[1], "routing-options", "static", "route", idList[0]}}
+	},
+	providerName + "_vlan": func(id string) [][]string {
+		idList := strings.Split(id, junos.IDSeparator)
+		if len(idList) < 2 {
+			return nil
+		}
+
+		return [][]string{
+			managedResourceRoutingInstancePrefix(idList[1], "vlans", idList[0]),
+		}
+	},
+}
+
+// managedResourceExcludedSubHierarchies: registry of resource types with the sub-hierarchies
+// (as words following a hierarchy prefix of managedResourceHierarchies) not owned by the resource
+// because they are owned by resources of another type.
+//
+//nolint:gochecknoglobals
+var managedResourceExcludedSubHierarchies = map[string][][]string{
+	// units are owned by junos_interface_logical
+	providerName + "_interface_physical": {{"unit"}},
+}
+
+// managedHierarchy: hierarchy prefix of configuration owned by a managed resource
+// with the sub-hierarchies excluded.
+type managedHierarchy struct {
+	prefix   []string
+	excluded [][]string
+}
+
+// owns checks if the words of a configuration line are under the hierarchy prefix
+// and not under an excluded sub-hierarchy.
+func (hierarchy managedHierarchy) owns(words []string) bool {
+	if !configtree.HasPrefix(words, hierarchy.prefix) {
+		return false
+	}
+
+	return !slices.ContainsFunc(hierarchy.excluded, func(excluded []string) bool {
+		return configtree.HasPrefix(words[len(hierarchy.prefix):], excluded)
+	})
+}
+
+func managedResourceRoutingInstancePrefix(routingInstance string, words ...string) []string {
+	if routingInstance == junos.DefaultW || routingInstance == "" {
+		return words
+	}
+
+	return append([]string{"routing-instances", routingInstance}, words...)
+}
+
+// defaultResourceAddManaged records the resource in the managed resources of client
+// when its type is in the managedResourceHierarchies registry.
+func defaultResourceAddManaged(
+	ctx context.Context,
+	rsc junosResource,
+	state tfsdk.State,
+) (
+	diags diag.Diagnostics,
+) {
+	if _, ok := managedResourceHierarchies[rsc.typeName()]; !ok {
+		return diags
+	}
+	if rsc.junosClient() == nil {
+		return diags
+	}
+
+	var id types.String
+	diags.Append(state.GetAttribute(ctx, path.Root("id"), &id)...)
+	if diags.HasError() || id.ValueString() == "" {
+		return diags
+	}
+	rsc.junosClient().AddManagedResource(rsc.typeName(), id.ValueString())
+
+	return diags
+}
+
+// defaultResourceRemoveManaged removes the resource from the managed resources of client
+// after its destruction.
+func defaultResourceRemoveManaged(
+	ctx context.Context,
+	rsc junosResource,
+	state tfsdk.State,
+) (
+	diags diag.Diagnostics,
+) {
+	if _, ok := managedResourceHierarchies[rsc.typeName()]; !ok {
+		return diags
+	}
+	if rsc.junosClient() == nil {
+		return diags
+	}
+
+	var id types.String
+	diags.Append(state.GetAttribute(ctx, path.Root("id"), &id)...)
+	if diags.HasError() || id.ValueString() == "" {
+		return diags
+	}
+	rsc.junosClient().RemoveManagedResource(rsc.typeName(), id.ValueString())
+
+	return diags
+}
+
+// managedHierarchies returns the hierarchies of configuration owned by resources
+// managed with the client.
+func managedHierarchies(client *junos.Client) []managedHierarchy {
+	hierarchies := make([]managedHierarchy, 0)
+	for typeName, ids := range client.ManagedResources() {
+		hierarchiesFunc, ok := managedResourceHierarchies[typeName]
+		if !ok {
+			continue
+		}
+		for _, id := range ids {
+			for _, prefix := range hierarchiesFunc(id) {
+				hierarchies = append(hierarchies, managedHierarchy{
+					prefix:   prefix,
+					excluded: managedResourceExcludedSubHierarchies[typeName],
+				})
+			}
+		}
+	}
+
+	return hierarchies
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/configtree"
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// resourceDataUpdateWithMinimalDiff: resource data opted in to be updated
+// with only the set-line difference between state and plan
+// instead of deleting the configuration of state then setting the configuration of plan.
+type resourceDataUpdateWithMinimalDiff interface {
+	resourceDataSet
+	resourceDataDel
+	updateWithMinimalDiff()
+}
+
+// defaultResourceUpdateConfig deletes the configuration of state and sets the configuration of plan
+// or, when state and plan are opted in resourceDataUpdateWithMinimalDiff,
+// sends only the delete and set lines needed to update the configuration.
+//
+// Return false if an error was added to the response.
+func defaultResourceUpdateConfig(
+	ctx context.Context,
+	junSess *junos.Session,
+	state resourceDataDel,
+	plan resourceDataSet,
+	resp *resource.UpdateResponse,
+) bool {
+	if configSet, ok := resourceMinimalDiffConfigSet(ctx, junSess, state, plan); ok {
+		if len(configSet) == 0 {
+			return true
+		}
+		if err := junSess.ConfigSet(ctx, configSet); err != nil {
+			resp.Diagnostics.AddError(tfdiag.ConfigSetErrSummary, err.Error())
+
+			return false
+		}
+
+		return true
+	}
+
+	if stateOpts, ok := state.(resourceDataDelWithOpts); ok {
+		if err := stateOpts.delOpts(ctx, junSess); err != nil {
+			resp.Diagnostics.AddError(tfdiag.ConfigDelErrSummary, err.Error())
+
+			return false
+		}
+	} else {
+		if err := state.del(ctx, junSess); err != nil {
+			resp.Diagnostics.AddError(tfdiag.ConfigDelErrSummary, err.Error())
+
+			return false
+		}
+	}
+	if errPath, err := plan.set(ctx, junSess); err != nil {
+		if !errPath.Equal(path.Empty()) {
+			resp.Diagnostics.AddAttributeError(errPath, tfdiag.ConfigSetErrSummary, err.Error())
+		} else {
+			resp.Diagnostics.AddError(tfdiag.ConfigSetErrSummary, err.Error())
+		}
+
+		return false
+	}
+
+	return true
+}
+
+// resourceMinimalDiffConfigSet renders the lines of state and plan without applying them
+// and returns the lines to update the configuration.
+//
+// Return false if state and plan are not opted in resourceDataUpdateWithMinimalDiff
+// or if the difference can't be safely computed (the caller needs to delete then set).
+func resourceMinimalDiffConfigSet(
+	ctx context.Context,
+	junSess *junos.Session,
+	state resourceDataDel,
+	plan resourceDataSet,
+) ([]string, bool) {
+	stateDiff, ok := state.(resourceDataUpdateWithMinimalDiff)
+	if !ok {
+		return nil, false
+	}
+	if _, ok := plan.(resourceDataUpdateWithMinimalDiff); !ok {
+		return nil, false
+	}
+	if _, ok := state.(resourceDataDelWithOpts); ok {
+		return nil, false
+	}
+
+	recorder, recorded := junSess.ConfigSetRecorder()
+	if err := stateDiff.del(ctx, recorder); err != nil {
+		return nil, false
+	}
+	delLines := recorded()
+	recorder, recorded = junSess.ConfigSetRecorder()
+	if _, err := stateDiff.set(ctx, recorder); err != nil {
+		return nil, false
+	}
+	stateLines := recorded()
+	recorder, recorded = junSess.ConfigSetRecorder()
+	if _, err := plan.set(ctx, recorder); err != nil {
+		return nil, false
+	}
+	planLines := recorded()
+
+	owned := make([][]string, 0, len(delLines))
+	for _, line := range delLines {
+		for v := range strings.SplitSeq(line, "\n") {
+			words, ok := strings.CutPrefix(strings.TrimSpace(v), junos.DeleteLS)
+			if !ok {
+				return nil, false
+			}
+			owned = append(owned, configtree.SplitWords(words))
+		}
+	}
+
+	return configtree.DiffLines(stateLines, planLines, owned)
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type resourceDataNullID interface {
@@ -70,9 +72,13 @@ type resourceDataSet interface {
 	set(context.Context, *junos.Session) (path.Path, error)
 }
 
+type resourceDataFillID interface {
+	fillID()
+}
+
 type resourceDataFirstSet interface {
 	resourceDataSet
-	fillID()
+	resourceDataFillID
 }
 
 type resourceDataReadPrivateToState interface {
@@ -130,6 +136,9 @@ func defaultResourceCreate(
 		}
 
 		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		defaultResourceWriteFakeSetFile(ctx, rsc, junSess, resp.State, "create", &resp.Diagnostics)
+		resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
+		resp.Diagnostics.Append(defaultResourceAddManaged(ctx, rsc, resp.State)...)
 
 		return
 	}
@@ -147,9 +156,12 @@ func defaultResourceCreate(
 		return
 	}
 	defer func() {
-		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock())...)
+		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock(ctx))...)
 	}()
 
+	if !defaultResourceCheckCompatibility(plan, junSess, &resp.Diagnostics) {
+		return
+	}
 	if preCheck != nil && !preCheck(ctx, junSess) {
 		return
 	}
@@ -183,6 +195,8 @@ func defaultResourceCreate(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
+	resp.Diagnostics.Append(defaultResourceAddManaged(ctx, rsc, resp.State)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -210,7 +224,39 @@ func defaultResourceRead(
 	}
 	defer junSess.Close()
 
-	junos.MutexLock()
+	junSess.ReadLock()
+	err = defaultResourceDataRead(ctx, mainAttrValues, data, junSess)
+	junSess.ReadUnlock()
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.ConfigReadErrSummary, err.Error())
+
+		return
+	}
+
+	if data.nullID() {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if beforeSetState != nil {
+		beforeSetState()
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
+	resp.Diagnostics.Append(defaultResourceAddManaged(ctx, rsc, resp.State)...)
+}
+
+// defaultResourceDataRead calls the read function of data with mainAttrValues as arguments.
+func defaultResourceDataRead(
+	ctx context.Context,
+	mainAttrValues []any,
+	data resourceDataNullID,
+	junSess *junos.Session,
+) (
+	err error,
+) {
 	if data0, ok := data.(resourceDataReadWithoutArg); ok {
 		err = data0.read(ctx, junSess)
 	}
@@ -275,24 +321,8 @@ func defaultResourceRead(
 			junSess,
 		)
 	}
-	junos.MutexUnlock()
-	if err != nil {
-		resp.Diagnostics.AddError(tfdiag.ConfigReadErrSummary, err.Error())
 
-		return
-	}
-
-	if data.nullID() {
-		resp.State.RemoveResource(ctx)
-
-		return
-	}
-
-	if beforeSetState != nil {
-		beforeSetState()
-	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	return err
 }
 
 func defaultResourceUpdate(
@@ -305,26 +335,7 @@ func defaultResourceUpdate(
 	if rsc.junosClient().FakeUpdateAlso() {
 		junSess := rsc.junosClient().NewSessionWithoutNetconf(ctx)
 
-		if stateOpts, ok := state.(resourceDataDelWithOpts); ok {
-			if err := stateOpts.delOpts(ctx, junSess); err != nil {
-				resp.Diagnostics.AddError(tfdiag.ConfigDelErrSummary, err.Error())
-
-				return
-			}
-		} else {
-			if err := state.del(ctx, junSess); err != nil {
-				resp.Diagnostics.AddError(tfdiag.ConfigDelErrSummary, err.Error())
-
-				return
-			}
-		}
-		if errPath, err := plan.set(ctx, junSess); err != nil {
-			if !errPath.Equal(path.Empty()) {
-				resp.Diagnostics.AddAttributeError(errPath, tfdiag.ConfigSetErrSummary, err.Error())
-			} else {
-				resp.Diagnostics.AddError(tfdiag.ConfigSetErrSummary, err.Error())
-			}
-
+		if !defaultResourceUpdateConfig(ctx, junSess, state, plan, resp) {
 			return
 		}
 
@@ -335,6 +346,9 @@ func defaultResourceUpdate(
 		}
 
 		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		defaultResourceWriteFakeSetFile(ctx, rsc, junSess, resp.State, "update", &resp.Diagnostics)
+		resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
+		resp.Diagnostics.Append(defaultResourceAddManaged(ctx, rsc, resp.State)...)
 
 		return
 	}
@@ -352,29 +366,13 @@ func defaultResourceUpdate(
 		return
 	}
 	defer func() {
-		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock())...)
+		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock(ctx))...)
 	}()
 
-	if stateOpts, ok := state.(resourceDataDelWithOpts); ok {
-		if err := stateOpts.delOpts(ctx, junSess); err != nil {
-			resp.Diagnostics.AddError(tfdiag.ConfigDelErrSummary, err.Error())
-
-			return
-		}
-	} else {
-		if err := state.del(ctx, junSess); err != nil {
-			resp.Diagnostics.AddError(tfdiag.ConfigDelErrSummary, err.Error())
-
-			return
-		}
+	if !defaultResourceCheckCompatibility(plan, junSess, &resp.Diagnostics) {
+		return
 	}
-	if errPath, err := plan.set(ctx, junSess); err != nil {
-		if !errPath.Equal(path.Empty()) {
-			resp.Diagnostics.AddAttributeError(errPath, tfdiag.ConfigSetErrSummary, err.Error())
-		} else {
-			resp.Diagnostics.AddError(tfdiag.ConfigSetErrSummary, err.Error())
-		}
-
+	if !defaultResourceUpdateConfig(ctx, junSess, state, plan, resp) {
 		return
 	}
 	warns, err := junSess.CommitConf(ctx, "update resource "+rsc.typeName())
@@ -392,6 +390,8 @@ func defaultResourceUpdate(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
+	resp.Diagnostics.Append(defaultResourceAddManaged(ctx, rsc, resp.State)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -418,6 +418,9 @@ func defaultResourceDelete(
 			return
 		}
 
+		defaultResourceWriteFakeSetFile(ctx, rsc, junSess, resp.State, "delete", &resp.Diagnostics)
+		resp.Diagnostics.Append(defaultResourceRemoveManaged(ctx, rsc, resp.State)...)
+
 		return
 	}
 
@@ -434,7 +437,7 @@ func defaultResourceDelete(
 		return
 	}
 	defer func() {
-		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock())...)
+		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock(ctx))...)
 	}()
 
 	if err := state.del(ctx, junSess); err != nil {
@@ -449,6 +452,7 @@ func defaultResourceDelete(
 
 		return
 	}
+	resp.Diagnostics.Append(defaultResourceRemoveManaged(ctx, rsc, resp.State)...)
 }
 
 func defaultResourceImportState(
@@ -551,4 +555,25 @@ func defaultResourceImportState(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
+	resp.Diagnostics.Append(defaultResourceAddManaged(ctx, rsc, resp.State)...)
+}
+
+// defaultResourceWriteFakeSetFile writes the set/delete lines generated with the session without netconf
+// in the fake set file, in a section for the resource (with the id in state) and the operation.
+func defaultResourceWriteFakeSetFile(
+	ctx context.Context,
+	rsc junosResource,
+	junSess *junos.Session,
+	state tfsdk.State,
+	operation string,
+	diags *diag.Diagnostics,
+) {
+	var id types.String
+	if d := state.GetAttribute(ctx, path.Root("id"), &id); d.HasError() {
+		id = types.StringValue("")
+	}
+	if err := junSess.WriteFakeSetFile(rsc.typeName()+"."+id.ValueString(), operation); err != nil {
+		diags.AddError(tfdiag.ConfigSetErrSummary, err.Error())
+	}
 }
@@ -0,0 +1,370 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/configtree"
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Kinds of configuration referenced by resources.
+const (
+	referenceAddress         = "address"
+	referenceApplication     = "application"
+	referenceInterface       = "interface"
+	referencePolicyStatement = "policy-statement"
+	referenceRoutingInstance = "routing-instance"
+	referenceSecurityZone    = "security-zone"
+)
+
+// configReference: name of configuration referenced by an attribute of resource.
+type configReference struct {
+	path path.Path
+	kind string
+	name string
+	// zone: security zone of address (to look up in the address book of zone).
+	zone string
+}
+
+// resourceConfigReferences: resource config with attributes referencing
+// other configuration that need to exist on device when the configuration is committed.
+type resourceConfigReferences interface {
+	references(ctx context.Context) []configReference
+}
+
+// newConfigReferences generates a reference for each known value of a string, list or set attribute
+// with the path of the element for the list and set attributes.
+func newConfigReferences(
+	attrPath path.Path, kind string, value attr.Value,
+) []configReference {
+	references := make([]configReference, 0)
+	addReference := func(elemPath path.Path, elem attr.Value) {
+		v, ok := elem.(types.String)
+		if !ok || v.IsUnknown() || v.IsNull() {
+			return
+		}
+		references = append(references, configReference{
+			path: elemPath,
+			kind: kind,
+			name: v.ValueString(),
+		})
+	}
+	switch v := value.(type) {
+	case types.List:
+		for i, elem := range v.Elements() {
+			addReference(attrPath.AtListIndex(i), elem)
+		}
+	case types.Set:
+		for _, elem := range v.Elements() {
+			addReference(attrPath.AtSetValue(elem), elem)
+		}
+	default:
+		addReference(attrPath, value)
+	}
+
+	return references
+}
+
+// defaultResourceModifyPlanAddPlanned records, when the check of references is enabled,
+// the resource with its `id` (generated by fillID() of plan) in the planned resources of client
+// to let the resources referencing it, and planned after it, know it will be created.
+func defaultResourceModifyPlanAddPlanned(
+	ctx context.Context,
+	rsc junosResource,
+	plan resourceDataFillID,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	if rsc.junosClient() == nil || rsc.junosClient().PlanCheckReferences() == "" {
+		return
+	}
+
+	// the attributes used to generate `id` need to be known
+	idAttributes := []string{"name"}
+	if rscWithIdentity, ok := rsc.(junosResourceWithIdentity); ok {
+		idAttributes = make([]string, 0)
+		for _, attr := range rscWithIdentity.identityAttributes() {
+			idAttributes = append(idAttributes, attr.name)
+		}
+	}
+	for _, name := range idAttributes {
+		var value types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(name), &value)...)
+		if resp.Diagnostics.HasError() || value.IsUnknown() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.fillID()
+	// read `id` through a state with the schema of plan, as the data of each resource has its own type
+	idState := tfsdk.State{Schema: req.Plan.Schema}
+	resp.Diagnostics.Append(idState.Set(ctx, plan)...)
+	var id types.String
+	resp.Diagnostics.Append(idState.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() || id.ValueString() == "" {
+		return
+	}
+	rsc.junosClient().AddPlannedResource(rsc.typeName(), id.ValueString())
+}
+
+// defaultResourceModifyPlanCheckReferences checks, when enabled on provider,
+// that configuration referenced by config exists on device or is planned by another resource
+// and adds an attribute warning or error (depending on provider setting) for each one not found.
+//
+// Configuration planned by another resource is only known when the resource is planned before,
+// so when the attribute references it (like `junos_policyoptions_policy_statement.name.name`).
+func defaultResourceModifyPlanCheckReferences(
+	ctx context.Context,
+	rsc junosResource,
+	config resourceConfigReferences,
+	diags *diag.Diagnostics,
+) {
+	if rsc.junosClient() == nil || rsc.junosClient().PlanCheckReferences() == "" {
+		return
+	}
+	if rsc.junosClient().FakeCreateSetFile() {
+		return
+	}
+	references := config.references(ctx)
+	if len(references) == 0 {
+		return
+	}
+
+	// results of references planned by another resource or already looked up on device
+	// (with the client, for a previous resource in plan)
+	// to open a session only when a lookup on device is necessary
+	planned := plannedHierarchies(rsc.junosClient())
+	found := make(map[string]bool)
+	toLookUp := make([]configReference, 0)
+	for _, reference := range references {
+		if referenceSkipped(reference) {
+			continue
+		}
+		key := referenceKey(reference)
+		if _, ok := found[key]; ok {
+			continue
+		}
+		if referencePlanned(reference, planned) {
+			found[key] = true
+
+			continue
+		}
+		if exists, ok := rsc.junosClient().ReferenceLookup(key); ok {
+			found[key] = exists
+
+			continue
+		}
+		if !slices.ContainsFunc(toLookUp, func(v configReference) bool { return referenceKey(v) == key }) {
+			toLookUp = append(toLookUp, reference)
+		}
+	}
+	if len(toLookUp) > 0 {
+		junSess, err := rsc.junosClient().StartNewSession(ctx)
+		if err != nil {
+			diags.AddWarning(tfdiag.StartSessErrSummary,
+				"unable to check references on device: "+err.Error())
+
+			return
+		}
+		defer junSess.Close()
+
+		junSess.ReadLock()
+		for _, reference := range toLookUp {
+			exists, err := referenceExists(ctx, reference, junSess)
+			if err != nil {
+				diags.AddAttributeWarning(reference.path, tfdiag.ConfigReadErrSummary,
+					fmt.Sprintf("unable to check %s %q on device: %s", reference.kind, reference.name, err))
+
+				continue
+			}
+			found[referenceKey(reference)] = exists
+			rsc.junosClient().AddReferenceLookup(referenceKey(reference), exists)
+		}
+		junSess.ReadUnlock()
+	}
+
+	for _, reference := range references {
+		if referenceSkipped(reference) {
+			continue
+		}
+		exists, ok := found[referenceKey(reference)]
+		if !ok || exists {
+			// not found only when the lookup on device has failed (with already a warning)
+			continue
+		}
+
+		message := fmt.Sprintf("%s %q not found on device and not planned by another resource", reference.kind, reference.name)
+		if reference.zone != "" {
+			message = fmt.Sprintf("%s %q not found in address books for zone %q on device "+
+				"and not planned by another resource", reference.kind, reference.name, reference.zone)
+		}
+		if rsc.junosClient().PlanCheckReferences() == junos.PlanCheckReferencesError {
+			diags.AddAttributeError(reference.path, tfdiag.MissingConfigErrSummary, message)
+		} else {
+			diags.AddAttributeWarning(reference.path, tfdiag.MissingConfigWarnSummary, message)
+		}
+	}
+}
+
+// referenceKey returns the key to identify the referenced configuration.
+func referenceKey(reference configReference) string {
+	return reference.kind + " " + reference.zone + " " + reference.name
+}
+
+// plannedHierarchies returns the hierarchy prefixes of configuration planned by resources
+// with the client.
+func plannedHierarchies(client *junos.Client) [][]string {
+	hierarchies := make([][]string, 0)
+	for typeName, ids := range client.PlannedResources() {
+		hierarchiesFunc, ok := managedResourceHierarchies[typeName]
+		if !ok {
+			continue
+		}
+		for _, id := range ids {
+			hierarchies = append(hierarchies, hierarchiesFunc(id)...)
+		}
+	}
+
+	return hierarchies
+}
+
+// referenceSkipped checks if the reference is a predefined name or an expression
+// that can't be checked in configuration.
+func referenceSkipped(reference configReference) bool {
+	switch reference.kind {
+	case referenceAddress:
+		return reference.name == "any" || reference.name == "any-ipv4" || reference.name == "any-ipv6"
+	case referenceApplication:
+		return reference.name == "any" || strings.HasPrefix(reference.name, "junos-")
+	case referencePolicyStatement:
+		return strings.ContainsAny(reference.name, " ()&|!")
+	case referenceRoutingInstance:
+		return reference.name == junos.DefaultW
+	case referenceSecurityZone:
+		return reference.name == "junos-host"
+	}
+
+	return false
+}
+
+// referenceHierarchies returns the hierarchy prefixes where the referenced configuration can be.
+func referenceHierarchies(reference configReference) [][]string {
+	switch reference.kind {
+	case referenceAddress:
+		return [][]string{
+			{"security", "address-book"},
+			{"security", "zones", "security-zone", reference.zone},
+		}
+	case referenceApplication:
+		return [][]string{
+			{"applications", "application", reference.name},
+			{"applications", "application-set", reference.name},
+		}
+	case referenceInterface:
+		return [][]string{{"interfaces", reference.name}}
+	case referencePolicyStatement:
+		return [][]string{{"policy-options", "policy-statement", reference.name}}
+	case referenceRoutingInstance:
+		return [][]string{{"routing-instances", reference.name}}
+	case referenceSecurityZone:
+		return [][]string{{"security", "zones", "security-zone", reference.name}}
+	}
+
+	return nil
+}
+
+// referencePlanned checks if a planned hierarchy may contain the referenced configuration.
+//
+// The content of planned address books isn't known so an address is considered planned
+// if an address book (or the zone of address) is planned.
+func referencePlanned(reference configReference, planned [][]string) bool {
+	for _, hierarchy := range referenceHierarchies(reference) {
+		for _, plannedHierarchy := range planned {
+			if configtree.HasPrefix(hierarchy, plannedHierarchy) ||
+				configtree.HasPrefix(plannedHierarchy, hierarchy) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// referenceExists checks if the referenced configuration exists on device.
+func referenceExists(
+	ctx context.Context, reference configReference, junSess *junos.Session,
+) (
+	bool, error,
+) {
+	switch reference.kind {
+	case referenceAddress:
+		return checkSecurityAddressBooksAddressExists(ctx, reference.zone, reference.name, junSess)
+	case referenceApplication:
+		if exists, err := checkApplicationExists(ctx, reference.name, junSess); err != nil || exists {
+			return exists, err
+		}
+
+		return checkApplicationSetExists(ctx, reference.name, junSess)
+	case referenceInterface:
+		return junSess.CheckInterfaceExists(ctx, reference.name)
+	case referencePolicyStatement:
+		return checkPolicyoptionsPolicyStatementExists(ctx, reference.name, junSess)
+	case referenceRoutingInstance:
+		return checkRoutingInstanceExists(ctx, reference.name, junSess)
+	case referenceSecurityZone:
+		return checkSecurityZonesExists(ctx, reference.name, junSess)
+	}
+
+	return true, nil
+}
+
+// checkSecurityAddressBooksAddressExists checks if an address or an address-set exists
+// in the address book of zone or in one of the address books of security.
+func checkSecurityAddressBooksAddressExists(
+	ctx context.Context, zone, name string, junSess *junos.Session,
+) (
+	bool, error,
+) {
+	if zone != "" {
+		if exists, err := checkSecurityZoneBookAddressExists(ctx, zone, name, junSess); err != nil || exists {
+			return exists, err
+		}
+		if exists, err := checkSecurityZoneBookAddressSetExists(ctx, zone, name, junSess); err != nil || exists {
+			return exists, err
+		}
+	}
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security address-book"+junos.PipeDisplaySetRelative)
+	if err != nil {
+		return false, err
+	}
+	books, err := configtree.ParseOutput(showConfig)
+	if err != nil {
+		return false, err
+	}
+	for _, book := range books.Children() {
+		if books.Has(book, "address", name) || books.Has(book, "address-set", name) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/configtree"
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &firewallFilter{}
+	_ list.ListResourceWithConfigure = &firewallFilter{}
+)
+
+func newFirewallFilterListResource() list.ListResource {
+	return &firewallFilter{}
+}
+
+func (rsc *firewallFilter) ListResourceConfigSchema(
+	_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: defaultListResourceSchemaDescription(rsc),
+	}
+}
+
+func (rsc *firewallFilter) List(
+	ctx context.Context, req list.ListRequest, stream *list.ListResultsStream,
+) {
+	defaultListResourceList(
+		ctx,
+		rsc,
+		func(_ context.Context, junSess *junos.Session) ([]string, error) {
+			showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+				"firewall"+junos.PipeDisplaySetRelative)
+			if err != nil {
+				return nil, err
+			}
+
+			tree, err := configtree.ParseOutput(showConfig)
+			if err != nil {
+				return nil, err
+			}
+
+			ids := make([]string, 0)
+			for _, family := range tree.Children("family") {
+				for _, name := range tree.Children("family", family, "filter") {
+					ids = append(ids, name+junos.IDSeparator+family)
+				}
+			}
+
+			return ids, nil
+		},
+		func() resourceDataNullID {
+			return &firewallFilterData{}
+		},
+		req,
+		stream,
+	)
+}
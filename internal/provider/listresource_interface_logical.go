@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/configtree"
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &interfaceLogical{}
+	_ list.ListResourceWithConfigure = &interfaceLogical{}
+)
+
+func newInterfaceLogicalListResource() list.ListResource {
+	return &interfaceLogical{}
+}
+
+func (rsc *interfaceLogical) ListResourceConfigSchema(
+	_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: defaultListResourceSchemaDescription(rsc),
+	}
+}
+
+func (rsc *interfaceLogical) List(
+	ctx context.Context, req list.ListRequest, stream *list.ListResultsStream,
+) {
+	defaultListResourceList(
+		ctx,
+		rsc,
+		func(fnCtx context.Context, junSess *junos.Session) ([]string, error) {
+			showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+				"interfaces"+junos.PipeDisplaySetRelative)
+			if err != nil {
+				return nil, err
+			}
+
+			tree, err := configtree.ParseOutput(showConfig)
+			if err != nil {
+				return nil, err
+			}
+
+			ids := make([]string, 0)
+			for _, interfaceName := range tree.Children() {
+				if interfaceName == "interface-range" {
+					continue
+				}
+				for _, unit := range tree.Children(interfaceName, "unit") {
+					name := interfaceName + "." + unit
+					// interface disabled by the provider is not a resource
+					ncInt, _, _, err := checkInterfaceLogicalNCEmpty(
+						fnCtx,
+						name,
+						rsc.client.GroupInterfaceDelete(),
+						junSess,
+					)
+					if err != nil {
+						return nil, err
+					}
+					if ncInt {
+						continue
+					}
+					ids = append(ids, name)
+				}
+			}
+
+			return ids, nil
+		},
+		func() resourceDataNullID {
+			return &interfaceLogicalData{}
+		},
+		req,
+		stream,
+	)
+}
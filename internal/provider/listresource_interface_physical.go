@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/configtree"
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &interfacePhysical{}
+	_ list.ListResourceWithConfigure = &interfacePhysical{}
+)
+
+func newInterfacePhysicalListResource() list.ListResource {
+	return &interfacePhysical{}
+}
+
+func (rsc *interfacePhysical) ListResourceConfigSchema(
+	_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: defaultListResourceSchemaDescription(rsc),
+	}
+}
+
+func (rsc *interfacePhysical) List(
+	ctx context.Context, req list.ListRequest, stream *list.ListResultsStream,
+) {
+	defaultListResourceList(
+		ctx,
+		rsc,
+		func(fnCtx context.Context, junSess *junos.Session) ([]string, error) {
+			showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+				"interfaces"+junos.PipeDisplaySetRelative)
+			if err != nil {
+				return nil, err
+			}
+
+			ids := make([]string, 0)
+			alreadyChecked := make(map[string]struct{})
+			tree, err := configtree.ParseOutput(showConfig)
+			if err != nil {
+				return nil, err
+			}
+			for _, name := range tree.Children() {
+				switch name {
+				case "apply-groups",
+					"apply-groups-except",
+					"interface-range",
+					"interface-set",
+					"stacked-interface-set",
+					"traceoptions":
+					continue
+				}
+				if _, ok := alreadyChecked[name]; ok {
+					continue
+				}
+				alreadyChecked[name] = struct{}{}
+				// interface disabled by the provider is not a resource
+				ncInt, _, err := checkInterfacePhysicalNCEmpty(
+					fnCtx,
+					name,
+					rsc.client.GroupInterfaceDelete(),
+					junSess,
+				)
+				if err != nil {
+					return nil, err
+				}
+				if ncInt {
+					continue
+				}
+				ids = append(ids, name)
+			}
+
+			return ids, nil
+		},
+		func() resourceDataNullID {
+			return &interfacePhysicalData{}
+		},
+		req,
+		stream,
+	)
+}
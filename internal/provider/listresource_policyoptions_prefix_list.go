@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/configtree"
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &policyoptionsPrefixList{}
+	_ list.ListResourceWithConfigure = &policyoptionsPrefixList{}
+)
+
+func newPolicyoptionsPrefixListListResource() list.ListResource {
+	return &policyoptionsPrefixList{}
+}
+
+func (rsc *policyoptionsPrefixList) ListResourceConfigSchema(
+	_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: defaultListResourceSchemaDescription(rsc),
+	}
+}
+
+func (rsc *policyoptionsPrefixList) List(
+	ctx context.Context, req list.ListRequest, stream *list.ListResultsStream,
+) {
+	defaultListResourceList(
+		ctx,
+		rsc,
+		func(_ context.Context, junSess *junos.Session) ([]string, error) {
+			showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+				"policy-options prefix-list"+junos.PipeDisplaySetRelative)
+			if err != nil {
+				return nil, err
+			}
+
+			ids := make([]string, 0)
+			tree, err := configtree.ParseOutput(showConfig)
+			if err != nil {
+				return nil, err
+			}
+			for _, name := range tree.Children() {
+				ids = append(ids, name)
+			}
+
+			return ids, nil
+		},
+		func() resourceDataNullID {
+			return &policyoptionsPrefixListData{}
+		},
+		req,
+		stream,
+	)
+}
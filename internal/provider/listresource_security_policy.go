@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/configtree"
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &securityPolicy{}
+	_ list.ListResourceWithConfigure = &securityPolicy{}
+)
+
+func newSecurityPolicyListResource() list.ListResource {
+	return &securityPolicy{}
+}
+
+func (rsc *securityPolicy) ListResourceConfigSchema(
+	_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: defaultListResourceSchemaDescription(rsc),
+	}
+}
+
+func (rsc *securityPolicy) List(
+	ctx context.Context, req list.ListRequest, stream *list.ListResultsStream,
+) {
+	defaultListResourceList(
+		ctx,
+		rsc,
+		func(_ context.Context, junSess *junos.Session) ([]string, error) {
+			showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+				"security policies"+junos.PipeDisplaySetRelative)
+			if err != nil {
+				return nil, err
+			}
+
+			tree, err := configtree.ParseOutput(showConfig)
+			if err != nil {
+				return nil, err
+			}
+
+			ids := make([]string, 0)
+			for _, fromZone := range tree.Children("from-zone") {
+				for _, toZone := range tree.Children("from-zone", fromZone, "to-zone") {
+					ids = append(ids, fromZone+junos.IDSeparator+toZone)
+				}
+			}
+
+			return ids, nil
+		},
+		func() resourceDataNullID {
+			return &securityPolicyData{}
+		},
+		req,
+		stream,
+	)
+}
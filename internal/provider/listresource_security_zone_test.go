@@ -0,0 +1,44 @@
+package provider_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccListResourceSecurityZone_basic(t *testing.T) {
+	if os.Getenv("TESTACC_SRX") != "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck: func() { testAccPreCheck(t) },
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigDirectory: config.TestStepDirectory(),
+				},
+				{
+					Query: true,
+					Config: `
+provider "junos" {}
+
+list "junos_security_zone" "testacc" {
+  provider = junos
+}
+`,
+					QueryResultChecks: []querycheck.QueryResultCheck{
+						querycheck.ExpectIdentity("junos_security_zone.testacc", map[string]knownvalue.Check{
+							"name": knownvalue.StringExact("testacc_list_zone"),
+						}),
+					},
+				},
+			},
+		})
+	}
+}
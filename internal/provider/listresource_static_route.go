@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/configtree"
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &staticRoute{}
+	_ list.ListResourceWithConfigure = &staticRoute{}
+)
+
+func newStaticRouteListResource() list.ListResource {
+	return &staticRoute{}
+}
+
+func (rsc *staticRoute) ListResourceConfigSchema(
+	_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: defaultListResourceSchemaDescription(rsc),
+		Attributes:  listResourceConfigRoutingInstance{}.attributesSchema(),
+	}
+}
+
+func (rsc *staticRoute) List(
+	ctx context.Context, req list.ListRequest, stream *list.ListResultsStream,
+) {
+	var config listResourceConfigRoutingInstance
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+	routingInstance := config.routingInstance()
+
+	defaultListResourceList(
+		ctx,
+		rsc,
+		func(_ context.Context, junSess *junos.Session) ([]string, error) {
+			showPrefix := junos.CmdShowConfig
+			showPrefixInet6 := junos.CmdShowConfig
+			if routingInstance == junos.DefaultW {
+				showPrefix += junos.RoutingOptionsWS
+				showPrefixInet6 += junos.RoutingOptionsWS + junos.RibInet60WS
+			} else {
+				showPrefix += junos.RoutingInstancesWS + routingInstance + " " + junos.RoutingOptionsWS
+				showPrefixInet6 += junos.RoutingInstancesWS + routingInstance + " " + junos.RoutingOptionsWS +
+					"rib " + routingInstance + ".inet6.0 "
+			}
+
+			ids := make([]string, 0)
+			for _, prefix := range []string{showPrefix, showPrefixInet6} {
+				showConfig, err := junSess.Command(ctx, prefix+
+					"static route"+junos.PipeDisplaySetRelative)
+				if err != nil {
+					return nil, err
+				}
+				tree, err := configtree.ParseOutput(showConfig)
+				if err != nil {
+					return nil, err
+				}
+				for _, name := range tree.Children() {
+					ids = append(ids, name+junos.IDSeparator+routingInstance)
+				}
+			}
+
+			return ids, nil
+		},
+		func() resourceDataNullID {
+			return &staticRouteData{}
+		},
+		req,
+		stream,
+	)
+}
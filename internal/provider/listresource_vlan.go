@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/configtree"
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &vlan{}
+	_ list.ListResourceWithConfigure = &vlan{}
+)
+
+func newVlanListResource() list.ListResource {
+	return &vlan{}
+}
+
+func (rsc *vlan) ListResourceConfigSchema(
+	_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: defaultListResourceSchemaDescription(rsc),
+		Attributes:  listResourceConfigRoutingInstance{}.attributesSchema(),
+	}
+}
+
+func (rsc *vlan) List(
+	ctx context.Context, req list.ListRequest, stream *list.ListResultsStream,
+) {
+	var config listResourceConfigRoutingInstance
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+	routingInstance := config.routingInstance()
+
+	defaultListResourceList(
+		ctx,
+		rsc,
+		func(_ context.Context, junSess *junos.Session) ([]string, error) {
+			showConfigPrefix := junos.CmdShowConfig
+			if routingInstance != junos.DefaultW {
+				showConfigPrefix += junos.RoutingInstancesWS + routingInstance + " "
+			}
+			showConfig, err := junSess.Command(ctx, showConfigPrefix+
+				"vlans"+junos.PipeDisplaySetRelative)
+			if err != nil {
+				return nil, err
+			}
+
+			ids := make([]string, 0)
+			tree, err := configtree.ParseOutput(showConfig)
+			if err != nil {
+				return nil, err
+			}
+			for _, name := range tree.Children() {
+				ids = append(ids, name+junos.IDSeparator+routingInstance)
+			}
+
+			return ids, nil
+		},
+		func() resourceDataNullID {
+			return &vlanData{}
+		},
+		req,
+		stream,
+	)
+}
@@ -0,0 +1,58 @@
+package provider_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccListResourceVlan_basic(t *testing.T) {
+	if os.Getenv("TESTACC_SWITCH") != "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck: func() { testAccPreCheck(t) },
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_14_0),
+			},
+			ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigDirectory: config.TestStepDirectory(),
+				},
+				{
+					Query: true,
+					Config: `
+provider "junos" {}
+
+list "junos_vlan" "testacc" {
+  provider = junos
+}
+
+list "junos_vlan" "testacc_ri" {
+  provider = junos
+
+  config {
+    routing_instance = "testacc_list_vlan"
+  }
+}
+`,
+					QueryResultChecks: []querycheck.QueryResultCheck{
+						querycheck.ExpectIdentity("junos_vlan.testacc", map[string]knownvalue.Check{
+							"name":             knownvalue.StringExact("testacc_list_vlan"),
+							"routing_instance": knownvalue.StringExact("default"),
+						}),
+						querycheck.ExpectLength("junos_vlan.testacc_ri", 1),
+						querycheck.ExpectIdentity("junos_vlan.testacc_ri", map[string]knownvalue.Check{
+							"name":             knownvalue.StringExact("testacc_list_vlan_ri"),
+							"routing_instance": knownvalue.StringExact("testacc_list_vlan"),
+						}),
+					},
+				},
+			},
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
 	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
@@ -12,9 +13,11 @@ import (
 	"github.com/jeremmfr/terraform-provider-junos/internal/version"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -29,8 +32,9 @@ func New() provider.Provider {
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider            = &junosProvider{}
-	_ provider.ProviderWithActions = &junosProvider{}
+	_ provider.Provider                  = &junosProvider{}
+	_ provider.ProviderWithActions       = &junosProvider{}
+	_ provider.ProviderWithListResources = &junosProvider{}
 )
 
 type junosProvider struct{}
@@ -53,12 +57,23 @@ type junosProviderModel struct {
 	SSHCiphers                 types.List   `tfsdk:"ssh_ciphers"`
 	SSHTimeoutToEstab          types.Int64  `tfsdk:"ssh_timeout_to_establish"`
 	SSHRetryToEstab            types.Int64  `tfsdk:"ssh_retry_to_establish"`
+	RPCTimeout                 types.Int64  `tfsdk:"rpc_timeout"`
+	RetryMaxElapsedTime        types.Int64  `tfsdk:"retry_max_elapsed_time"`
+	RetryInitialInterval       types.Int64  `tfsdk:"retry_initial_interval"`
+	RetryMaxInterval           types.Int64  `tfsdk:"retry_max_interval"`
+	RetryErrors                types.Set    `tfsdk:"retry_errors"`
 	FilePermission             types.String `tfsdk:"file_permission"`
 	DebugNetconfLogPath        types.String `tfsdk:"debug_netconf_log_path"`
 	FakeCreateSetFile          types.String `tfsdk:"fake_create_with_setfile"`
 	FakeUpdateAlso             types.Bool   `tfsdk:"fake_update_also"`
 	FakeDeleteAlso             types.Bool   `tfsdk:"fake_delete_also"`
+	FakeSetFileHeader          types.Bool   `tfsdk:"fake_setfile_header"`
+	FakeSetFileXML             types.Bool   `tfsdk:"fake_setfile_xml"`
+	FakeSetFileText            types.Bool   `tfsdk:"fake_setfile_text"`
+	OfflineConfigFile          types.String `tfsdk:"offline_config_file"`
+	OfflineHardwareModel       types.String `tfsdk:"offline_hardware_model"`
 	UseSingleSession           types.Bool   `tfsdk:"use_single_session"`
+	PlanCheckReferences        types.String `tfsdk:"plan_check_references"`
 }
 
 const (
@@ -187,6 +202,54 @@ func (p *junosProvider) Schema(
 					int64validator.Between(1, 10),
 				},
 			},
+			"rpc_timeout": schema.Int64Attribute{
+				Optional: true,
+				Description: "Timeout in seconds to wait the reply of each RPC (command, configuration, commit, ...) " +
+					"on device, the connection is closed when reached. 0 to wait indefinitely." +
+					" May also be provided via " + junos.EnvRPCTimeout + " environment variable.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_elapsed_time": schema.Int64Attribute{
+				Optional: true,
+				Description: "Seconds after which a RPC failed with a transient error is no longer retried " +
+					"(default to 60), 0 to disable retries." +
+					" May also be provided via " + junos.EnvRetryMaxElapsedTime + " environment variable.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_initial_interval": schema.Int64Attribute{
+				Optional: true,
+				Description: "Seconds to wait before the first retry of a RPC failed with a transient error " +
+					"(default to 1), the wait is multiplied by 2 after each retry." +
+					" May also be provided via " + junos.EnvRetryInitialInterval + " environment variable.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"retry_max_interval": schema.Int64Attribute{
+				Optional: true,
+				Description: "Maximum seconds to wait between two retries of a RPC failed with a transient error " +
+					"(default to 15)." +
+					" May also be provided via " + junos.EnvRetryMaxInterval + " environment variable.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"retry_errors": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Kinds of transient errors retried (default to all kinds)." +
+					" May also be provided via " + junos.EnvRetryErrors + " environment variable" +
+					" (comma-separated list).",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.OneOf(junos.RetryKinds()...),
+					),
+				},
+			},
 			"file_permission": schema.StringAttribute{
 				Optional: true,
 				Description: "The permission to set for the created file (debug, setfile)." +
@@ -218,20 +281,76 @@ func (p *junosProvider) Schema(
 					"and respond with a `fake` successful delete of resources to Terraform." +
 					" May also be enabled via " + junos.EnvFakedeleteAlso + " environment variable.",
 			},
+			"fake_setfile_header": schema.BoolAttribute{
+				Optional: true,
+				Description: "Add a comment header with the resource and the operation " +
+					"(like `# junos_vlan.foo create`) before the lines of each resource " +
+					"in the file of `fake_create_with_setfile`." +
+					" May also be enabled via " + junos.EnvFakesetfileHeader + " environment variable.",
+			},
+			"fake_setfile_xml": schema.BoolAttribute{
+				Optional: true,
+				Description: "Also write the lines of each resource in a Junos XML `<load-configuration>` RPC " +
+					"in a second file with the path of `fake_create_with_setfile` and the `" +
+					junos.FakeSetFileXMLExtension + "` extension appended." +
+					" May also be enabled via " + junos.EnvFakesetfileXML + " environment variable.",
+			},
+			"fake_setfile_text": schema.BoolAttribute{
+				Optional: true,
+				Description: "Also write the lines of each resource in curly-brace text format " +
+					"in a file with the path of `fake_create_with_setfile` and the `" +
+					junos.FakeSetFileTextExtension + "` extension appended" +
+					" (the delete lines with the `delete:` tag)." +
+					" May also be enabled via " + junos.EnvFakesetfileText + " environment variable.",
+			},
+			"offline_config_file": schema.StringAttribute{
+				Optional: true,
+				Description: "Don't connect to a device but use the specified file " +
+					"with set lines of configuration (output of `show configuration | display set`): " +
+					"read configuration from it and apply set/delete lines to it." +
+					" May also be provided via " + junos.EnvOfflineConfigFile + " environment variable.",
+			},
+			"offline_hardware_model": schema.StringAttribute{
+				Optional: true,
+				Description: "The hardware model of device (like `vsrx`) to use when `offline_config_file` is set." +
+					" May also be provided via " + junos.EnvOfflineHardwareModel + " environment variable.",
+			},
 			"use_single_session": schema.BoolAttribute{
 				Optional: true,
 				Description: "Enable the single session connection strategy to reuse a single Netconf/SSH session " +
 					"for all provider operations. This reduces the connection overhead significantly." +
 					" May also be enabled via " + junos.EnvUseSingleSession + " environment variable.",
 			},
+			"plan_check_references": schema.StringAttribute{
+				Optional: true,
+				Description: "Check during plan that configuration referenced by resources " +
+					"(policy statements, zones, addresses, applications, ...) exists on device " +
+					"or is planned by another resource, and generate a diagnostic with this level " +
+					"(`" + junos.PlanCheckReferencesWarning + "` or `" + junos.PlanCheckReferencesError + "`)." +
+					" May also be provided via " + junos.EnvPlanCheckReferences + " environment variable.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(junos.PlanCheckReferencesWarning, junos.PlanCheckReferencesError),
+				},
+			},
 		},
 	}
 }
 
 func (p *junosProvider) Actions(_ context.Context) []func() action.Action {
 	return []func() action.Action{
+		newClearAction,
+		newCommandAction,
+		newCommitConfirmAction,
 		newCommitFileAction,
+		newFileDownloadAction,
+		newFileUploadAction,
+		newHaltAction,
 		newLoadConfigAction,
+		newPingAction,
+		newRebootAction,
+		newRescueConfigAction,
+		newSoftwareInstallAction,
+		newTracerouteAction,
 	}
 }
 
@@ -240,15 +359,39 @@ func (p *junosProvider) DataSources(_ context.Context) []func() datasource.DataS
 		newApplicationSetsDataSource,
 		newApplicationsDataSource,
 		newChassisInventoryDataSource,
+		newCommandDataSource,
+		newConfigDriftDataSource,
 		newConfigRawDataSource,
 		newInterfaceLogicalDataSource,
 		newInterfaceLogicalInfoDataSource,
 		newInterfacePhysicalDataSource,
 		newInterfacesPhysicalPresentDataSource,
+		newPingDataSource,
+		newRescueConfigDataSource,
 		newRoutesDataSource,
 		newRoutingInstanceDataSource,
 		newSecurityZoneDataSource,
 		newSystemInformationDataSource,
+		newTracerouteDataSource,
+	}
+}
+
+func (p *junosProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		newBgpGroupListResource,
+		newBgpNeighborListResource,
+		newFirewallFilterListResource,
+		newInterfaceLogicalListResource,
+		newInterfacePhysicalListResource,
+		newPolicyoptionsPrefixListListResource,
+		newSecurityAddressBookListResource,
+		newSecurityNatDestinationListResource,
+		newSecurityNatSourceListResource,
+		newSecurityNatStaticListResource,
+		newSecurityPolicyListResource,
+		newSecurityZoneListResource,
+		newStaticRouteListResource,
+		newVlanListResource,
 	}
 }
 
@@ -262,6 +405,7 @@ func (p *junosProvider) Resources(_ context.Context) []func() resource.Resource
 		newApplicationSetResource,
 		newApplyGroupResource,
 		newApplyGroupExceptResource,
+		newAuthoritativeHierarchyResource,
 		newBgpGroupResource,
 		newBgpNeighborResource,
 		newBridgeDomainResource,
@@ -555,6 +699,56 @@ func (p *junosProvider) Configure( //nolint:gocyclo
 				fmt.Sprintf(instructionUnknownMessage, junos.EnvSSHRetryToEstablish),
 		)
 	}
+	if config.RPCTimeout.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rpc_timeout"),
+			tfdiag.UnknownJunosAttrErrSummary,
+			unknownValueErrorMessage+"for 'rpc_timeout' attribute."+
+				fmt.Sprintf(instructionUnknownMessage, junos.EnvRPCTimeout),
+		)
+	}
+	if config.RetryMaxElapsedTime.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_max_elapsed_time"),
+			tfdiag.UnknownJunosAttrErrSummary,
+			unknownValueErrorMessage+"for 'retry_max_elapsed_time' attribute."+
+				fmt.Sprintf(instructionUnknownMessage, junos.EnvRetryMaxElapsedTime),
+		)
+	}
+	if config.RetryInitialInterval.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_initial_interval"),
+			tfdiag.UnknownJunosAttrErrSummary,
+			unknownValueErrorMessage+"for 'retry_initial_interval' attribute."+
+				fmt.Sprintf(instructionUnknownMessage, junos.EnvRetryInitialInterval),
+		)
+	}
+	if config.RetryMaxInterval.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_max_interval"),
+			tfdiag.UnknownJunosAttrErrSummary,
+			unknownValueErrorMessage+"for 'retry_max_interval' attribute."+
+				fmt.Sprintf(instructionUnknownMessage, junos.EnvRetryMaxInterval),
+		)
+	}
+	if config.RetryErrors.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_errors"),
+			tfdiag.UnknownJunosAttrErrSummary,
+			unknownValueErrorMessage+"for 'retry_errors' attribute."+
+				fmt.Sprintf(instructionUnknownMessage, junos.EnvRetryErrors),
+		)
+	}
+	for _, v := range config.RetryErrors.Elements() {
+		if v.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_errors"),
+				tfdiag.UnknownJunosAttrErrSummary,
+				unknownValueErrorMessage+"for 'retry_errors' attribute."+
+					fmt.Sprintf(instructionUnknownMessage, junos.EnvRetryErrors),
+			)
+		}
+	}
 	if config.FilePermission.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("file_permission"),
@@ -595,6 +789,46 @@ func (p *junosProvider) Configure( //nolint:gocyclo
 				fmt.Sprintf(instructionUnknownMessage, junos.EnvFakedeleteAlso),
 		)
 	}
+	if config.FakeSetFileHeader.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("fake_setfile_header"),
+			tfdiag.UnknownJunosAttrErrSummary,
+			unknownValueErrorMessage+"for 'fake_setfile_header' attribute."+
+				fmt.Sprintf(instructionUnknownMessage, junos.EnvFakesetfileHeader),
+		)
+	}
+	if config.FakeSetFileXML.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("fake_setfile_xml"),
+			tfdiag.UnknownJunosAttrErrSummary,
+			unknownValueErrorMessage+"for 'fake_setfile_xml' attribute."+
+				fmt.Sprintf(instructionUnknownMessage, junos.EnvFakesetfileXML),
+		)
+	}
+	if config.FakeSetFileText.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("fake_setfile_text"),
+			tfdiag.UnknownJunosAttrErrSummary,
+			unknownValueErrorMessage+"for 'fake_setfile_text' attribute."+
+				fmt.Sprintf(instructionUnknownMessage, junos.EnvFakesetfileText),
+		)
+	}
+	if config.OfflineConfigFile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("offline_config_file"),
+			tfdiag.UnknownJunosAttrErrSummary,
+			unknownValueErrorMessage+"for 'offline_config_file' attribute."+
+				fmt.Sprintf(instructionUnknownMessage, junos.EnvOfflineConfigFile),
+		)
+	}
+	if config.OfflineHardwareModel.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("offline_hardware_model"),
+			tfdiag.UnknownJunosAttrErrSummary,
+			unknownValueErrorMessage+"for 'offline_hardware_model' attribute."+
+				fmt.Sprintf(instructionUnknownMessage, junos.EnvOfflineHardwareModel),
+		)
+	}
 	if config.UseSingleSession.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("use_single_session"),
@@ -603,15 +837,28 @@ func (p *junosProvider) Configure( //nolint:gocyclo
 				fmt.Sprintf(instructionUnknownMessage, junos.EnvUseSingleSession),
 		)
 	}
+	if config.PlanCheckReferences.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("plan_check_references"),
+			tfdiag.UnknownJunosAttrErrSummary,
+			unknownValueErrorMessage+"for 'plan_check_references' attribute."+
+				fmt.Sprintf(instructionUnknownMessage, junos.EnvPlanCheckReferences),
+		)
+	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	offlineConfigFile := os.Getenv(junos.EnvOfflineConfigFile)
+	if !config.OfflineConfigFile.IsNull() {
+		offlineConfigFile = config.OfflineConfigFile.ValueString()
+	}
+
 	hostIP := os.Getenv(junos.EnvHost)
 	if !config.IP.IsNull() {
 		hostIP = config.IP.ValueString()
 	}
-	if hostIP == "" {
+	if hostIP == "" && offlineConfigFile == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("ip"),
 			"Missing Junos IP target",
@@ -873,6 +1120,149 @@ func (p *junosProvider) Configure( //nolint:gocyclo
 		}
 	}
 
+	if !config.RPCTimeout.IsNull() {
+		if _, err := client.WithRPCTimeout(int(config.RPCTimeout.ValueInt64())); err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("rpc_timeout"),
+				"Bad value in rpc_timeout",
+				fmt.Sprintf("Error to use value in 'rpc_timeout' attribute: %s\n"+
+					"So the attribute has the default value", err),
+			)
+		}
+	} else if v := os.Getenv(junos.EnvRPCTimeout); v != "" {
+		d, err := strconv.Atoi(v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("rpc_timeout"),
+				"Error to parse "+junos.EnvRPCTimeout,
+				fmt.Sprintf("Error to parse value in "+junos.EnvRPCTimeout+" environment variable: %s\n"+
+					"So the variable is not used", err),
+			)
+		} else {
+			if _, err := client.WithRPCTimeout(d); err != nil {
+				resp.Diagnostics.AddAttributeWarning(
+					path.Root("rpc_timeout"),
+					"Bad value in "+junos.EnvRPCTimeout,
+					fmt.Sprintf("Error to use value in "+junos.EnvRPCTimeout+" environment variable: %s\n"+
+						"So the variable is not used", err),
+				)
+			}
+		}
+	}
+
+	if !config.RetryMaxElapsedTime.IsNull() {
+		if _, err := client.WithRetryMaxElapsedTime(int(config.RetryMaxElapsedTime.ValueInt64())); err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("retry_max_elapsed_time"),
+				"Bad value in retry_max_elapsed_time",
+				fmt.Sprintf("Error to use value in 'retry_max_elapsed_time' attribute: %s\n"+
+					"So the attribute has the default value", err),
+			)
+		}
+	} else if v := os.Getenv(junos.EnvRetryMaxElapsedTime); v != "" {
+		d, err := strconv.Atoi(v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("retry_max_elapsed_time"),
+				"Error to parse "+junos.EnvRetryMaxElapsedTime,
+				fmt.Sprintf("Error to parse value in "+junos.EnvRetryMaxElapsedTime+" environment variable: %s\n"+
+					"So the variable is not used", err),
+			)
+		} else {
+			if _, err := client.WithRetryMaxElapsedTime(d); err != nil {
+				resp.Diagnostics.AddAttributeWarning(
+					path.Root("retry_max_elapsed_time"),
+					"Bad value in "+junos.EnvRetryMaxElapsedTime,
+					fmt.Sprintf("Error to use value in "+junos.EnvRetryMaxElapsedTime+" environment variable: %s\n"+
+						"So the variable is not used", err),
+				)
+			}
+		}
+	}
+
+	if !config.RetryInitialInterval.IsNull() {
+		if _, err := client.WithRetryInitialInterval(int(config.RetryInitialInterval.ValueInt64())); err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("retry_initial_interval"),
+				"Bad value in retry_initial_interval",
+				fmt.Sprintf("Error to use value in 'retry_initial_interval' attribute: %s\n"+
+					"So the attribute has the default value", err),
+			)
+		}
+	} else if v := os.Getenv(junos.EnvRetryInitialInterval); v != "" {
+		d, err := strconv.Atoi(v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("retry_initial_interval"),
+				"Error to parse "+junos.EnvRetryInitialInterval,
+				fmt.Sprintf("Error to parse value in "+junos.EnvRetryInitialInterval+" environment variable: %s\n"+
+					"So the variable is not used", err),
+			)
+		} else {
+			if _, err := client.WithRetryInitialInterval(d); err != nil {
+				resp.Diagnostics.AddAttributeWarning(
+					path.Root("retry_initial_interval"),
+					"Bad value in "+junos.EnvRetryInitialInterval,
+					fmt.Sprintf("Error to use value in "+junos.EnvRetryInitialInterval+" environment variable: %s\n"+
+						"So the variable is not used", err),
+				)
+			}
+		}
+	}
+
+	if !config.RetryMaxInterval.IsNull() {
+		if _, err := client.WithRetryMaxInterval(int(config.RetryMaxInterval.ValueInt64())); err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("retry_max_interval"),
+				"Bad value in retry_max_interval",
+				fmt.Sprintf("Error to use value in 'retry_max_interval' attribute: %s\n"+
+					"So the attribute has the default value", err),
+			)
+		}
+	} else if v := os.Getenv(junos.EnvRetryMaxInterval); v != "" {
+		d, err := strconv.Atoi(v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("retry_max_interval"),
+				"Error to parse "+junos.EnvRetryMaxInterval,
+				fmt.Sprintf("Error to parse value in "+junos.EnvRetryMaxInterval+" environment variable: %s\n"+
+					"So the variable is not used", err),
+			)
+		} else {
+			if _, err := client.WithRetryMaxInterval(d); err != nil {
+				resp.Diagnostics.AddAttributeWarning(
+					path.Root("retry_max_interval"),
+					"Bad value in "+junos.EnvRetryMaxInterval,
+					fmt.Sprintf("Error to use value in "+junos.EnvRetryMaxInterval+" environment variable: %s\n"+
+						"So the variable is not used", err),
+				)
+			}
+		}
+	}
+
+	if !config.RetryErrors.IsNull() {
+		retryErrors := make([]string, 0, len(config.RetryErrors.Elements()))
+		for _, v := range config.RetryErrors.Elements() {
+			retryErrors = append(retryErrors, v.(types.String).ValueString())
+		}
+		_, _ = client.WithRetryErrors(retryErrors)
+	} else if v := os.Getenv(junos.EnvRetryErrors); v != "" {
+		retryErrors := make([]string, 0)
+		for kind := range strings.SplitSeq(v, ",") {
+			if kind = strings.TrimSpace(kind); kind != "" {
+				retryErrors = append(retryErrors, kind)
+			}
+		}
+		if _, err := client.WithRetryErrors(retryErrors); err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("retry_errors"),
+				"Bad value in "+junos.EnvRetryErrors,
+				fmt.Sprintf("Error to use value in "+junos.EnvRetryErrors+" environment variable: %s\n"+
+					"So the variable is not used", err),
+			)
+		}
+	}
+
 	_, _ = client.WithFilePermission(0o644) // default value for file_permission
 	if !config.FilePermission.IsNull() {
 		filePerm, err := strconv.ParseInt(config.FilePermission.ValueString(), 8, 64)
@@ -980,6 +1370,30 @@ func (p *junosProvider) Configure( //nolint:gocyclo
 		client.WithFakeDeleteAlso()
 	}
 
+	if !config.FakeSetFileHeader.IsNull() {
+		if config.FakeSetFileHeader.ValueBool() {
+			client.WithFakeSetFileHeader()
+		}
+	} else if utils.ParseTrue(os.Getenv(junos.EnvFakesetfileHeader)) {
+		client.WithFakeSetFileHeader()
+	}
+
+	if !config.FakeSetFileXML.IsNull() {
+		if config.FakeSetFileXML.ValueBool() {
+			client.WithFakeSetFileXML()
+		}
+	} else if utils.ParseTrue(os.Getenv(junos.EnvFakesetfileXML)) {
+		client.WithFakeSetFileXML()
+	}
+
+	if !config.FakeSetFileText.IsNull() {
+		if config.FakeSetFileText.ValueBool() {
+			client.WithFakeSetFileText()
+		}
+	} else if utils.ParseTrue(os.Getenv(junos.EnvFakesetfileText)) {
+		client.WithFakeSetFileText()
+	}
+
 	if !config.UseSingleSession.IsNull() {
 		if config.UseSingleSession.ValueBool() {
 			client.WithSingleSession()
@@ -988,6 +1402,49 @@ func (p *junosProvider) Configure( //nolint:gocyclo
 		client.WithSingleSession()
 	}
 
+	if !config.PlanCheckReferences.IsNull() {
+		_, _ = client.WithPlanCheckReferences(config.PlanCheckReferences.ValueString())
+	} else if v := os.Getenv(junos.EnvPlanCheckReferences); v != "" {
+		if _, err := client.WithPlanCheckReferences(v); err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("plan_check_references"),
+				"Bad value in "+junos.EnvPlanCheckReferences,
+				fmt.Sprintf("Error to use value in "+junos.EnvPlanCheckReferences+" environment variable: %s\n"+
+					"So the variable is not used", err),
+			)
+		}
+	}
+
+	if offlineConfigFile != "" {
+		if err := utils.ReplaceTildeToHomeDir(&offlineConfigFile); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("offline_config_file"),
+				"Bad value in offline_config_file",
+				fmt.Sprintf("Error to use value in offline_config_file attribute or "+
+					junos.EnvOfflineConfigFile+" environment variable: %s", err),
+			)
+
+			return
+		}
+		client.WithOfflineConfigFile(offlineConfigFile)
+
+		if !config.OfflineHardwareModel.IsNull() {
+			client.WithOfflineHardwareModel(config.OfflineHardwareModel.ValueString())
+		} else if v := os.Getenv(junos.EnvOfflineHardwareModel); v != "" {
+			client.WithOfflineHardwareModel(v)
+		}
+
+		if client.FakeCreateSetFile() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("offline_config_file"),
+				"Inconsistency offline attributes",
+				"'offline_config_file' cannot be set with 'fake_create_with_setfile'",
+			)
+
+			return
+		}
+	}
+
 	if !client.FakeCreateSetFile() &&
 		(client.FakeUpdateAlso() || client.FakeDeleteAlso()) {
 		resp.Diagnostics.AddAttributeError(
@@ -998,6 +1455,17 @@ func (p *junosProvider) Configure( //nolint:gocyclo
 
 		return
 	}
+	if !client.FakeCreateSetFile() &&
+		(client.FakeSetFileHeader() || client.FakeSetFileXML() || client.FakeSetFileText()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("fake_create_with_setfile"),
+			"Inconsistency fake attributes",
+			"'fake_create_with_setfile' need to be set with 'fake_setfile_header', 'fake_setfile_xml' "+
+				"and 'fake_setfile_text'",
+		)
+
+		return
+	}
 
 	resp.ActionData = client
 	resp.DataSourceData = client
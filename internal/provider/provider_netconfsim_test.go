@@ -0,0 +1,84 @@
+package provider_test
+
+import (
+	"os"
+	"os/exec"
+	"regexp"
+	"testing"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/netconfsim"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// testNetconfSimStart starts a netconf simulator and configures the provider to use it
+// to run tests with resource.UnitTest without a Junos device.
+//
+// The test is skipped if the terraform binary is not available.
+func testNetconfSimStart(t *testing.T, simConfig netconfsim.Config) *netconfsim.Server {
+	t.Helper()
+
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") == "" {
+		if _, err := exec.LookPath("terraform"); err != nil {
+			t.Skip("terraform binary not found to run tests with netconf simulator")
+		}
+	}
+	srv := netconfsim.Start(t, simConfig)
+	srv.Setenv(t)
+	t.Setenv(junos.EnvFakecreateSetfile, "")
+	t.Setenv(junos.EnvOfflineConfigFile, "")
+
+	return srv
+}
+
+func TestUnitDataSourceConfigDrift_netconfsim(t *testing.T) {
+	srv := testNetconfSimStart(t, netconfsim.Config{})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: config.StaticDirectory("testdata/TestUnitDataSourceConfigDrift_netconfsim/1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.junos_config_drift.testacc",
+						"drift", "true"),
+					resource.TestCheckTypeSetElemAttr("data.junos_config_drift.testacc",
+						"unmanaged_lines.*",
+						"set policy-options prefix-list testacc_config_drift_unmanaged 192.0.2.128/25"),
+				),
+			},
+			{
+				ConfigDirectory: config.StaticDirectory("testdata/TestUnitDataSourceConfigDrift_netconfsim/2"),
+			},
+		},
+	})
+
+	if config := srv.CommittedConfig(); config != "" {
+		t.Errorf("got unexpected committed configuration after destroy %q", config)
+	}
+}
+
+func TestUnitResourceBgpGroup_planCheckReferences(t *testing.T) {
+	testNetconfSimStart(t, netconfsim.Config{})
+	t.Setenv(junos.EnvPlanCheckReferences, junos.PlanCheckReferencesError)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: config.StaticDirectory("testdata/TestUnitResourceBgpGroup_planCheckReferences/1"),
+				ExpectError: regexp.MustCompile(
+					`policy-statement "testacc_plan_check_missing" not found on device`),
+			},
+			{
+				ConfigDirectory: config.StaticDirectory("testdata/TestUnitResourceBgpGroup_planCheckReferences/2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("junos_bgp_group.testacc_plan_check",
+						"export.0", "testacc_plan_check"),
+				),
+			},
+		},
+	})
+}
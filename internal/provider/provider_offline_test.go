@@ -0,0 +1,98 @@
+package provider_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testaccProviderOfflineConfigFile = "/tmp/testacc_terraform-provider-junos_offline-config-file"
+
+func TestAccProviderOfflineConfigFile_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if err := os.WriteFile(testaccProviderOfflineConfigFile, []byte(
+				"set version 23.4R1.9\n"+
+					"set policy-options prefix-list testacc_offline_existing 192.0.2.0/25\n",
+			), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() { _ = os.Remove(testaccProviderOfflineConfigFile) })
+		},
+		Steps: []resource.TestStep{
+			{
+				ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+				ConfigDirectory:          config.TestStepDirectory(),
+				ConfigVariables: map[string]config.Variable{
+					"file": config.StringVariable(testaccProviderOfflineConfigFile),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("junos_policyoptions_prefix_list.testacc_offline",
+						"prefix.#", "2"),
+					testAccCheckOfflineConfigFileContains(
+						"set policy-options prefix-list testacc_offline 192.0.2.128/25",
+						"set policy-options prefix-list testacc_offline_existing 192.0.2.0/25",
+					),
+				),
+			},
+			{
+				ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+				ResourceName:             "junos_policyoptions_prefix_list.testacc_offline",
+				ImportState:              true,
+				ImportStateVerify:        true,
+				ConfigDirectory:          config.StaticDirectory("testdata/TestAccProviderOfflineConfigFile_basic/1"),
+				ConfigVariables: map[string]config.Variable{
+					"file": config.StringVariable(testaccProviderOfflineConfigFile),
+				},
+			},
+			{
+				ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+				ConfigDirectory:          config.TestStepDirectory(),
+				ConfigVariables: map[string]config.Variable{
+					"file": config.StringVariable(testaccProviderOfflineConfigFile),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOfflineConfigFileNotContains(
+						"set policy-options prefix-list testacc_offline ",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOfflineConfigFileContains(lines ...string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		content, err := os.ReadFile(testaccProviderOfflineConfigFile)
+		if err != nil {
+			return err
+		}
+		for _, line := range lines {
+			if !strings.Contains(string(content), line+"\n") {
+				return fmt.Errorf("line %q not found in offline config file", line)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckOfflineConfigFileNotContains(prefix string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		content, err := os.ReadFile(testaccProviderOfflineConfigFile)
+		if err != nil {
+			return err
+		}
+		if strings.Contains(string(content), prefix) {
+			return errors.New("line with prefix " + prefix + " found in offline config file")
+		}
+
+		return nil
+	}
+}
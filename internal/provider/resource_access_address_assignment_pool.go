@@ -37,6 +37,7 @@ var (
 	_ resource.ResourceWithValidateConfig = &accessAddressAssignmentPool{}
 	_ resource.ResourceWithImportState    = &accessAddressAssignmentPool{}
 	_ resource.ResourceWithUpgradeState   = &accessAddressAssignmentPool{}
+	_ resource.ResourceWithIdentity       = &accessAddressAssignmentPool{}
 )
 
 type accessAddressAssignmentPool struct {
@@ -674,6 +675,26 @@ func (rsc *accessAddressAssignmentPool) Schema(
 	}
 }
 
+func (rsc *accessAddressAssignmentPool) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *accessAddressAssignmentPool) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Address pool name.",
+		},
+		{
+			name:         "routing_instance",
+			description:  "Routing instance for pool.",
+			defaultValue: junos.DefaultW,
+		},
+	}
+}
+
 type accessAddressAssignmentPoolData struct {
 	ID              types.String                            `tfsdk:"id"`
 	Name            types.String                            `tfsdk:"name"`
@@ -1670,6 +1691,10 @@ func (rsc *accessAddressAssignmentPool) Delete(
 func (rsc *accessAddressAssignmentPool) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data accessAddressAssignmentPoolData
 
 	var _ resourceDataReadFrom2String = &data
@@ -1685,7 +1710,7 @@ func (rsc *accessAddressAssignmentPool) ImportState(
 }
 
 func checkAccessAddressAssignmentPoolExists(
-	_ context.Context, name, routingInstance string, junSess *junos.Session,
+	ctx context.Context, name, routingInstance string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
@@ -1693,8 +1718,8 @@ func checkAccessAddressAssignmentPoolExists(
 	if routingInstance != "" && routingInstance != junos.DefaultW {
 		showPrefix += junos.RoutingInstancesWS + routingInstance + " "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"access address-assignment pool " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"access address-assignment pool "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -1718,7 +1743,7 @@ func (rscData *accessAddressAssignmentPoolData) nullID() bool {
 }
 
 func (rscData *accessAddressAssignmentPoolData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -1746,7 +1771,7 @@ func (rscData *accessAddressAssignmentPoolData) set(
 		configSet = append(configSet, blockSet...)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (block *accessAddressAssignmentPoolBlockFamily) configSet(
@@ -2109,14 +2134,14 @@ func (block *accessAddressAssignmentPoolBlockFamilyBlockDhcpAttributes) configSe
 }
 
 func (rscData *accessAddressAssignmentPoolData) read(
-	_ context.Context, name, routingInstance string, junSess *junos.Session,
+	ctx context.Context, name, routingInstance string, junSess *junos.Session,
 ) error {
 	showPrefix := junos.CmdShowConfig
 	if routingInstance != "" && routingInstance != junos.DefaultW {
 		showPrefix += junos.RoutingInstancesWS + routingInstance + " "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"access address-assignment pool " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"access address-assignment pool "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -2368,7 +2393,7 @@ func (block *accessAddressAssignmentPoolBlockFamilyBlockDhcpAttributes) read(ite
 }
 
 func (rscData *accessAddressAssignmentPoolData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	delPrefix := junos.DeleteLS
 	if v := rscData.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
@@ -2379,5 +2404,5 @@ func (rscData *accessAddressAssignmentPoolData) del(
 		delPrefix + "access address-assignment pool " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
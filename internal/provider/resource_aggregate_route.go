@@ -32,6 +32,7 @@ var (
 	_ resource.ResourceWithConfigure      = &aggregateRoute{}
 	_ resource.ResourceWithValidateConfig = &aggregateRoute{}
 	_ resource.ResourceWithImportState    = &aggregateRoute{}
+	_ resource.ResourceWithIdentity       = &aggregateRoute{}
 )
 
 type aggregateRoute struct {
@@ -230,6 +231,26 @@ func (rsc *aggregateRoute) Schema(
 	}
 }
 
+func (rsc *aggregateRoute) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *aggregateRoute) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "destination",
+			description: "Destination prefix.",
+		},
+		{
+			name:         "routing_instance",
+			description:  "Routing instance for aggregate route.",
+			defaultValue: junos.DefaultW,
+		},
+	}
+}
+
 type aggregateRouteData struct {
 	ID                       types.String   `tfsdk:"id"`
 	Destination              types.String   `tfsdk:"destination"`
@@ -478,6 +499,10 @@ func (rsc *aggregateRoute) Delete(
 func (rsc *aggregateRoute) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data aggregateRouteData
 
 	var _ resourceDataReadFrom2String = &data
@@ -493,7 +518,7 @@ func (rsc *aggregateRoute) ImportState(
 }
 
 func checkAggregateRouteExists(
-	_ context.Context, destination, routingInstance string, junSess *junos.Session,
+	ctx context.Context, destination, routingInstance string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
@@ -510,8 +535,8 @@ func checkAggregateRouteExists(
 			showPrefix += "rib " + routingInstance + ".inet6.0 "
 		}
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"aggregate route " + destination + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"aggregate route "+destination+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -535,7 +560,7 @@ func (rscData *aggregateRouteData) nullID() bool {
 }
 
 func (rscData *aggregateRouteData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -600,11 +625,11 @@ func (rscData *aggregateRouteData) set(
 			utils.ConvI64toa(rscData.Preference.ValueInt64()))
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *aggregateRouteData) read(
-	_ context.Context, destination, routingInstance string, junSess *junos.Session,
+	ctx context.Context, destination, routingInstance string, junSess *junos.Session,
 ) error {
 	showPrefix := junos.CmdShowConfig
 	switch routingInstance {
@@ -619,8 +644,8 @@ func (rscData *aggregateRouteData) read(
 			showPrefix += "rib " + routingInstance + ".inet6.0 "
 		}
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"aggregate route " + destination + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"aggregate route "+destination+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -686,7 +711,7 @@ func (rscData *aggregateRouteData) read(
 }
 
 func (rscData *aggregateRouteData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	delPrefix := junos.DeleteLS
 	switch routingInstance := rscData.RoutingInstance.ValueString(); routingInstance {
@@ -706,5 +731,5 @@ func (rscData *aggregateRouteData) del(
 		delPrefix + "aggregate route " + rscData.Destination.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
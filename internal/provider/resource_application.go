@@ -30,8 +30,10 @@ import (
 var (
 	_ resource.Resource                   = &application{}
 	_ resource.ResourceWithConfigure      = &application{}
+	_ resource.ResourceWithModifyPlan     = &application{}
 	_ resource.ResourceWithValidateConfig = &application{}
 	_ resource.ResourceWithImportState    = &application{}
+	_ resource.ResourceWithIdentity       = &application{}
 )
 
 type application struct {
@@ -104,6 +106,21 @@ func (rsc *application) Schema(
 	}
 }
 
+func (rsc *application) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *application) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Application name.",
+		},
+	}
+}
+
 type applicationData struct {
 	applicationAttrData
 
@@ -624,6 +641,12 @@ func (rsc *application) ValidateConfig(
 	config.applicationAttrConfig.validateConfig(ctx, nil, "", resp)
 }
 
+func (rsc *application) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	defaultResourceModifyPlanAddPlanned(ctx, rsc, &applicationData{}, req, resp)
+}
+
 func (rsc *application) Create(
 	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse,
 ) {
@@ -755,6 +778,10 @@ func (rsc *application) Delete(
 func (rsc *application) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data applicationData
 
 	var _ resourceDataReadFrom1String = &data
@@ -769,12 +796,12 @@ func (rsc *application) ImportState(
 }
 
 func checkApplicationExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"applications application " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"applications application "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -794,7 +821,7 @@ func (rscData *applicationData) nullID() bool {
 }
 
 func (rscData *applicationData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -808,7 +835,7 @@ func (rscData *applicationData) set(
 		return errPath, err
 	}
 
-	return path.Empty(), junSess.ConfigSet(dataConfigSet)
+	return path.Empty(), junSess.ConfigSet(ctx, dataConfigSet)
 }
 
 func (rscData applicationAttrData) configSet(blockErrorSuffix string) ([]string, path.Path, error) {
@@ -939,10 +966,10 @@ func (block *applicationBlockTerm) configSet(
 }
 
 func (rscData *applicationData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"applications application " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"applications application "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -1052,11 +1079,11 @@ func (block *applicationBlockTerm) read(itemTrim string) (err error) {
 }
 
 func (rscData *applicationData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete applications application " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -27,8 +27,10 @@ import (
 var (
 	_ resource.Resource                   = &applicationSet{}
 	_ resource.ResourceWithConfigure      = &applicationSet{}
+	_ resource.ResourceWithModifyPlan     = &applicationSet{}
 	_ resource.ResourceWithValidateConfig = &applicationSet{}
 	_ resource.ResourceWithImportState    = &applicationSet{}
+	_ resource.ResourceWithIdentity       = &applicationSet{}
 )
 
 type applicationSet struct {
@@ -100,6 +102,21 @@ func (rsc *applicationSet) Schema(
 	}
 }
 
+func (rsc *applicationSet) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *applicationSet) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Application set name.",
+		},
+	}
+}
+
 type applicationSetData struct {
 	applicationSetAttrData
 
@@ -209,6 +226,12 @@ func (rsc *applicationSet) ValidateConfig(
 	config.applicationSetAttrConfig.validateConfig(ctx, nil, "", resp)
 }
 
+func (rsc *applicationSet) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	defaultResourceModifyPlanAddPlanned(ctx, rsc, &applicationSetData{}, req, resp)
+}
+
 func (rsc *applicationSet) Create(
 	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse,
 ) {
@@ -340,6 +363,10 @@ func (rsc *applicationSet) Delete(
 func (rsc *applicationSet) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data applicationSetData
 
 	var _ resourceDataReadFrom1String = &data
@@ -354,12 +381,12 @@ func (rsc *applicationSet) ImportState(
 }
 
 func checkApplicationSetExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"applications application-set " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"applications application-set "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -379,7 +406,7 @@ func (rscData *applicationSetData) nullID() bool {
 }
 
 func (rscData *applicationSetData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -388,7 +415,7 @@ func (rscData *applicationSetData) set(
 			errors.New("at least one of arguments need to be set (in addition to `name`)")
 	}
 
-	return path.Empty(), junSess.ConfigSet(rscData.applicationSetAttrData.configSet())
+	return path.Empty(), junSess.ConfigSet(ctx, rscData.applicationSetAttrData.configSet())
 }
 
 func (rscData *applicationSetAttrData) configSet() []string {
@@ -409,10 +436,10 @@ func (rscData *applicationSetAttrData) configSet() []string {
 }
 
 func (rscData *applicationSetData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"applications application-set " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"applications application-set "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -446,11 +473,11 @@ func (rscData *applicationSetAttrData) read(itemTrim string) {
 }
 
 func (rscData *applicationSetData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete applications application-set " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -24,6 +24,7 @@ var (
 	_ resource.ResourceWithConfigure      = &applyGroup{}
 	_ resource.ResourceWithValidateConfig = &applyGroup{}
 	_ resource.ResourceWithImportState    = &applyGroup{}
+	_ resource.ResourceWithIdentity       = &applyGroup{}
 )
 
 type applyGroup struct {
@@ -106,6 +107,25 @@ func (rsc *applyGroup) Schema(
 	}
 }
 
+func (rsc *applyGroup) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *applyGroup) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Name of group.",
+		},
+		{
+			name:        "prefix",
+			description: "Prefix path to define where apply-group must be set.",
+		},
+	}
+}
+
 type applyGroupData struct {
 	ID     types.String `tfsdk:"id"`
 	Name   types.String `tfsdk:"name"`
@@ -226,6 +246,10 @@ func (rsc *applyGroup) Delete(
 func (rsc *applyGroup) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data applyGroupData
 
 	var _ resourceDataReadFrom2String = &data
@@ -249,7 +273,7 @@ func (rscData *applyGroupData) nullID() bool {
 }
 
 func (rscData *applyGroupData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -262,17 +286,17 @@ func (rscData *applyGroupData) set(
 		junos.SetLS + prefix + "apply-groups \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *applyGroupData) read(
-	_ context.Context, name, prefix string, junSess *junos.Session,
+	ctx context.Context, name, prefix string, junSess *junos.Session,
 ) error {
 	if prefix != "" && !strings.HasSuffix(prefix, " ") {
 		prefix += " "
 	}
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		prefix + "apply-groups" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		prefix+"apply-groups"+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -301,7 +325,7 @@ func (rscData *applyGroupData) read(
 }
 
 func (rscData *applyGroupData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	prefix := rscData.Prefix.ValueString()
 	if prefix != "" && !strings.HasSuffix(prefix, " ") {
@@ -312,5 +336,5 @@ func (rscData *applyGroupData) del(
 		junos.DeleteLS + prefix + "apply-groups \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -24,6 +24,7 @@ var (
 	_ resource.ResourceWithConfigure      = &applyGroupExcept{}
 	_ resource.ResourceWithValidateConfig = &applyGroupExcept{}
 	_ resource.ResourceWithImportState    = &applyGroupExcept{}
+	_ resource.ResourceWithIdentity       = &applyGroupExcept{}
 )
 
 type applyGroupExcept struct {
@@ -106,6 +107,25 @@ func (rsc *applyGroupExcept) Schema(
 	}
 }
 
+func (rsc *applyGroupExcept) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *applyGroupExcept) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Name of group.",
+		},
+		{
+			name:        "prefix",
+			description: "Prefix path to define where apply-groups-except must be set.",
+		},
+	}
+}
+
 type applyGroupExceptData struct {
 	ID     types.String `tfsdk:"id"`
 	Name   types.String `tfsdk:"name"`
@@ -243,6 +263,10 @@ func (rsc *applyGroupExcept) Delete(
 func (rsc *applyGroupExcept) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data applyGroupExceptData
 
 	var _ resourceDataReadFrom2String = &data
@@ -266,7 +290,7 @@ func (rscData *applyGroupExceptData) nullID() bool {
 }
 
 func (rscData *applyGroupExceptData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -279,17 +303,17 @@ func (rscData *applyGroupExceptData) set(
 		junos.SetLS + prefix + "apply-groups-except \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *applyGroupExceptData) read(
-	_ context.Context, name, prefix string, junSess *junos.Session,
+	ctx context.Context, name, prefix string, junSess *junos.Session,
 ) error {
 	if !strings.HasSuffix(prefix, " ") {
 		prefix += " "
 	}
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		prefix + "apply-groups-except" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		prefix+"apply-groups-except"+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -316,7 +340,7 @@ func (rscData *applyGroupExceptData) read(
 }
 
 func (rscData *applyGroupExceptData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	prefix := rscData.Prefix.ValueString()
 	if !strings.HasSuffix(prefix, " ") {
@@ -327,5 +351,5 @@ func (rscData *applyGroupExceptData) del(
 		junos.DeleteLS + prefix + "apply-groups-except \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
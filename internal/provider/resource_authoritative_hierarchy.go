@@ -0,0 +1,438 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jeremmfr/terraform-provider-junos/internal/configtree"
+	"github.com/jeremmfr/terraform-provider-junos/internal/junos"
+	"github.com/jeremmfr/terraform-provider-junos/internal/tfdiag"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &authoritativeHierarchy{}
+	_ resource.ResourceWithConfigure   = &authoritativeHierarchy{}
+	_ resource.ResourceWithModifyPlan  = &authoritativeHierarchy{}
+	_ resource.ResourceWithImportState = &authoritativeHierarchy{}
+)
+
+type authoritativeHierarchy struct {
+	client *junos.Client
+}
+
+func newAuthoritativeHierarchyResource() resource.Resource {
+	return &authoritativeHierarchy{}
+}
+
+func (rsc *authoritativeHierarchy) typeName() string {
+	return providerName + "_authoritative_hierarchy"
+}
+
+func (rsc *authoritativeHierarchy) junosClient() *junos.Client {
+	return rsc.client
+}
+
+func (rsc *authoritativeHierarchy) Metadata(
+	_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse,
+) {
+	resp.TypeName = rsc.typeName()
+}
+
+func (rsc *authoritativeHierarchy) Configure(
+	ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*junos.Client)
+	if !ok {
+		unexpectedResourceConfigureType(ctx, req, resp)
+
+		return
+	}
+	rsc.client = client
+}
+
+func (rsc *authoritativeHierarchy) Schema(
+	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Manage exclusively a hierarchy level: " +
+			"remove all configuration under it not owned by a resource managed with the provider.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "An identifier for the resource with format `<path>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Required:    true,
+				Description: "Hierarchy level (without `set` word) to manage exclusively.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"unmanaged": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Statements under the hierarchy level not owned by a resource, " +
+					"found when reading the device configuration.",
+			},
+			"removals": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Statements under the hierarchy level removed (or to be removed) " +
+					"by the last apply.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type authoritativeHierarchyData struct {
+	ID        types.String   `tfsdk:"id"`
+	Path      types.String   `tfsdk:"path"`
+	Unmanaged []types.String `tfsdk:"unmanaged"`
+	Removals  types.List     `tfsdk:"removals"`
+}
+
+func (rsc *authoritativeHierarchy) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state authoritativeHierarchyData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Path.IsUnknown() {
+		return
+	}
+
+	var removals []string
+	if req.State.Raw.IsNull() {
+		if rsc.junosClient() == nil || rsc.junosClient().FakeCreateSetFile() {
+			return
+		}
+		junSess, err := rsc.junosClient().StartNewSession(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+			return
+		}
+		defer junSess.Close()
+
+		junSess.ReadLock()
+		removals, err = authoritativeHierarchyRemovals(
+			ctx, junSess, plan.Path.ValueString(), managedHierarchies(rsc.junosClient()),
+		)
+		junSess.ReadUnlock()
+		if err != nil {
+			resp.Diagnostics.AddError(tfdiag.ConfigReadErrSummary, err.Error())
+
+			return
+		}
+	} else {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, v := range state.Unmanaged {
+			removals = append(removals, v.ValueString())
+		}
+	}
+
+	plan.Unmanaged = make([]types.String, 0)
+	if removals == nil {
+		removals = make([]string, 0)
+	}
+	var diags diag.Diagnostics
+	plan.Removals, diags = types.ListValueFrom(ctx, types.StringType, removals)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(removals) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Configuration Will Be Removed",
+			fmt.Sprintf("apply of %s %q will delete the following statements: \n  %s",
+				rsc.typeName(), plan.Path.ValueString(), strings.Join(removals, "\n  ")),
+		)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (rsc *authoritativeHierarchy) Create(
+	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse,
+) {
+	var plan authoritativeHierarchyData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Path.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("path"),
+			"Empty Path",
+			"could not create "+rsc.typeName()+" with empty path",
+		)
+
+		return
+	}
+
+	if rsc.junosClient().FakeCreateSetFile() {
+		resp.Diagnostics.AddWarning(
+			"Configuration Not Removed",
+			"the configuration under the hierarchy level cannot be read "+
+				"to be removed when fake_create_with_setfile is set",
+		)
+		plan.fillID()
+		plan.Unmanaged = make([]types.String, 0)
+		plan.Removals = types.ListValueMust(types.StringType, []attr.Value{})
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+		return
+	}
+
+	rsc.apply(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (rsc *authoritativeHierarchy) Read(
+	ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse,
+) {
+	var state authoritativeHierarchyData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	junSess, err := rsc.junosClient().StartNewSession(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+		return
+	}
+	defer junSess.Close()
+
+	junSess.ReadLock()
+	unmanaged, err := authoritativeHierarchyRemovals(
+		ctx, junSess, state.Path.ValueString(), managedHierarchies(rsc.junosClient()),
+	)
+	junSess.ReadUnlock()
+	if err != nil {
+		resp.Diagnostics.AddError(tfdiag.ConfigReadErrSummary, err.Error())
+
+		return
+	}
+
+	state.fillID()
+	state.Unmanaged = make([]types.String, len(unmanaged))
+	for i, v := range unmanaged {
+		state.Unmanaged[i] = types.StringValue(v)
+	}
+	if state.Removals.IsNull() || state.Removals.IsUnknown() {
+		state.Removals = types.ListValueMust(types.StringType, []attr.Value{})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (rsc *authoritativeHierarchy) Update(
+	ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse,
+) {
+	var plan authoritativeHierarchyData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if rsc.junosClient().FakeUpdateAlso() {
+		resp.Diagnostics.AddWarning(
+			"Configuration Not Removed",
+			"the configuration under the hierarchy level cannot be read "+
+				"to be removed when fake_update_also is set",
+		)
+		plan.Unmanaged = make([]types.String, 0)
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+		return
+	}
+
+	rsc.apply(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (rsc *authoritativeHierarchy) Delete(
+	_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse,
+) {
+	// no-op
+}
+
+func (rsc *authoritativeHierarchy) ImportState(
+	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
+) {
+	var data authoritativeHierarchyData
+	data.Path = types.StringValue(req.ID)
+	data.fillID()
+	data.Unmanaged = make([]types.String, 0)
+	data.Removals = types.ListValueMust(types.StringType, []attr.Value{})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// apply removes the statements planned in removals (computed in ModifyPlan).
+//
+// The removals are not recomputed when they are unknown because
+// only the resources applied in the current run are known as managed at this step.
+func (rsc *authoritativeHierarchy) apply(
+	ctx context.Context, plan *authoritativeHierarchyData, diags *diag.Diagnostics,
+) {
+	if plan.Removals.IsUnknown() || plan.Removals.IsNull() {
+		diags.AddAttributeError(
+			path.Root("removals"),
+			"Unknown Removals",
+			"could not apply "+rsc.typeName()+" with statements to remove unknown at plan time, "+
+				"run a new plan when the path and the provider configuration are known",
+		)
+
+		return
+	}
+	var removals []string
+	diags.Append(plan.Removals.ElementsAs(ctx, &removals, false)...)
+	if diags.HasError() {
+		return
+	}
+	plan.fillID()
+	plan.Unmanaged = make([]types.String, 0)
+	if len(removals) == 0 {
+		return
+	}
+
+	junSess, err := rsc.junosClient().StartNewSession(ctx)
+	if err != nil {
+		diags.AddError(tfdiag.StartSessErrSummary, err.Error())
+
+		return
+	}
+	defer junSess.Close()
+	if err := junSess.ConfigLock(ctx); err != nil {
+		diags.AddError(tfdiag.ConfigLockErrSummary, err.Error())
+
+		return
+	}
+	defer func() {
+		diags.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock(ctx))...)
+	}()
+
+	configSet := make([]string, len(removals))
+	for i, v := range removals {
+		configSet[i] = junos.DeleteLS + v
+	}
+	if err := junSess.ConfigSet(ctx, configSet); err != nil {
+		diags.AddError(tfdiag.ConfigDelErrSummary, err.Error())
+
+		return
+	}
+	warns, err := junSess.CommitConf(ctx, "remove unmanaged configuration with resource "+rsc.typeName())
+	diags.Append(tfdiag.Warns(tfdiag.ConfigCommitWarnSummary, warns)...)
+	if err != nil {
+		diags.AddError(tfdiag.ConfigCommitErrSummary, err.Error())
+
+		return
+	}
+}
+
+func (rscData *authoritativeHierarchyData) fillID() {
+	rscData.ID = types.StringValue(rscData.Path.ValueString())
+}
+
+// authoritativeHierarchyRemovals returns the statements (without `delete` word) to delete
+// to remove the committed configuration under the hierarchy level
+// not owned by the managed hierarchies.
+//
+// Each statement is the shortest one that doesn't contain any managed hierarchy.
+func authoritativeHierarchyRemovals(
+	ctx context.Context, junSess *junos.Session, hierarchy string, managed []managedHierarchy,
+) (
+	[]string, error,
+) {
+	config, err := junSess.ConfigGet(ctx, junos.ConfigFormatSet)
+	if err != nil {
+		return nil, fmt.Errorf("getting configuration: %w", err)
+	}
+
+	return authoritativeHierarchyRemovalsFromConfig(config, hierarchy, managed), nil
+}
+
+func authoritativeHierarchyRemovalsFromConfig(
+	config, hierarchy string, managed []managedHierarchy,
+) []string {
+	prefix := configtree.SplitWords(strings.TrimPrefix(strings.TrimSpace(hierarchy), junos.SetLS))
+	removals := make([]string, 0)
+	for item := range strings.SplitSeq(config, "\n") {
+		itemTrim, ok := strings.CutPrefix(strings.TrimSpace(item), junos.SetLS)
+		if !ok {
+			continue
+		}
+		words := configtree.SplitWords(itemTrim)
+		if len(words) <= len(prefix) || !configtree.HasPrefix(words, prefix) {
+			continue
+		}
+		if slices.ContainsFunc(managed, func(hierarchy managedHierarchy) bool {
+			return hierarchy.owns(words)
+		}) {
+			continue
+		}
+		for k := len(prefix) + 1; k <= len(words); k++ {
+			if slices.ContainsFunc(managed, func(hierarchy managedHierarchy) bool {
+				return configtree.HasPrefix(hierarchy.prefix, words[:k])
+			}) {
+				continue
+			}
+			statement := configtree.JoinWords(words[:k])
+			if !slices.Contains(removals, statement) {
+				removals = append(removals, statement)
+			}
+
+			break
+		}
+	}
+
+	return removals
+}
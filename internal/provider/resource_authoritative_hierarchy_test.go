@@ -0,0 +1,39 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceAuthoritativeHierarchy_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory:    config.TestStepDirectory(),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				ConfigDirectory: config.TestStepDirectory(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("junos_authoritative_hierarchy.testacc",
+						"unmanaged.#", "0"),
+					resource.TestCheckTypeSetElemAttr("junos_authoritative_hierarchy.testacc",
+						"removals.*",
+						"policy-options as-path testacc_authhier_unmanaged"),
+				),
+			},
+			{
+				ResourceName:      "junos_authoritative_hierarchy.testacc",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"removals",
+				},
+			},
+		},
+	})
+}
@@ -29,6 +29,7 @@ var (
 	_ resource.ResourceWithModifyPlan     = &bgpGroup{}
 	_ resource.ResourceWithValidateConfig = &bgpGroup{}
 	_ resource.ResourceWithImportState    = &bgpGroup{}
+	_ resource.ResourceWithIdentity       = &bgpGroup{}
 	_ resource.ResourceWithUpgradeState   = &bgpGroup{}
 )
 
@@ -132,6 +133,26 @@ func (rsc *bgpGroup) Schema(
 	}
 }
 
+func (rsc *bgpGroup) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *bgpGroup) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Name of group.",
+		},
+		{
+			name:         "routing_instance",
+			description:  "Routing instance for bgp protocol if not root level.",
+			defaultValue: junos.DefaultW,
+		},
+	}
+}
+
 type bgpGroupData struct {
 	bgpAttrData
 
@@ -177,10 +198,19 @@ func (rsc *bgpGroup) ModifyPlan(
 	}
 
 	config.bgpAttrConfig.modifyPlan(ctx, &plan.bgpAttrConfig)
+	defaultResourceModifyPlanCheckReferences(ctx, rsc, &config, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
 }
 
+func (config *bgpGroupConfig) references(_ context.Context) []configReference {
+	return append(
+		config.policyReferences(),
+		newConfigReferences(path.Root("routing_instance"), referenceRoutingInstance,
+			config.RoutingInstance)...,
+	)
+}
+
 func (rsc *bgpGroup) Create(
 	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse,
 ) {
@@ -391,6 +421,10 @@ func (rsc *bgpGroup) Delete(
 func (rsc *bgpGroup) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data bgpGroupData
 
 	var _ resourceDataReadFrom2String = &data
@@ -406,7 +440,7 @@ func (rsc *bgpGroup) ImportState(
 }
 
 func checkBgpGroupExists(
-	_ context.Context, name, routingInstance string, junSess *junos.Session,
+	ctx context.Context, name, routingInstance string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
@@ -414,8 +448,8 @@ func checkBgpGroupExists(
 	if routingInstance != "" && routingInstance != junos.DefaultW {
 		showPrefix += junos.RoutingInstancesWS + routingInstance + " "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"protocols bgp group \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"protocols bgp group \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -439,7 +473,7 @@ func (rscData *bgpGroupData) nullID() bool {
 }
 
 func (rscData *bgpGroupData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -458,18 +492,18 @@ func (rscData *bgpGroupData) set(
 	}
 	configSet = append(configSet, dataConfigSet...)
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *bgpGroupData) read(
-	_ context.Context, name, routingInstance string, junSess *junos.Session,
+	ctx context.Context, name, routingInstance string, junSess *junos.Session,
 ) error {
 	showPrefix := junos.CmdShowConfig
 	if routingInstance != "" && routingInstance != junos.DefaultW {
 		showPrefix += junos.RoutingInstancesWS + routingInstance + " "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"protocols bgp group \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"protocols bgp group \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -504,7 +538,7 @@ func (rscData *bgpGroupData) read(
 }
 
 func (rscData *bgpGroupData) delOpts(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	delPrefix := junos.DeleteLS
 	if v := rscData.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
@@ -512,11 +546,11 @@ func (rscData *bgpGroupData) delOpts(
 	}
 	delPrefix += "protocols bgp group \"" + rscData.Name.ValueString() + "\" "
 
-	return junSess.ConfigSet(rscData.bgpAttrData.configOptsToDel(delPrefix))
+	return junSess.ConfigSet(ctx, rscData.bgpAttrData.configOptsToDel(delPrefix))
 }
 
 func (rscData *bgpGroupData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	delPrefix := junos.DeleteLS
 	if v := rscData.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
@@ -527,5 +561,5 @@ func (rscData *bgpGroupData) del(
 		delPrefix + "protocols bgp group \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -28,6 +28,7 @@ var (
 	_ resource.ResourceWithModifyPlan     = &bgpNeighbor{}
 	_ resource.ResourceWithValidateConfig = &bgpNeighbor{}
 	_ resource.ResourceWithImportState    = &bgpNeighbor{}
+	_ resource.ResourceWithIdentity       = &bgpNeighbor{}
 	_ resource.ResourceWithUpgradeState   = &bgpNeighbor{}
 )
 
@@ -130,6 +131,30 @@ func (rsc *bgpNeighbor) Schema(
 	}
 }
 
+func (rsc *bgpNeighbor) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *bgpNeighbor) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "ip",
+			description: "IP of neighbor.",
+		},
+		{
+			name:         "routing_instance",
+			description:  "Routing instance for bgp protocol if not root level.",
+			defaultValue: junos.DefaultW,
+		},
+		{
+			name:        "group",
+			description: "Name of BGP group for this neighbor.",
+		},
+	}
+}
+
 type bgpNeighborData struct {
 	bgpAttrData
 
@@ -175,10 +200,19 @@ func (rsc *bgpNeighbor) ModifyPlan(
 	}
 
 	config.bgpAttrConfig.modifyPlan(ctx, &plan.bgpAttrConfig)
+	defaultResourceModifyPlanCheckReferences(ctx, rsc, &config, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
 }
 
+func (config *bgpNeighborConfig) references(_ context.Context) []configReference {
+	return append(
+		config.policyReferences(),
+		newConfigReferences(path.Root("routing_instance"), referenceRoutingInstance,
+			config.RoutingInstance)...,
+	)
+}
+
 func (rsc *bgpNeighbor) Create(
 	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse,
 ) {
@@ -425,6 +459,10 @@ func (rsc *bgpNeighbor) Delete(
 func (rsc *bgpNeighbor) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data bgpNeighborData
 
 	var _ resourceDataReadFrom3String = &data
@@ -440,7 +478,7 @@ func (rsc *bgpNeighbor) ImportState(
 }
 
 func checkBgpNeighborExists(
-	_ context.Context, ip, routingInstance, group string, junSess *junos.Session,
+	ctx context.Context, ip, routingInstance, group string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
@@ -448,8 +486,8 @@ func checkBgpNeighborExists(
 	if routingInstance != "" && routingInstance != junos.DefaultW {
 		showPrefix += junos.RoutingInstancesWS + routingInstance + " "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"protocols bgp group \"" + group + "\" neighbor " + ip + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"protocols bgp group \""+group+"\" neighbor "+ip+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -481,7 +519,7 @@ func (rscData *bgpNeighborData) nullID() bool {
 }
 
 func (rscData *bgpNeighborData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -500,11 +538,11 @@ func (rscData *bgpNeighborData) set(
 	}
 	configSet = append(configSet, dataConfigSet...)
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *bgpNeighborData) read(
-	_ context.Context,
+	ctx context.Context,
 	ip,
 	routingInstance,
 	group string,
@@ -514,8 +552,8 @@ func (rscData *bgpNeighborData) read(
 	if routingInstance != "" && routingInstance != junos.DefaultW {
 		showPrefix += junos.RoutingInstancesWS + routingInstance + " "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"protocols bgp group \"" + group + "\" neighbor " + ip + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"protocols bgp group \""+group+"\" neighbor "+ip+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -546,7 +584,7 @@ func (rscData *bgpNeighborData) read(
 }
 
 func (rscData *bgpNeighborData) delOpts(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	delPrefix := junos.DeleteLS
 	if v := rscData.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
@@ -554,11 +592,11 @@ func (rscData *bgpNeighborData) delOpts(
 	}
 	delPrefix += "protocols bgp group \"" + rscData.Group.ValueString() + "\" neighbor " + rscData.IP.ValueString() + " "
 
-	return junSess.ConfigSet(rscData.bgpAttrData.configOptsToDel(delPrefix))
+	return junSess.ConfigSet(ctx, rscData.bgpAttrData.configOptsToDel(delPrefix))
 }
 
 func (rscData *bgpNeighborData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	delPrefix := junos.DeleteLS
 	if v := rscData.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
@@ -569,5 +607,5 @@ func (rscData *bgpNeighborData) del(
 		delPrefix + "protocols bgp group \"" + rscData.Group.ValueString() + "\" neighbor " + rscData.IP.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -6,8 +6,11 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccResourceBgpNeighbor_basic(t *testing.T) {
@@ -179,3 +182,41 @@ func TestAccResourceBgpNeighbor_basic(t *testing.T) {
 		})
 	}
 }
+
+func TestAccResourceBgpNeighbor_identity(t *testing.T) {
+	if os.Getenv("TESTACC_SWITCH") == "" {
+		resource.Test(t, resource.TestCase{
+			PreCheck: func() { testAccPreCheck(t) },
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_12_0),
+			},
+			ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					ConfigDirectory: config.TestStepDirectory(),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectIdentity("junos_bgp_neighbor.testacc_bgpneighbor_identity",
+							map[string]knownvalue.Check{
+								"ip":               knownvalue.StringExact("192.0.2.4"),
+								"routing_instance": knownvalue.StringExact("testacc_bgpneighbor_identity"),
+								"group":            knownvalue.StringExact("testacc_bgpneighbor_identity"),
+							},
+						),
+					},
+				},
+				{
+					ConfigDirectory: config.TestStepDirectory(),
+					ResourceName:    "junos_bgp_neighbor.testacc_bgpneighbor_identity",
+					ImportState:     true,
+					ImportStateKind: resource.ImportBlockWithResourceIdentity,
+				},
+				{
+					ResourceName:      "junos_bgp_neighbor.testacc_bgpneighbor_identity",
+					ImportState:       true,
+					ImportStateId:     "192.0.2.4_-_testacc_bgpneighbor_identity_-_testacc_bgpneighbor_identity",
+					ImportStateVerify: true,
+				},
+			},
+		})
+	}
+}
@@ -30,6 +30,7 @@ var (
 	_ resource.ResourceWithConfigure      = &eventoptionsDestination{}
 	_ resource.ResourceWithValidateConfig = &eventoptionsDestination{}
 	_ resource.ResourceWithImportState    = &eventoptionsDestination{}
+	_ resource.ResourceWithIdentity       = &eventoptionsDestination{}
 )
 
 type eventoptionsDestination struct {
@@ -140,6 +141,21 @@ func (rsc *eventoptionsDestination) Schema(
 	}
 }
 
+func (rsc *eventoptionsDestination) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *eventoptionsDestination) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Destination name.",
+		},
+	}
+}
+
 type eventoptionsDestinationData struct {
 	ID            types.String                              `tfsdk:"id"`
 	Name          types.String                              `tfsdk:"name"`
@@ -319,6 +335,10 @@ func (rsc *eventoptionsDestination) Delete(
 func (rsc *eventoptionsDestination) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data eventoptionsDestinationData
 
 	var _ resourceDataReadFrom1String = &data
@@ -333,12 +353,12 @@ func (rsc *eventoptionsDestination) ImportState(
 }
 
 func checkEventoptionsDestinationExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"event-options destinations \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"event-options destinations \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -358,7 +378,7 @@ func (rscData *eventoptionsDestinationData) nullID() bool {
 }
 
 func (rscData *eventoptionsDestinationData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -384,14 +404,14 @@ func (rscData *eventoptionsDestinationData) set(
 			utils.ConvI64toa(rscData.TransferDelay.ValueInt64()))
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *eventoptionsDestinationData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"event-options destinations \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"event-options destinations \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -436,11 +456,11 @@ func (rscData *eventoptionsDestinationData) read(
 }
 
 func (rscData *eventoptionsDestinationData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete event-options destinations \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
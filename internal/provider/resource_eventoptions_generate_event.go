@@ -29,6 +29,7 @@ var (
 	_ resource.ResourceWithConfigure      = &eventoptionsGenerateEvent{}
 	_ resource.ResourceWithValidateConfig = &eventoptionsGenerateEvent{}
 	_ resource.ResourceWithImportState    = &eventoptionsGenerateEvent{}
+	_ resource.ResourceWithIdentity       = &eventoptionsGenerateEvent{}
 )
 
 type eventoptionsGenerateEvent struct {
@@ -135,6 +136,21 @@ func (rsc *eventoptionsGenerateEvent) Schema(
 	}
 }
 
+func (rsc *eventoptionsGenerateEvent) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *eventoptionsGenerateEvent) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Name of the event to be generated.",
+		},
+	}
+}
+
 type eventoptionsGenerateEventData struct {
 	ID           types.String `tfsdk:"id"`
 	Name         types.String `tfsdk:"name"`
@@ -303,6 +319,10 @@ func (rsc *eventoptionsGenerateEvent) Delete(
 func (rsc *eventoptionsGenerateEvent) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data eventoptionsGenerateEventData
 
 	var _ resourceDataReadFrom1String = &data
@@ -317,12 +337,12 @@ func (rsc *eventoptionsGenerateEvent) ImportState(
 }
 
 func checkEventoptionsGenerateEventExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"event-options generate-event \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"event-options generate-event \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -342,7 +362,7 @@ func (rscData *eventoptionsGenerateEventData) nullID() bool {
 }
 
 func (rscData *eventoptionsGenerateEventData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -363,14 +383,14 @@ func (rscData *eventoptionsGenerateEventData) set(
 		configSet = append(configSet, setPrefix+"time-of-day "+v)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *eventoptionsGenerateEventData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"event-options generate-event \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"event-options generate-event \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -405,11 +425,11 @@ func (rscData *eventoptionsGenerateEventData) read(
 }
 
 func (rscData *eventoptionsGenerateEventData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete event-options generate-event \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -35,6 +35,7 @@ var (
 	_ resource.ResourceWithValidateConfig = &eventoptionsPolicy{}
 	_ resource.ResourceWithImportState    = &eventoptionsPolicy{}
 	_ resource.ResourceWithUpgradeState   = &eventoptionsPolicy{}
+	_ resource.ResourceWithIdentity       = &eventoptionsPolicy{}
 )
 
 type eventoptionsPolicy struct {
@@ -598,6 +599,21 @@ func (rsc *eventoptionsPolicy) Schema(
 	}
 }
 
+func (rsc *eventoptionsPolicy) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *eventoptionsPolicy) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Name of policy.",
+		},
+	}
+}
+
 type eventoptionsPolicyData struct {
 	ID              types.String                             `tfsdk:"id"`
 	Name            types.String                             `tfsdk:"name"`
@@ -1225,6 +1241,10 @@ func (rsc *eventoptionsPolicy) Delete(
 func (rsc *eventoptionsPolicy) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data eventoptionsPolicyData
 
 	var _ resourceDataReadFrom1String = &data
@@ -1239,12 +1259,12 @@ func (rsc *eventoptionsPolicy) ImportState(
 }
 
 func checkEventoptionsPolicyExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"event-options policy \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"event-options policy \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -1264,7 +1284,7 @@ func (rscData *eventoptionsPolicyData) nullID() bool {
 }
 
 func (rscData *eventoptionsPolicyData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -1321,7 +1341,7 @@ func (rscData *eventoptionsPolicyData) set(
 		configSet = append(configSet, blockSet...)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (block *eventoptionsPolicyBlockThen) configSet(
@@ -1633,10 +1653,10 @@ func (block *eventoptionsPolicyBlockWithin) configSet(
 }
 
 func (rscData *eventoptionsPolicyData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"event-options policy \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"event-options policy \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -1906,11 +1926,11 @@ func (block *eventoptionsPolicyBlockWithin) read(itemTrim string) (err error) {
 }
 
 func (rscData *eventoptionsPolicyData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete event-options policy \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -36,6 +36,7 @@ var (
 	_ resource.ResourceWithValidateConfig = &evpn{}
 	_ resource.ResourceWithImportState    = &evpn{}
 	_ resource.ResourceWithUpgradeState   = &evpn{}
+	_ resource.ResourceWithIdentity       = &evpn{}
 )
 
 type evpn struct {
@@ -260,6 +261,22 @@ func (rsc *evpn) Schema(
 	}
 }
 
+func (rsc *evpn) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *evpn) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:         "routing_instance",
+			description:  "Routing instance.",
+			defaultValue: junos.DefaultW,
+		},
+	}
+}
+
 type evpnData struct {
 	ID                    types.String                    `tfsdk:"id"`
 	RoutingInstance       types.String                    `tfsdk:"routing_instance"`
@@ -456,17 +473,17 @@ func (rsc *evpn) Read(
 	}
 	defer junSess.Close()
 
-	junos.MutexLock()
+	junSess.ReadLock()
 	if v := state.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
 		instanceExists, err := checkRoutingInstanceExists(ctx, v, junSess)
 		if err != nil {
-			junos.MutexUnlock()
+			junSess.ReadUnlock()
 			resp.Diagnostics.AddError(tfdiag.ConfigReadErrSummary, err.Error())
 
 			return
 		}
 		if !instanceExists {
-			junos.MutexUnlock()
+			junSess.ReadUnlock()
 			resp.State.RemoveResource(ctx)
 
 			return
@@ -474,7 +491,7 @@ func (rsc *evpn) Read(
 	}
 
 	err = data.read(ctx, state.RoutingInstance.ValueString(), junSess)
-	junos.MutexUnlock()
+	junSess.ReadUnlock()
 	if err != nil {
 		resp.Diagnostics.AddError(tfdiag.ConfigReadErrSummary, err.Error())
 
@@ -492,6 +509,7 @@ func (rsc *evpn) Read(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
 }
 
 func (rsc *evpn) Update(
@@ -534,6 +552,10 @@ func (rsc *evpn) Delete(
 func (rsc *evpn) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	junSess, err := rsc.junosClient().StartNewSession(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
@@ -580,6 +602,7 @@ func (rsc *evpn) ImportState(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
 }
 
 func (rscData *evpnData) fillID() {
@@ -595,7 +618,7 @@ func (rscData *evpnData) nullID() bool {
 }
 
 func (rscData *evpnData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -686,11 +709,11 @@ func (rscData *evpnData) set(
 		}
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *evpnData) read(
-	_ context.Context, routingInstance string, junSess *junos.Session,
+	ctx context.Context, routingInstance string, junSess *junos.Session,
 ) error {
 	showPrefix := junos.CmdShowConfig
 	showSwitchRI := junos.CmdShowConfig
@@ -700,12 +723,12 @@ func (rscData *evpnData) read(
 	} else {
 		showSwitchRI += "switch-options"
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"protocols evpn" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"protocols evpn"+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
-	showConfigSwitchRI, err := junSess.Command(showSwitchRI + junos.PipeDisplaySetRelative)
+	showConfigSwitchRI, err := junSess.Command(ctx, showSwitchRI+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -799,7 +822,7 @@ func (rscData *evpnData) read(
 }
 
 func (rscData *evpnData) delOpts(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	delPrefix := junos.DeleteLS
 	delSwitchRIPrefix := junos.DeleteLS
@@ -833,11 +856,11 @@ func (rscData *evpnData) delOpts(
 		)
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *evpnData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	delPrefix := junos.DeleteLS
 	delSwitchRIPrefix := junos.DeleteLS
@@ -866,5 +889,5 @@ func (rscData *evpnData) del(
 		)
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
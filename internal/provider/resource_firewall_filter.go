@@ -33,6 +33,7 @@ var (
 	_ resource.ResourceWithConfigure      = &firewallFilter{}
 	_ resource.ResourceWithValidateConfig = &firewallFilter{}
 	_ resource.ResourceWithImportState    = &firewallFilter{}
+	_ resource.ResourceWithIdentity       = &firewallFilter{}
 	_ resource.ResourceWithUpgradeState   = &firewallFilter{}
 )
 
@@ -839,6 +840,25 @@ func (rsc *firewallFilter) Schema(
 	}
 }
 
+func (rsc *firewallFilter) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *firewallFilter) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Filter name.",
+		},
+		{
+			name:        "family",
+			description: "Family where create this filter.",
+		},
+	}
+}
+
 type firewallFilterData struct {
 	ID                types.String              `tfsdk:"id"`
 	Name              types.String              `tfsdk:"name"`
@@ -1733,6 +1753,7 @@ func (rsc *firewallFilter) Update(
 		return
 	}
 
+	var _ resourceDataUpdateWithMinimalDiff = &state
 	defaultResourceUpdate(
 		ctx,
 		rsc,
@@ -1762,6 +1783,10 @@ func (rsc *firewallFilter) Delete(
 func (rsc *firewallFilter) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data firewallFilterData
 
 	var _ resourceDataReadFrom2String = &data
@@ -1777,12 +1802,12 @@ func (rsc *firewallFilter) ImportState(
 }
 
 func checkFirewallFilterExists(
-	_ context.Context, name, family string, junSess *junos.Session,
+	ctx context.Context, name, family string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"firewall family " + family + " filter \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"firewall family "+family+" filter \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -1802,7 +1827,7 @@ func (rscData *firewallFilterData) nullID() bool {
 }
 
 func (rscData *firewallFilterData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -1838,7 +1863,7 @@ func (rscData *firewallFilterData) set(
 		}
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (block *firewallFilterBlockTermBlockFrom) configSet(
@@ -2122,10 +2147,10 @@ func (block *firewallFilterBlockTermBlockThen) configSet(setPrefix string) []str
 }
 
 func (rscData *firewallFilterData) read(
-	_ context.Context, name, family string, junSess *junos.Session,
+	ctx context.Context, name, family string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"firewall family " + family + " filter \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"firewall family "+family+" filter \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -2318,11 +2343,13 @@ func (block *firewallFilterBlockTermBlockThen) read(itemTrim string) {
 }
 
 func (rscData *firewallFilterData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete firewall family " + rscData.Family.ValueString() + " filter \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
+
+func (rscData *firewallFilterData) updateWithMinimalDiff() {}
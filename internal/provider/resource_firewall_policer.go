@@ -32,6 +32,7 @@ var (
 	_ resource.ResourceWithValidateConfig = &firewallPolicer{}
 	_ resource.ResourceWithImportState    = &firewallPolicer{}
 	_ resource.ResourceWithUpgradeState   = &firewallPolicer{}
+	_ resource.ResourceWithIdentity       = &firewallPolicer{}
 )
 
 type firewallPolicer struct {
@@ -243,6 +244,21 @@ func (rsc *firewallPolicer) Schema(
 	}
 }
 
+func (rsc *firewallPolicer) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *firewallPolicer) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Policer name.",
+		},
+	}
+}
+
 type firewallPolicerData struct {
 	ID                       types.String                        `tfsdk:"id"`
 	Name                     types.String                        `tfsdk:"name"`
@@ -529,6 +545,10 @@ func (rsc *firewallPolicer) Delete(
 func (rsc *firewallPolicer) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data firewallPolicerData
 
 	var _ resourceDataReadFrom1String = &data
@@ -543,12 +563,12 @@ func (rsc *firewallPolicer) ImportState(
 }
 
 func checkFirewallPolicerExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"firewall policer \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"firewall policer \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -568,7 +588,7 @@ func (rscData *firewallPolicerData) nullID() bool {
 }
 
 func (rscData *firewallPolicerData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -623,14 +643,14 @@ func (rscData *firewallPolicerData) set(
 			rscData.IfExceedingPPS.PPSLimit.ValueString())
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *firewallPolicerData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"firewall policer \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"firewall policer \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -703,11 +723,11 @@ func (rscData *firewallPolicerData) read(
 }
 
 func (rscData *firewallPolicerData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete firewall policer \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -33,6 +33,7 @@ var (
 	_ resource.ResourceWithValidateConfig = &forwardingoptionsDhcprelay{}
 	_ resource.ResourceWithImportState    = &forwardingoptionsDhcprelay{}
 	_ resource.ResourceWithUpgradeState   = &forwardingoptionsDhcprelay{}
+	_ resource.ResourceWithIdentity       = &forwardingoptionsDhcprelay{}
 )
 
 type forwardingoptionsDhcprelay struct {
@@ -556,6 +557,27 @@ func (rsc *forwardingoptionsDhcprelay) Schema(
 }
 
 //nolint:lll
+func (rsc *forwardingoptionsDhcprelay) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *forwardingoptionsDhcprelay) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:         "routing_instance",
+			description:  "Routing instance if not root level.",
+			defaultValue: junos.DefaultW,
+		},
+		{
+			name:         "version",
+			description:  "Version for DHCP or DHCPv6.",
+			defaultValue: "v4",
+		},
+	}
+}
+
 type forwardingoptionsDhcprelayData struct {
 	ID                                   types.String                                          `tfsdk:"id"`
 	RoutingInstance                      types.String                                          `tfsdk:"routing_instance"`
@@ -1364,17 +1386,17 @@ func (rsc *forwardingoptionsDhcprelay) Read(
 	}
 	defer junSess.Close()
 
-	junos.MutexLock()
+	junSess.ReadLock()
 	if v := state.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
 		instanceExists, err := checkRoutingInstanceExists(ctx, v, junSess)
 		if err != nil {
-			junos.MutexUnlock()
+			junSess.ReadUnlock()
 			resp.Diagnostics.AddError(tfdiag.ConfigReadErrSummary, err.Error())
 
 			return
 		}
 		if !instanceExists {
-			junos.MutexUnlock()
+			junSess.ReadUnlock()
 			resp.State.RemoveResource(ctx)
 
 			return
@@ -1382,7 +1404,7 @@ func (rsc *forwardingoptionsDhcprelay) Read(
 	}
 
 	err = data.read(ctx, state.RoutingInstance.ValueString(), state.Version.ValueString(), junSess)
-	junos.MutexUnlock()
+	junSess.ReadUnlock()
 	if err != nil {
 		resp.Diagnostics.AddError(tfdiag.ConfigReadErrSummary, err.Error())
 
@@ -1396,6 +1418,7 @@ func (rsc *forwardingoptionsDhcprelay) Read(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
 }
 
 func (rsc *forwardingoptionsDhcprelay) Update(
@@ -1437,6 +1460,10 @@ func (rsc *forwardingoptionsDhcprelay) Delete(
 func (rsc *forwardingoptionsDhcprelay) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	junSess, err := rsc.junosClient().StartNewSession(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
@@ -1496,6 +1523,7 @@ func (rsc *forwardingoptionsDhcprelay) ImportState(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
 }
 
 func (rscData *forwardingoptionsDhcprelayData) fillID() {
@@ -1516,7 +1544,7 @@ func (rscData *forwardingoptionsDhcprelayData) nullID() bool {
 }
 
 func (rscData *forwardingoptionsDhcprelayData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -1885,7 +1913,7 @@ func (rscData *forwardingoptionsDhcprelayData) set(
 		configSet = append(configSet, block.configSet(setPrefix)...)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (block *forwardingoptionsDhcprelayBlockActiveLeasequery) configSet(setPrefix string) []string {
@@ -1974,7 +2002,7 @@ func (block *forwardingoptionsDhcprelayBlockLeasequery) configSet(setPrefix stri
 }
 
 func (rscData *forwardingoptionsDhcprelayData) read( //nolint:gocognit
-	_ context.Context, routingInstance, version string, junSess *junos.Session,
+	ctx context.Context, routingInstance, version string, junSess *junos.Session,
 ) error {
 	showPrefix := junos.CmdShowConfig
 	if routingInstance != "" && routingInstance != junos.DefaultW {
@@ -1984,8 +2012,8 @@ func (rscData *forwardingoptionsDhcprelayData) read( //nolint:gocognit
 	if version == "v6" {
 		showSuffix = "dhcpv6"
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"forwarding-options dhcp-relay " + showSuffix + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"forwarding-options dhcp-relay "+showSuffix+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -2274,7 +2302,7 @@ func (block *forwardingoptionsDhcprelayBlockLeasequery) read(itemTrim string) (e
 }
 
 func (rscData *forwardingoptionsDhcprelayData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	delPrefix := junos.DeleteLS
 	if v := rscData.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
@@ -2323,5 +2351,5 @@ func (rscData *forwardingoptionsDhcprelayData) del(
 		delPrefix + "vendor-specific-information",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
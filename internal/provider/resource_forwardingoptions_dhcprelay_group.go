@@ -33,6 +33,7 @@ var (
 	_ resource.ResourceWithValidateConfig = &forwardingoptionsDhcprelayGroup{}
 	_ resource.ResourceWithImportState    = &forwardingoptionsDhcprelayGroup{}
 	_ resource.ResourceWithUpgradeState   = &forwardingoptionsDhcprelayGroup{}
+	_ resource.ResourceWithIdentity       = &forwardingoptionsDhcprelayGroup{}
 )
 
 type forwardingoptionsDhcprelayGroup struct {
@@ -539,6 +540,31 @@ func (rsc *forwardingoptionsDhcprelayGroup) Schema(
 }
 
 //nolint:lll
+func (rsc *forwardingoptionsDhcprelayGroup) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *forwardingoptionsDhcprelayGroup) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Group name.",
+		},
+		{
+			name:         "routing_instance",
+			description:  "Routing instance if not root level.",
+			defaultValue: junos.DefaultW,
+		},
+		{
+			name:         "version",
+			description:  "Version for DHCP or DHCPv6.",
+			defaultValue: "v4",
+		},
+	}
+}
+
 type forwardingoptionsDhcprelayGroupData struct {
 	ID                                   types.String                                          `tfsdk:"id"                                       tfdata:"skip_isempty"`
 	Name                                 types.String                                          `tfsdk:"name"                                     tfdata:"skip_isempty"`
@@ -1545,6 +1571,10 @@ func (rsc *forwardingoptionsDhcprelayGroup) Delete(
 func (rsc *forwardingoptionsDhcprelayGroup) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data forwardingoptionsDhcprelayGroupData
 
 	var _ resourceDataReadFrom3String = &data
@@ -1560,7 +1590,7 @@ func (rsc *forwardingoptionsDhcprelayGroup) ImportState(
 }
 
 func checkForwardingoptionsDhcprelayGroupExists(
-	_ context.Context, name, routingInstance, version string, junSess *junos.Session,
+	ctx context.Context, name, routingInstance, version string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
@@ -1572,8 +1602,8 @@ func checkForwardingoptionsDhcprelayGroupExists(
 	if version == "v6" {
 		showPrefix += "dhcpv6 "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"group " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"group "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -1604,7 +1634,7 @@ func (rscData *forwardingoptionsDhcprelayGroupData) nullID() bool {
 }
 
 func (rscData *forwardingoptionsDhcprelayGroupData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -1941,7 +1971,7 @@ func (rscData *forwardingoptionsDhcprelayGroupData) set(
 		configSet = append(configSet, block.configSet(setPrefix)...)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (block *forwardingoptionsDhcprelayGroupBlockInterface) configSet(
@@ -2058,7 +2088,7 @@ func (block *forwardingoptionsDhcprelayGroupBlockInterface) configSet(
 }
 
 func (rscData *forwardingoptionsDhcprelayGroupData) read(
-	_ context.Context, name, routingInstance, version string, junSess *junos.Session,
+	ctx context.Context, name, routingInstance, version string, junSess *junos.Session,
 ) error {
 	showPrefix := junos.CmdShowConfig
 	if routingInstance != "" && routingInstance != junos.DefaultW {
@@ -2068,8 +2098,8 @@ func (rscData *forwardingoptionsDhcprelayGroupData) read(
 	if version == "v6" {
 		showPrefix += "dhcpv6 "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"group " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"group "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -2333,7 +2363,7 @@ func (block *forwardingoptionsDhcprelayGroupBlockInterface) read(itemTrim, versi
 }
 
 func (rscData *forwardingoptionsDhcprelayGroupData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	delPrefix := junos.DeleteLS
 	if v := rscData.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
@@ -2348,5 +2378,5 @@ func (rscData *forwardingoptionsDhcprelayGroupData) del(
 		delPrefix + "group " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -26,6 +26,7 @@ var (
 	_ resource.Resource                = &forwardingoptionsDhcprelayServergroup{}
 	_ resource.ResourceWithConfigure   = &forwardingoptionsDhcprelayServergroup{}
 	_ resource.ResourceWithImportState = &forwardingoptionsDhcprelayServergroup{}
+	_ resource.ResourceWithIdentity    = &forwardingoptionsDhcprelayServergroup{}
 )
 
 type forwardingoptionsDhcprelayServergroup struct {
@@ -136,6 +137,31 @@ func (rsc *forwardingoptionsDhcprelayServergroup) Schema(
 	}
 }
 
+func (rsc *forwardingoptionsDhcprelayServergroup) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *forwardingoptionsDhcprelayServergroup) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Server group name.",
+		},
+		{
+			name:         "routing_instance",
+			description:  "Routing instance if not root level.",
+			defaultValue: junos.DefaultW,
+		},
+		{
+			name:         "version",
+			description:  "Version for DHCP or DHCPv6.",
+			defaultValue: "v4",
+		},
+	}
+}
+
 type forwardingoptionsDhcprelayServergroupData struct {
 	ID              types.String   `tfsdk:"id"`
 	Name            types.String   `tfsdk:"name"`
@@ -350,6 +376,10 @@ func (rsc *forwardingoptionsDhcprelayServergroup) Delete(
 func (rsc *forwardingoptionsDhcprelayServergroup) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data forwardingoptionsDhcprelayServergroupData
 
 	var _ resourceDataReadFrom3String = &data
@@ -365,7 +395,7 @@ func (rsc *forwardingoptionsDhcprelayServergroup) ImportState(
 }
 
 func checkForwardingoptionsDhcprelayServergroupExists(
-	_ context.Context, name, routingInstance, version string, junSess *junos.Session,
+	ctx context.Context, name, routingInstance, version string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
@@ -377,8 +407,8 @@ func checkForwardingoptionsDhcprelayServergroupExists(
 	if version == "v6" {
 		showPrefix += "dhcpv6 "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"server-group " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"server-group "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -409,7 +439,7 @@ func (rscData *forwardingoptionsDhcprelayServergroupData) nullID() bool {
 }
 
 func (rscData *forwardingoptionsDhcprelayServergroupData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -429,11 +459,11 @@ func (rscData *forwardingoptionsDhcprelayServergroupData) set(
 		configSet = append(configSet, setPrefix+v.ValueString())
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *forwardingoptionsDhcprelayServergroupData) read(
-	_ context.Context, name, routingInstance, version string, junSess *junos.Session,
+	ctx context.Context, name, routingInstance, version string, junSess *junos.Session,
 ) error {
 	showPrefix := junos.CmdShowConfig
 	if routingInstance != "" && routingInstance != junos.DefaultW {
@@ -443,8 +473,8 @@ func (rscData *forwardingoptionsDhcprelayServergroupData) read(
 	if version == "v6" {
 		showPrefix += "dhcpv6 "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"server-group " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"server-group "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -479,7 +509,7 @@ func (rscData *forwardingoptionsDhcprelayServergroupData) read(
 }
 
 func (rscData *forwardingoptionsDhcprelayServergroupData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	delPrefix := junos.DeleteLS
 	if v := rscData.RoutingInstance.ValueString(); v != junos.DefaultW {
@@ -494,5 +524,5 @@ func (rscData *forwardingoptionsDhcprelayServergroupData) del(
 		delPrefix + "server-group " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
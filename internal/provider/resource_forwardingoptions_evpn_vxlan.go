@@ -28,6 +28,7 @@ var (
 	_ resource.ResourceWithConfigure      = &forwardingoptionsEvpnVxlan{}
 	_ resource.ResourceWithValidateConfig = &forwardingoptionsEvpnVxlan{}
 	_ resource.ResourceWithImportState    = &forwardingoptionsEvpnVxlan{}
+	_ resource.ResourceWithIdentity       = &forwardingoptionsEvpnVxlan{}
 )
 
 type forwardingoptionsEvpnVxlan struct {
@@ -109,6 +110,22 @@ func (rsc *forwardingoptionsEvpnVxlan) Schema(
 	}
 }
 
+func (rsc *forwardingoptionsEvpnVxlan) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *forwardingoptionsEvpnVxlan) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:         "routing_instance",
+			description:  "Routing instance if not root level.",
+			defaultValue: junos.DefaultW,
+		},
+	}
+}
+
 type forwardingoptionsEvpnVxlanData struct {
 	ID              types.String `tfsdk:"id"               tfdata:"skip_isempty"`
 	RoutingInstance types.String `tfsdk:"routing_instance" tfdata:"skip_isempty"`
@@ -193,8 +210,8 @@ func (rsc *forwardingoptionsEvpnVxlan) Read(
 	}
 	defer junSess.Close()
 
-	junos.MutexLock()
-	defer junos.MutexUnlock()
+	junSess.ReadLock()
+	defer junSess.ReadUnlock()
 
 	if v := state.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
 		instanceExists, err := checkRoutingInstanceExists(ctx, v, junSess)
@@ -221,6 +238,7 @@ func (rsc *forwardingoptionsEvpnVxlan) Read(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
 }
 
 func (rsc *forwardingoptionsEvpnVxlan) Update(
@@ -262,6 +280,10 @@ func (rsc *forwardingoptionsEvpnVxlan) Delete(
 func (rsc *forwardingoptionsEvpnVxlan) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	junSess, err := rsc.client.StartNewSession(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
@@ -294,6 +316,7 @@ func (rsc *forwardingoptionsEvpnVxlan) ImportState(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
 }
 
 func (rscData *forwardingoptionsEvpnVxlanData) fillID() {
@@ -305,7 +328,7 @@ func (rscData *forwardingoptionsEvpnVxlanData) fillID() {
 }
 
 func (rscData *forwardingoptionsEvpnVxlanData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -325,18 +348,18 @@ func (rscData *forwardingoptionsEvpnVxlanData) set(
 		configSet = append(configSet, setPrefix+"shared-tunnels")
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *forwardingoptionsEvpnVxlanData) read(
-	_ context.Context, routingInstance string, junSess *junos.Session,
+	ctx context.Context, routingInstance string, junSess *junos.Session,
 ) error {
 	showPrefix := junos.CmdShowConfig
 	if routingInstance != "" && routingInstance != junos.DefaultW {
 		showPrefix += junos.RoutingInstancesWS + routingInstance + " "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"forwarding-options evpn-vxlan" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"forwarding-options evpn-vxlan"+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -365,7 +388,7 @@ func (rscData *forwardingoptionsEvpnVxlanData) read(
 }
 
 func (rscData *forwardingoptionsEvpnVxlanData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	delPrefix := junos.DeleteLS
 	if v := rscData.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
@@ -377,5 +400,5 @@ func (rscData *forwardingoptionsEvpnVxlanData) del(
 		delPrefix + "shared-tunnels",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -33,6 +33,7 @@ var (
 	_ resource.ResourceWithConfigure      = &forwardingoptionsSampling{}
 	_ resource.ResourceWithValidateConfig = &forwardingoptionsSampling{}
 	_ resource.ResourceWithImportState    = &forwardingoptionsSampling{}
+	_ resource.ResourceWithIdentity       = &forwardingoptionsSampling{}
 )
 
 type forwardingoptionsSampling struct {
@@ -182,6 +183,22 @@ func (rsc *forwardingoptionsSampling) Schema(
 	}
 }
 
+func (rsc *forwardingoptionsSampling) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *forwardingoptionsSampling) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:         "routing_instance",
+			description:  "Routing instance if not root level.",
+			defaultValue: junos.DefaultW,
+		},
+	}
+}
+
 type forwardingoptionsSamplingData struct {
 	ID                types.String                                     `tfsdk:"id"`
 	RoutingInstance   types.String                                     `tfsdk:"routing_instance"`
@@ -1149,8 +1166,8 @@ func (rsc *forwardingoptionsSampling) Read(
 	}
 	defer junSess.Close()
 
-	junos.MutexLock()
-	defer junos.MutexUnlock()
+	junSess.ReadLock()
+	defer junSess.ReadUnlock()
 
 	if v := state.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
 		instanceExists, err := checkRoutingInstanceExists(ctx, v, junSess)
@@ -1177,6 +1194,7 @@ func (rsc *forwardingoptionsSampling) Read(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
 }
 
 func (rsc *forwardingoptionsSampling) Update(
@@ -1218,6 +1236,10 @@ func (rsc *forwardingoptionsSampling) Delete(
 func (rsc *forwardingoptionsSampling) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	junSess, err := rsc.client.StartNewSession(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
@@ -1250,6 +1272,7 @@ func (rsc *forwardingoptionsSampling) ImportState(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
 }
 
 func (rscData *forwardingoptionsSamplingData) fillID() {
@@ -1261,7 +1284,7 @@ func (rscData *forwardingoptionsSamplingData) fillID() {
 }
 
 func (rscData *forwardingoptionsSamplingData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -1340,7 +1363,7 @@ func (rscData *forwardingoptionsSamplingData) set(
 		configSet = append(configSet, blockSet...)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (block *forwardingoptionsSamplingBlockInput) configSet(
@@ -1714,14 +1737,14 @@ func (block *forwardingoptionsSamplingBlockOutputBlockInterface) configSet(setPr
 }
 
 func (rscData *forwardingoptionsSamplingData) read(
-	_ context.Context, routingInstance string, junSess *junos.Session,
+	ctx context.Context, routingInstance string, junSess *junos.Session,
 ) error {
 	showPrefix := junos.CmdShowConfig
 	if routingInstance != "" && routingInstance != junos.DefaultW {
 		showPrefix += junos.RoutingInstancesWS + routingInstance + " "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"forwarding-options sampling" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"forwarding-options sampling"+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -2118,7 +2141,7 @@ func (block *forwardingoptionsSamplingBlockOutputBlockInterface) read(itemTrim s
 }
 
 func (rscData *forwardingoptionsSamplingData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	delPrefix := junos.DeleteLS
 	if v := rscData.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
@@ -2139,5 +2162,5 @@ func (rscData *forwardingoptionsSamplingData) del(
 		delPrefix + "input",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
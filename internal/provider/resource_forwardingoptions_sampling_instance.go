@@ -34,6 +34,7 @@ var (
 	_ resource.ResourceWithValidateConfig = &forwardingoptionsSamplingInstance{}
 	_ resource.ResourceWithImportState    = &forwardingoptionsSamplingInstance{}
 	_ resource.ResourceWithUpgradeState   = &forwardingoptionsSamplingInstance{}
+	_ resource.ResourceWithIdentity       = &forwardingoptionsSamplingInstance{}
 )
 
 type forwardingoptionsSamplingInstance struct {
@@ -181,6 +182,26 @@ func (rsc *forwardingoptionsSamplingInstance) Schema(
 	}
 }
 
+func (rsc *forwardingoptionsSamplingInstance) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *forwardingoptionsSamplingInstance) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Name for sampling instance.",
+		},
+		{
+			name:         "routing_instance",
+			description:  "Routing instance for sampling instance if not root level.",
+			defaultValue: junos.DefaultW,
+		},
+	}
+}
+
 type forwardingoptionsSamplingInstanceData struct {
 	ID                types.String                                             `tfsdk:"id"`
 	Name              types.String                                             `tfsdk:"name"`
@@ -1118,6 +1139,10 @@ func (rsc *forwardingoptionsSamplingInstance) Delete(
 func (rsc *forwardingoptionsSamplingInstance) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	junSess, err := rsc.client.StartNewSession(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
@@ -1152,10 +1177,11 @@ func (rsc *forwardingoptionsSamplingInstance) ImportState(
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
 }
 
 func checkForwardingoptionsSamplingInstanceExists(
-	_ context.Context, name, routingInstance string, junSess *junos.Session,
+	ctx context.Context, name, routingInstance string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
@@ -1163,8 +1189,8 @@ func checkForwardingoptionsSamplingInstanceExists(
 	if routingInstance != "" && routingInstance != junos.DefaultW {
 		showPrefix += junos.RoutingInstancesWS + routingInstance + " "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"forwarding-options sampling instance \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"forwarding-options sampling instance \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -1188,7 +1214,7 @@ func (rscData *forwardingoptionsSamplingInstanceData) nullID() bool {
 }
 
 func (rscData *forwardingoptionsSamplingInstanceData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -1261,7 +1287,7 @@ func (rscData *forwardingoptionsSamplingInstanceData) set(
 		configSet = append(configSet, blockSet...)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (block *forwardingoptionsSamplingInstanceBlockInput) configSet(
@@ -1622,14 +1648,14 @@ func (block *forwardingoptionsSamplingInstanceBlockOutputBlockInterface) configS
 }
 
 func (rscData *forwardingoptionsSamplingInstanceData) read(
-	_ context.Context, name, routingInstance string, junSess *junos.Session,
+	ctx context.Context, name, routingInstance string, junSess *junos.Session,
 ) error {
 	showPrefix := junos.CmdShowConfig
 	if routingInstance != "" && routingInstance != junos.DefaultW {
 		showPrefix += junos.RoutingInstancesWS + routingInstance + " "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"forwarding-options sampling instance \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"forwarding-options sampling instance \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -1995,7 +2021,7 @@ func (block *forwardingoptionsSamplingInstanceBlockOutputBlockInterface) read(it
 }
 
 func (rscData *forwardingoptionsSamplingInstanceData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	delPrefix := junos.DeleteLS
 	if v := rscData.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
@@ -2006,5 +2032,5 @@ func (rscData *forwardingoptionsSamplingInstanceData) del(
 		delPrefix + "forwarding-options sampling instance \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
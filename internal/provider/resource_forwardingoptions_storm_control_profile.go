@@ -30,6 +30,7 @@ var (
 	_ resource.ResourceWithConfigure      = &forwardingoptionsStormControlProfile{}
 	_ resource.ResourceWithValidateConfig = &forwardingoptionsStormControlProfile{}
 	_ resource.ResourceWithImportState    = &forwardingoptionsStormControlProfile{}
+	_ resource.ResourceWithIdentity       = &forwardingoptionsStormControlProfile{}
 )
 
 type forwardingoptionsStormControlProfile struct {
@@ -178,6 +179,21 @@ func (rsc *forwardingoptionsStormControlProfile) Schema(
 	}
 }
 
+func (rsc *forwardingoptionsStormControlProfile) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *forwardingoptionsStormControlProfile) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Storm control profile name.",
+		},
+	}
+}
+
 type forwardingoptionsStormControlProfileData struct {
 	ID             types.String                                  `tfsdk:"id"`
 	Name           types.String                                  `tfsdk:"name"`
@@ -376,6 +392,10 @@ func (rsc *forwardingoptionsStormControlProfile) Delete(
 func (rsc *forwardingoptionsStormControlProfile) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data forwardingoptionsStormControlProfileData
 
 	var _ resourceDataReadFrom1String = &data
@@ -390,12 +410,12 @@ func (rsc *forwardingoptionsStormControlProfile) ImportState(
 }
 
 func checkForwardingoptionsStormControlProfileExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"forwarding-options storm-control-profiles \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"forwarding-options storm-control-profiles \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -415,7 +435,7 @@ func (rscData *forwardingoptionsStormControlProfileData) nullID() bool {
 }
 
 func (rscData *forwardingoptionsStormControlProfileData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -457,14 +477,14 @@ func (rscData *forwardingoptionsStormControlProfileData) set(
 		}
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *forwardingoptionsStormControlProfileData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"forwarding-options storm-control-profiles \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"forwarding-options storm-control-profiles \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -516,11 +536,11 @@ func (rscData *forwardingoptionsStormControlProfileData) read(
 }
 
 func (rscData *forwardingoptionsStormControlProfileData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete forwarding-options storm-control-profiles \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -32,6 +32,7 @@ var (
 	_ resource.ResourceWithConfigure      = &generateRoute{}
 	_ resource.ResourceWithValidateConfig = &generateRoute{}
 	_ resource.ResourceWithImportState    = &generateRoute{}
+	_ resource.ResourceWithIdentity       = &generateRoute{}
 )
 
 type generateRoute struct {
@@ -238,6 +239,26 @@ func (rsc *generateRoute) Schema(
 	}
 }
 
+func (rsc *generateRoute) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *generateRoute) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "destination",
+			description: "Destination prefix.",
+		},
+		{
+			name:         "routing_instance",
+			description:  "Routing instance for generate route.",
+			defaultValue: junos.DefaultW,
+		},
+	}
+}
+
 type generateRouteData struct {
 	ID                       types.String   `tfsdk:"id"`
 	Destination              types.String   `tfsdk:"destination"`
@@ -496,6 +517,10 @@ func (rsc *generateRoute) Delete(
 func (rsc *generateRoute) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data generateRouteData
 
 	var _ resourceDataReadFrom2String = &data
@@ -511,7 +536,7 @@ func (rsc *generateRoute) ImportState(
 }
 
 func checkGenerateRouteExists(
-	_ context.Context, destination, routingInstance string, junSess *junos.Session,
+	ctx context.Context, destination, routingInstance string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
@@ -528,8 +553,8 @@ func checkGenerateRouteExists(
 			showPrefix += "rib " + routingInstance + ".inet6.0 "
 		}
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"generate route " + destination + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"generate route "+destination+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -553,7 +578,7 @@ func (rscData *generateRouteData) nullID() bool {
 }
 
 func (rscData *generateRouteData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -621,11 +646,11 @@ func (rscData *generateRouteData) set(
 			utils.ConvI64toa(rscData.Preference.ValueInt64()))
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *generateRouteData) read(
-	_ context.Context, destination, routingInstance string, junSess *junos.Session,
+	ctx context.Context, destination, routingInstance string, junSess *junos.Session,
 ) error {
 	showPrefix := junos.CmdShowConfig
 	switch routingInstance {
@@ -640,8 +665,8 @@ func (rscData *generateRouteData) read(
 			showPrefix += "rib " + routingInstance + ".inet6.0 "
 		}
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"generate route " + destination + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"generate route "+destination+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -709,7 +734,7 @@ func (rscData *generateRouteData) read(
 }
 
 func (rscData *generateRouteData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	delPrefix := junos.DeleteLS
 	switch routingInstance := rscData.RoutingInstance.ValueString(); routingInstance {
@@ -729,5 +754,5 @@ func (rscData *generateRouteData) del(
 		delPrefix + "generate route " + rscData.Destination.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
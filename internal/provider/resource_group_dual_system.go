@@ -34,6 +34,7 @@ var (
 	_ resource.ResourceWithValidateConfig = &groupDualSystem{}
 	_ resource.ResourceWithImportState    = &groupDualSystem{}
 	_ resource.ResourceWithUpgradeState   = &groupDualSystem{}
+	_ resource.ResourceWithIdentity       = &groupDualSystem{}
 )
 
 type groupDualSystem struct {
@@ -310,6 +311,21 @@ func (rsc *groupDualSystem) Schema(
 	}
 }
 
+func (rsc *groupDualSystem) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *groupDualSystem) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Name of group.",
+		},
+	}
+}
+
 type groupDualSystemData struct {
 	ID             types.String                        `tfsdk:"id"              tfdata:"skip_isempty"`
 	Name           types.String                        `tfsdk:"name"            tfdata:"skip_isempty"`
@@ -646,6 +662,10 @@ func (rsc *groupDualSystem) Delete(
 func (rsc *groupDualSystem) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data groupDualSystemData
 
 	if !slices.Contains([]string{"node0", "node1", "re0", "re1"}, req.ID) {
@@ -669,12 +689,12 @@ func (rsc *groupDualSystem) ImportState(
 }
 
 func checkGroupDualSystemExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"groups " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"groups "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -694,7 +714,7 @@ func (rscData *groupDualSystemData) nullID() bool {
 }
 
 func (rscData *groupDualSystemData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -756,7 +776,7 @@ func (rscData *groupDualSystemData) set(
 		configSet = append(configSet, rscData.System.configSet(setPrefix)...)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (block *groupDualSystemBlockInterfaceFXP0) configSet(
@@ -846,10 +866,10 @@ func (block *groupDualSystemBlockSystem) configSet(setPrefix string) []string {
 }
 
 func (rscData *groupDualSystemData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"groups " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"groups "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -904,8 +924,8 @@ func (rscData *groupDualSystemData) read(
 	}
 
 	rscData.ApplyGroups = types.BoolValue(false)
-	showConfigApplyGroups, err := junSess.Command(junos.CmdShowConfig +
-		"apply-groups" + junos.PipeDisplaySetRelative)
+	showConfigApplyGroups, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"apply-groups"+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -984,7 +1004,7 @@ func (block *groupDualSystemBlockSystem) read(itemTrim string) {
 }
 
 func (rscData *groupDualSystemData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	name := rscData.Name.ValueString()
 
@@ -999,5 +1019,5 @@ func (rscData *groupDualSystemData) del(
 		}
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
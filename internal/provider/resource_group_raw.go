@@ -27,6 +27,7 @@ var (
 	_ resource.ResourceWithConfigure      = &groupRaw{}
 	_ resource.ResourceWithValidateConfig = &groupRaw{}
 	_ resource.ResourceWithImportState    = &groupRaw{}
+	_ resource.ResourceWithIdentity       = &groupRaw{}
 )
 
 type groupRaw struct {
@@ -121,6 +122,26 @@ func (rsc *groupRaw) Schema(
 	}
 }
 
+func (rsc *groupRaw) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *groupRaw) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "The name of group.",
+		},
+		{
+			name:         "format",
+			description:  "The format used for the configuration data.",
+			defaultValue: "text",
+		},
+	}
+}
+
 type groupRawData struct {
 	ID     types.String `tfsdk:"id"`
 	Name   types.String `tfsdk:"name"`
@@ -184,7 +205,7 @@ func (rsc *groupRaw) Create(
 		return
 	}
 	defer func() {
-		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock())...)
+		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock(ctx))...)
 	}()
 
 	groupExists, err := checkGroupRawExists(ctx, plan.Name.ValueString(), junSess)
@@ -237,6 +258,7 @@ func (rsc *groupRaw) Create(
 	plan.fillID()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -288,7 +310,7 @@ func (rsc *groupRaw) Update(
 		return
 	}
 	defer func() {
-		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock())...)
+		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock(ctx))...)
 	}()
 
 	if err := state.del(ctx, junSess); err != nil {
@@ -314,6 +336,7 @@ func (rsc *groupRaw) Update(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -341,7 +364,7 @@ func (rsc *groupRaw) Delete(
 		return
 	}
 	defer func() {
-		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock())...)
+		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock(ctx))...)
 	}()
 
 	if err := state.del(ctx, junSess); err != nil {
@@ -361,6 +384,10 @@ func (rsc *groupRaw) Delete(
 func (rsc *groupRaw) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	junSess, err := rsc.junosClient().StartNewSession(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
@@ -392,15 +419,16 @@ func (rsc *groupRaw) ImportState(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
 }
 
 func checkGroupRawExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"groups \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"groups \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -420,7 +448,7 @@ func (rscData *groupRawData) nullID() bool {
 }
 
 func (rscData *groupRawData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -441,24 +469,24 @@ func (rscData *groupRawData) set(
 			)
 		}
 
-		return path.Empty(), junSess.ConfigLoad("set", "text", rawConfig.String())
+		return path.Empty(), junSess.ConfigLoad(ctx, "set", "text", rawConfig.String())
 	case "text":
 		fallthrough
 	default:
 		rawConfig := "groups {\n\"" + rscData.Name.ValueString() + "\" {\n" + rscData.Config.ValueString() + "}\n}\n"
 
 		// merge action as there is a delete of group before set when update
-		return path.Empty(), junSess.ConfigLoad("merge", "text", rawConfig)
+		return path.Empty(), junSess.ConfigLoad(ctx, "merge", "text", rawConfig)
 	}
 }
 
 func (rscData *groupRawData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
 	switch rscData.Format.ValueString() {
 	case "set":
-		showConfig, err := junSess.Command(junos.CmdShowConfig +
-			"groups \"" + name + "\"" + junos.PipeDisplaySetRelative)
+		showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+			"groups \""+name+"\""+junos.PipeDisplaySetRelative)
 		if err != nil {
 			return err
 		}
@@ -485,8 +513,8 @@ func (rscData *groupRawData) read(
 	case "text":
 		fallthrough
 	default:
-		showConfig, err := junSess.Command(junos.CmdShowConfig +
-			"groups \"" + name + "\"")
+		showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+			"groups \""+name+"\"")
 		if err != nil {
 			return err
 		}
@@ -516,11 +544,11 @@ func (rscData *groupRawData) read(
 }
 
 func (rscData *groupRawData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete groups \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -31,6 +31,7 @@ var (
 	_ resource.ResourceWithConfigure      = &iccpPeer{}
 	_ resource.ResourceWithValidateConfig = &iccpPeer{}
 	_ resource.ResourceWithImportState    = &iccpPeer{}
+	_ resource.ResourceWithIdentity       = &iccpPeer{}
 )
 
 type iccpPeer struct {
@@ -221,6 +222,21 @@ func (rsc *iccpPeer) Schema(
 	}
 }
 
+func (rsc *iccpPeer) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *iccpPeer) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "ip_address",
+			description: "IP address for this peer.",
+		},
+	}
+}
+
 type iccpPeerData struct {
 	ID                           types.String                          `tfsdk:"id"`
 	IPAddress                    types.String                          `tfsdk:"ip_address"`
@@ -411,6 +427,10 @@ func (rsc *iccpPeer) Delete(
 func (rsc *iccpPeer) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data iccpPeerData
 
 	var _ resourceDataReadFrom1String = &data
@@ -425,12 +445,12 @@ func (rsc *iccpPeer) ImportState(
 }
 
 func checkIccpPeerExists(
-	_ context.Context, ipAddress string, junSess *junos.Session,
+	ctx context.Context, ipAddress string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"protocols iccp peer " + ipAddress + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"protocols iccp peer "+ipAddress+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -450,7 +470,7 @@ func (rscData *iccpPeerData) nullID() bool {
 }
 
 func (rscData *iccpPeerData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -513,14 +533,14 @@ func (rscData *iccpPeerData) set(
 		}
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *iccpPeerData) read(
-	_ context.Context, ipAddress string, junSess *junos.Session,
+	ctx context.Context, ipAddress string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"protocols iccp peer " + ipAddress + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"protocols iccp peer "+ipAddress+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -594,11 +614,11 @@ func (rscData *iccpPeerData) read(
 }
 
 func (rscData *iccpPeerData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete protocols iccp peer " + rscData.IPAddress.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -32,6 +32,7 @@ var (
 	_ resource.ResourceWithConfigure      = &igmpSnoopingVlan{}
 	_ resource.ResourceWithValidateConfig = &igmpSnoopingVlan{}
 	_ resource.ResourceWithImportState    = &igmpSnoopingVlan{}
+	_ resource.ResourceWithIdentity       = &igmpSnoopingVlan{}
 )
 
 type igmpSnoopingVlan struct {
@@ -247,6 +248,26 @@ func (rsc *igmpSnoopingVlan) Schema(
 	}
 }
 
+func (rsc *igmpSnoopingVlan) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *igmpSnoopingVlan) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "VLAN name or `all`.",
+		},
+		{
+			name:         "routing_instance",
+			description:  "Routing instance for igmp-snooping protocol if not root level.",
+			defaultValue: junos.DefaultW,
+		},
+	}
+}
+
 type igmpSnoopingVlanData struct {
 	ID                      types.String                     `tfsdk:"id"`
 	Name                    types.String                     `tfsdk:"name"`
@@ -539,6 +560,10 @@ func (rsc *igmpSnoopingVlan) Delete(
 func (rsc *igmpSnoopingVlan) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data igmpSnoopingVlanData
 
 	var _ resourceDataReadFrom2String = &data
@@ -554,7 +579,7 @@ func (rsc *igmpSnoopingVlan) ImportState(
 }
 
 func checkIgmpSnoopingVlanExists(
-	_ context.Context, name, routingInstance string, junSess *junos.Session,
+	ctx context.Context, name, routingInstance string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
@@ -562,8 +587,8 @@ func checkIgmpSnoopingVlanExists(
 	if routingInstance != "" && routingInstance != junos.DefaultW {
 		showPrefix += junos.RoutingInstancesWS + routingInstance + " "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"protocols igmp-snooping vlan " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"protocols igmp-snooping vlan "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -587,7 +612,7 @@ func (rscData *igmpSnoopingVlanData) nullID() bool {
 }
 
 func (rscData *igmpSnoopingVlanData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -650,7 +675,7 @@ func (rscData *igmpSnoopingVlanData) set(
 		configSet = append(configSet, blockSet...)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (block *igmpSnoopingVlanBlockInterface) configSet(
@@ -700,14 +725,14 @@ func (block *igmpSnoopingVlanBlockInterface) configSet(
 }
 
 func (rscData *igmpSnoopingVlanData) read(
-	_ context.Context, name, routingInstance string, junSess *junos.Session,
+	ctx context.Context, name, routingInstance string, junSess *junos.Session,
 ) error {
 	showPrefix := junos.CmdShowConfig
 	if routingInstance != "" && routingInstance != junos.DefaultW {
 		showPrefix += junos.RoutingInstancesWS + routingInstance + " "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"protocols igmp-snooping vlan " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"protocols igmp-snooping vlan "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -795,7 +820,7 @@ func (block *igmpSnoopingVlanBlockInterface) read(itemTrim string) (err error) {
 }
 
 func (rscData *igmpSnoopingVlanData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	delPrefix := junos.DeleteLS
 	if v := rscData.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
@@ -806,5 +831,5 @@ func (rscData *igmpSnoopingVlanData) del(
 		delPrefix + "protocols igmp-snooping vlan " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -36,6 +36,7 @@ var (
 	_ resource.ResourceWithModifyPlan     = &interfaceLogical{}
 	_ resource.ResourceWithValidateConfig = &interfaceLogical{}
 	_ resource.ResourceWithImportState    = &interfaceLogical{}
+	_ resource.ResourceWithIdentity       = &interfaceLogical{}
 	_ resource.ResourceWithUpgradeState   = &interfaceLogical{}
 )
 
@@ -1112,6 +1113,21 @@ func (rsc *interfaceLogical) Schema(
 	}
 }
 
+func (rsc *interfaceLogical) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *interfaceLogical) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Name of logical interface (with dot).",
+		},
+	}
+}
+
 type interfaceLogicalData struct {
 	ID                       types.String                      `tfsdk:"id"`
 	Name                     types.String                      `tfsdk:"name"`
@@ -1893,10 +1909,26 @@ func (rsc *interfaceLogical) ModifyPlan(
 			plan.VlanID = types.Int64Null()
 		}
 	}
+	defaultResourceModifyPlanCheckReferences(ctx, rsc, &config, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
 }
 
+func (config *interfaceLogicalConfig) references(_ context.Context) []configReference {
+	references := newConfigReferences(path.Root("routing_instance"), referenceRoutingInstance,
+		config.RoutingInstance)
+	references = append(references, newConfigReferences(path.Root("security_zone"), referenceSecurityZone,
+		config.SecurityZone)...)
+	if !config.Name.IsUnknown() {
+		if physicalName, _, ok := strings.Cut(config.Name.ValueString(), "."); ok {
+			references = append(references, newConfigReferences(path.Root("name"), referenceInterface,
+				types.StringValue(physicalName))...)
+		}
+	}
+
+	return references
+}
+
 func (rsc *interfaceLogical) Create(
 	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse,
 ) {
@@ -1957,6 +1989,9 @@ func (rsc *interfaceLogical) Create(
 
 		plan.fillID()
 		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		defaultResourceWriteFakeSetFile(ctx, rsc, junSess, resp.State, "create", &resp.Diagnostics)
+		resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
+		resp.Diagnostics.Append(defaultResourceAddManaged(ctx, rsc, resp.State)...)
 
 		return
 	}
@@ -1974,7 +2009,7 @@ func (rsc *interfaceLogical) Create(
 		return
 	}
 	defer func() {
-		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock())...)
+		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock(ctx))...)
 	}()
 
 	ncInt, emptyInt, _, err := checkInterfaceLogicalNCEmpty(
@@ -2092,7 +2127,7 @@ func (rsc *interfaceLogical) Create(
 		return
 	}
 	if emptyInt && !setInt {
-		intExists, err := junSess.CheckInterfaceExists(plan.Name.ValueString())
+		intExists, err := junSess.CheckInterfaceExists(ctx, plan.Name.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(tfdiag.PostCheckErrSummary, err.Error())
 
@@ -2111,6 +2146,8 @@ func (rsc *interfaceLogical) Create(
 
 	plan.fillID()
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
+	resp.Diagnostics.Append(defaultResourceAddManaged(ctx, rsc, resp.State)...)
 }
 
 func (rsc *interfaceLogical) Read(
@@ -2130,8 +2167,8 @@ func (rsc *interfaceLogical) Read(
 	}
 	defer junSess.Close()
 
-	junos.MutexLock()
-	defer junos.MutexUnlock()
+	junSess.ReadLock()
+	defer junSess.ReadUnlock()
 
 	ncInt, emptyInt, setInt, err := checkInterfaceLogicalNCEmpty(
 		ctx,
@@ -2150,7 +2187,7 @@ func (rsc *interfaceLogical) Read(
 		return
 	}
 	if emptyInt && !setInt {
-		intExists, err := junSess.CheckInterfaceExists(state.Name.ValueString())
+		intExists, err := junSess.CheckInterfaceExists(ctx, state.Name.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(tfdiag.ConfigReadErrSummary, err.Error())
 
@@ -2172,6 +2209,8 @@ func (rsc *interfaceLogical) Read(
 	data.St0AlsoOnDestroy = state.St0AlsoOnDestroy
 	data.VlanNoCompute = state.VlanNoCompute
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
+	resp.Diagnostics.Append(defaultResourceAddManaged(ctx, rsc, resp.State)...)
 }
 
 func (rsc *interfaceLogical) Update(
@@ -2224,6 +2263,9 @@ func (rsc *interfaceLogical) Update(
 		}
 
 		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		defaultResourceWriteFakeSetFile(ctx, rsc, junSess, resp.State, "update", &resp.Diagnostics)
+		resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
+		resp.Diagnostics.Append(defaultResourceAddManaged(ctx, rsc, resp.State)...)
 
 		return
 	}
@@ -2241,7 +2283,7 @@ func (rsc *interfaceLogical) Update(
 		return
 	}
 	defer func() {
-		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock())...)
+		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock(ctx))...)
 	}()
 
 	if err := state.delOpts(ctx, junSess); err != nil {
@@ -2331,6 +2373,8 @@ func (rsc *interfaceLogical) Update(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
+	resp.Diagnostics.Append(defaultResourceAddManaged(ctx, rsc, resp.State)...)
 }
 
 func (rsc *interfaceLogical) Delete(
@@ -2353,6 +2397,10 @@ func (rsc *interfaceLogical) Delete(
 func (rsc *interfaceLogical) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	if strings.Count(req.ID, ".") != 1 {
 		resp.Diagnostics.AddError(
 			tfdiag.PreCheckErrSummary,
@@ -2390,7 +2438,7 @@ func (rsc *interfaceLogical) ImportState(
 		return
 	}
 	if emptyInt && !setInt {
-		intExists, err := junSess.CheckInterfaceExists(req.ID)
+		intExists, err := junSess.CheckInterfaceExists(ctx, req.ID)
 		if err != nil {
 			resp.Diagnostics.AddError("Interface Read Error", err.Error())
 
@@ -2422,12 +2470,18 @@ func (rsc *interfaceLogical) ImportState(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
+	resp.Diagnostics.Append(defaultResourceAddManaged(ctx, rsc, resp.State)...)
 }
 
 func (rscData *interfaceLogicalData) fillID() {
 	rscData.ID = types.StringValue(rscData.Name.ValueString())
 }
 
+func (rscData *interfaceLogicalData) nullID() bool {
+	return rscData.ID.IsNull()
+}
+
 func (rscCfg *interfaceLogicalConfig) computeVlanID() {
 	if !rscCfg.VlanID.IsUnknown() {
 		return
@@ -2470,15 +2524,15 @@ func (rscData *interfaceLogicalData) computeVlanID() {
 }
 
 func checkInterfaceLogicalNCEmpty(
-	_ context.Context, name, groupInterfaceDelete string, junSess *junos.Session,
+	ctx context.Context, name, groupInterfaceDelete string, junSess *junos.Session,
 ) (
 	ncInt, // interface is set with NC config
 	emtyInt, // interface is emty not set or just with set
 	justSet bool, // interface is empty with set
 	_ error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"interfaces " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"interfaces "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return false, false, false, err
 	}
@@ -2524,7 +2578,7 @@ func checkInterfaceLogicalNCEmpty(
 }
 
 func (rscData *interfaceLogicalData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -2728,7 +2782,7 @@ func (rscData *interfaceLogicalData) set(
 		}
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (block *interfaceLogicalBlockFamilyInetBlockAddress) configSet(
@@ -3104,10 +3158,10 @@ func (block *interfaceLogicalBlockFamilyInet6BlockDhcpV6Client) configSet(setPre
 }
 
 func (rscData *interfaceLogicalData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"interfaces " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"interfaces "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -3297,8 +3351,8 @@ func (rscData *interfaceLogicalData) read(
 			}
 		}
 	}
-	showConfigRoutingInstances, err := junSess.Command(junos.CmdShowConfig +
-		"routing-instances" + junos.PipeDisplaySetRelative)
+	showConfigRoutingInstances, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"routing-instances"+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -3319,8 +3373,8 @@ func (rscData *interfaceLogicalData) read(
 		}
 	}
 	if junSess.CheckCompatibilitySecurity() {
-		showConfigSecurityZones, err := junSess.Command(junos.CmdShowConfig +
-			"security zones" + junos.PipeDisplaySetRelative)
+		showConfigSecurityZones, err := junSess.Command(ctx, junos.CmdShowConfig+
+			"security zones"+junos.PipeDisplaySetRelative)
 		if err != nil {
 			return err
 		}
@@ -3330,7 +3384,7 @@ func (rscData *interfaceLogicalData) read(
 			if intMatch {
 				itemTrimFields := strings.Split(strings.TrimPrefix(item, "set security-zone "), " ")
 				rscData.SecurityZone = types.StringValue(itemTrimFields[0])
-				if err := rscData.readSecurityZoneInboundTraffic(name, junSess); err != nil {
+				if err := rscData.readSecurityZoneInboundTraffic(ctx, name, junSess); err != nil {
 					return err
 				}
 
@@ -3632,11 +3686,11 @@ func (block *interfaceLogicalBlockFamilyInet6BlockDhcpV6Client) read(itemTrim st
 }
 
 func (rscData *interfaceLogicalData) readSecurityZoneInboundTraffic(
-	name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security zones security-zone " + rscData.SecurityZone.ValueString() +
-		" interfaces " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security zones security-zone "+rscData.SecurityZone.ValueString()+
+		" interfaces "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -3675,7 +3729,7 @@ func (rscData *interfaceLogicalData) del(
 			"set interfaces "+rscData.Name.ValueString(),
 		)
 	}
-	if err := junSess.ConfigSet(configSet); err != nil {
+	if err := junSess.ConfigSet(ctx, configSet); err != nil {
 		return err
 	}
 	if v := rscData.RoutingInstance.ValueString(); v != "" {
@@ -3693,7 +3747,7 @@ func (rscData *interfaceLogicalData) del(
 }
 
 func (rscData *interfaceLogicalData) delOpts(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	delPrefix := "delete interfaces " + rscData.Name.ValueString() + " "
 
@@ -3710,27 +3764,27 @@ func (rscData *interfaceLogicalData) delOpts(
 		delPrefix + "vlan-id",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *interfaceLogicalData) delSecurityZone(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security zones security-zone " + rscData.SecurityZone.ValueString() +
 			" interfaces " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *interfaceLogicalData) delRoutingInstance(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		junos.DeleteLS + junos.RoutingInstancesWS + rscData.RoutingInstance.ValueString() +
 			" interface " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
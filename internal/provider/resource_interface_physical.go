@@ -32,8 +32,10 @@ import (
 var (
 	_ resource.Resource                   = &interfacePhysical{}
 	_ resource.ResourceWithConfigure      = &interfacePhysical{}
+	_ resource.ResourceWithModifyPlan     = &interfacePhysical{}
 	_ resource.ResourceWithValidateConfig = &interfacePhysical{}
 	_ resource.ResourceWithImportState    = &interfacePhysical{}
+	_ resource.ResourceWithIdentity       = &interfacePhysical{}
 	_ resource.ResourceWithUpgradeState   = &interfacePhysical{}
 )
 
@@ -53,6 +55,10 @@ func (rsc *interfacePhysical) junosName() string {
 	return "physical interface"
 }
 
+func (rsc *interfacePhysical) junosClient() *junos.Client {
+	return rsc.client
+}
+
 func (rsc *interfacePhysical) Metadata(
 	_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse,
 ) {
@@ -674,6 +680,21 @@ func (rsc *interfacePhysical) Schema(
 	}
 }
 
+func (rsc *interfacePhysical) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *interfacePhysical) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Name of physical interface (without dot).",
+		},
+	}
+}
+
 type interfacePhysicalData struct {
 	ID                     types.String                           `tfsdk:"id"`
 	Name                   types.String                           `tfsdk:"name"`
@@ -1195,6 +1216,12 @@ func (rsc *interfacePhysical) ValidateConfig(
 	}
 }
 
+func (rsc *interfacePhysical) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	defaultResourceModifyPlanAddPlanned(ctx, rsc, &interfacePhysicalData{}, req, resp)
+}
+
 func (rsc *interfacePhysical) Create(
 	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse,
 ) {
@@ -1247,6 +1274,9 @@ func (rsc *interfacePhysical) Create(
 
 		plan.fillID()
 		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		defaultResourceWriteFakeSetFile(ctx, rsc, junSess, resp.State, "create", &resp.Diagnostics)
+		resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
+		resp.Diagnostics.Append(defaultResourceAddManaged(ctx, rsc, resp.State)...)
 
 		return
 	}
@@ -1264,9 +1294,12 @@ func (rsc *interfacePhysical) Create(
 		return
 	}
 	defer func() {
-		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock())...)
+		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock(ctx))...)
 	}()
 
+	if !defaultResourceCheckCompatibility(&plan, junSess, &resp.Diagnostics) {
+		return
+	}
 	ncInt, emptyInt, err := checkInterfacePhysicalNCEmpty(
 		ctx,
 		plan.Name.ValueString(),
@@ -1337,7 +1370,7 @@ func (rsc *interfacePhysical) Create(
 		return
 	}
 	if emptyInt {
-		intExists, err := junSess.CheckInterfaceExists(plan.Name.ValueString())
+		intExists, err := junSess.CheckInterfaceExists(ctx, plan.Name.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(tfdiag.PostCheckErrSummary, err.Error())
 
@@ -1356,6 +1389,8 @@ func (rsc *interfacePhysical) Create(
 
 	plan.fillID()
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
+	resp.Diagnostics.Append(defaultResourceAddManaged(ctx, rsc, resp.State)...)
 }
 
 func (rsc *interfacePhysical) Read(
@@ -1374,8 +1409,8 @@ func (rsc *interfacePhysical) Read(
 	}
 	defer junSess.Close()
 
-	junos.MutexLock()
-	defer junos.MutexUnlock()
+	junSess.ReadLock()
+	defer junSess.ReadUnlock()
 
 	ncInt, emptyInt, err := checkInterfacePhysicalNCEmpty(
 		ctx,
@@ -1394,7 +1429,7 @@ func (rsc *interfacePhysical) Read(
 		return
 	}
 	if emptyInt {
-		intExists, err := junSess.CheckInterfaceExists(state.Name.ValueString())
+		intExists, err := junSess.CheckInterfaceExists(ctx, state.Name.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(tfdiag.ConfigReadErrSummary, err.Error())
 
@@ -1414,7 +1449,10 @@ func (rsc *interfacePhysical) Read(
 	}
 
 	data.NoDisableOnDestroy = state.NoDisableOnDestroy
+	data.keepSwitchingStyleAttributes(&state)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
+	resp.Diagnostics.Append(defaultResourceAddManaged(ctx, rsc, resp.State)...)
 }
 
 func (rsc *interfacePhysical) Update(
@@ -1457,6 +1495,9 @@ func (rsc *interfacePhysical) Update(
 		}
 
 		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		defaultResourceWriteFakeSetFile(ctx, rsc, junSess, resp.State, "update", &resp.Diagnostics)
+		resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
+		resp.Diagnostics.Append(defaultResourceAddManaged(ctx, rsc, resp.State)...)
 
 		return
 	}
@@ -1474,9 +1515,12 @@ func (rsc *interfacePhysical) Update(
 		return
 	}
 	defer func() {
-		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock())...)
+		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock(ctx))...)
 	}()
 
+	if !defaultResourceCheckCompatibility(&plan, junSess, &resp.Diagnostics) {
+		return
+	}
 	if err := state.delOpts(ctx, junSess); err != nil {
 		resp.Diagnostics.AddError(tfdiag.ConfigDelErrSummary, err.Error())
 
@@ -1506,6 +1550,8 @@ func (rsc *interfacePhysical) Update(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
+	resp.Diagnostics.Append(defaultResourceAddManaged(ctx, rsc, resp.State)...)
 }
 
 func (rsc *interfacePhysical) Delete(
@@ -1526,6 +1572,9 @@ func (rsc *interfacePhysical) Delete(
 			return
 		}
 
+		defaultResourceWriteFakeSetFile(ctx, rsc, junSess, resp.State, "delete", &resp.Diagnostics)
+		resp.Diagnostics.Append(defaultResourceRemoveManaged(ctx, rsc, resp.State)...)
+
 		return
 	}
 
@@ -1542,7 +1591,7 @@ func (rsc *interfacePhysical) Delete(
 		return
 	}
 	defer func() {
-		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock())...)
+		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock(ctx))...)
 	}()
 
 	if err := state.del(ctx, junSess); err != nil {
@@ -1557,9 +1606,10 @@ func (rsc *interfacePhysical) Delete(
 
 		return
 	}
+	resp.Diagnostics.Append(defaultResourceRemoveManaged(ctx, rsc, resp.State)...)
 
 	if !state.NoDisableOnDestroy.ValueBool() {
-		intExists, err := junSess.CheckInterfaceExists(state.Name.ValueString())
+		intExists, err := junSess.CheckInterfaceExists(ctx, state.Name.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError("Pre Disable Config Set Error", err.Error())
 		} else if intExists {
@@ -1587,6 +1637,10 @@ func (rsc *interfacePhysical) Delete(
 func (rsc *interfacePhysical) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	if strings.Count(req.ID, ".") != 0 {
 		resp.Diagnostics.AddError(
 			tfdiag.PreCheckErrSummary,
@@ -1624,7 +1678,7 @@ func (rsc *interfacePhysical) ImportState(
 		return
 	}
 	if emptyInt {
-		intExists, err := junSess.CheckInterfaceExists(req.ID)
+		intExists, err := junSess.CheckInterfaceExists(ctx, req.ID)
 		if err != nil {
 			resp.Diagnostics.AddError("Interface Read Error", err.Error())
 
@@ -1648,21 +1702,27 @@ func (rsc *interfacePhysical) ImportState(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
+	resp.Diagnostics.Append(defaultResourceAddManaged(ctx, rsc, resp.State)...)
 }
 
 func (rscData *interfacePhysicalData) fillID() {
 	rscData.ID = types.StringValue(rscData.Name.ValueString())
 }
 
+func (rscData *interfacePhysicalData) nullID() bool {
+	return rscData.ID.IsNull()
+}
+
 func checkInterfacePhysicalNCEmpty(
-	_ context.Context, name, groupInterfaceDelete string, junSess *junos.Session,
+	ctx context.Context, name, groupInterfaceDelete string, junSess *junos.Session,
 ) (
 	ncInt, // interface is set with NC config
 	emtyInt bool, // interface is not set (empty)
 	_ error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"interfaces " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"interfaces "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return false, false, err
 	}
@@ -1705,12 +1765,12 @@ func checkInterfacePhysicalNCEmpty(
 }
 
 func checkInterfacePhysicalContainsUnit(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"interfaces " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"interfaces "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return false, err
 	}
@@ -1759,10 +1819,10 @@ func (rscData *interfacePhysicalData) unsetAE(
 			return err
 		}
 		if aggregatedCount == "0" {
-			return junSess.ConfigSet([]string{"delete chassis aggregated-devices ethernet device-count"})
+			return junSess.ConfigSet(ctx, []string{"delete chassis aggregated-devices ethernet device-count"})
 		}
 
-		return junSess.ConfigSet([]string{"set chassis aggregated-devices ethernet device-count " + aggregatedCount})
+		return junSess.ConfigSet(ctx, []string{"set chassis aggregated-devices ethernet device-count " + aggregatedCount})
 	}
 
 	return nil
@@ -1917,7 +1977,12 @@ func (rscData *interfacePhysicalData) set(
 		configSet = append(configSet, setPrefix+"unit 0 family ethernet-switching storm-control \""+v+"\"")
 	}
 	if rscData.Trunk.ValueBool() {
-		configSet = append(configSet, setPrefix+"unit 0 family ethernet-switching interface-mode trunk")
+		// auto-select the syntax of non-ELS device
+		if junSess.CheckNonELS() {
+			configSet = append(configSet, setPrefix+"unit 0 family ethernet-switching port-mode trunk")
+		} else {
+			configSet = append(configSet, setPrefix+"unit 0 family ethernet-switching interface-mode trunk")
+		}
 	}
 	if rscData.TrunkNonELS.ValueBool() {
 		configSet = append(configSet, setPrefix+"unit 0 family ethernet-switching port-mode trunk")
@@ -1927,8 +1992,14 @@ func (rscData *interfacePhysicalData) set(
 			"unit 0 family ethernet-switching vlan members "+v.ValueString())
 	}
 	if !rscData.VlanNative.IsNull() {
-		configSet = append(configSet, setPrefix+"native-vlan-id "+
-			utils.ConvI64toa(rscData.VlanNative.ValueInt64()))
+		// auto-select the syntax of non-ELS device
+		if junSess.CheckNonELS() {
+			configSet = append(configSet, setPrefix+"unit 0 family ethernet-switching native-vlan-id "+
+				utils.ConvI64toa(rscData.VlanNative.ValueInt64()))
+		} else {
+			configSet = append(configSet, setPrefix+"native-vlan-id "+
+				utils.ConvI64toa(rscData.VlanNative.ValueInt64()))
+		}
 	}
 	if v := rscData.VlanNativeNonELS.ValueString(); v != "" {
 		configSet = append(configSet, setPrefix+"unit 0 family ethernet-switching native-vlan-id "+v)
@@ -1937,7 +2008,7 @@ func (rscData *interfacePhysicalData) set(
 		configSet = append(configSet, setPrefix+"vlan-tagging")
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (block *interfacePhysicalBlockESI) configSet(setPrefix string) []string {
@@ -2143,14 +2214,14 @@ func (block *interfacePhysicalBlockParentEtherOpts) configSet(
 }
 
 func addInterfaceNC(
-	_ context.Context, name, groupInterfaceDelete string, junSess *junos.Session,
+	ctx context.Context, name, groupInterfaceDelete string, junSess *junos.Session,
 ) (
 	err error,
 ) {
 	if groupInterfaceDelete == "" {
-		err = junSess.ConfigSet([]string{"set interfaces " + name + " disable description NC"})
+		err = junSess.ConfigSet(ctx, []string{"set interfaces " + name + " disable description NC"})
 	} else {
-		err = junSess.ConfigSet([]string{"set interfaces " + name + " apply-groups " + groupInterfaceDelete})
+		err = junSess.ConfigSet(ctx, []string{"set interfaces " + name + " apply-groups " + groupInterfaceDelete})
 	}
 	if err != nil {
 		return err
@@ -2160,10 +2231,10 @@ func addInterfaceNC(
 }
 
 func (rscData *interfacePhysicalData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"interfaces " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"interfaces "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -2436,7 +2507,7 @@ func (block *interfacePhysicalBlockParentEtherOpts) read(itemTrim string) (err e
 }
 
 func (rscData *interfacePhysicalData) delOpts(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	delPrefix := "delete interfaces " + rscData.Name.ValueString() + " "
 
@@ -2465,7 +2536,42 @@ func (rscData *interfacePhysicalData) delOpts(
 		delPrefix + "vlan-tagging",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
+}
+
+// keepSwitchingStyleAttributes moves the non-ELS options read on device
+// to the trunk and vlan_native attributes when they are used in state instead of the non-ELS attributes
+// (set with the syntax of non-ELS device).
+func (rscData *interfacePhysicalData) keepSwitchingStyleAttributes(state *interfacePhysicalData) {
+	if rscData.TrunkNonELS.ValueBool() && state.Trunk.ValueBool() && !state.TrunkNonELS.ValueBool() {
+		rscData.Trunk = types.BoolValue(true)
+		rscData.TrunkNonELS = types.BoolNull()
+	}
+	if !rscData.VlanNativeNonELS.IsNull() && !state.VlanNative.IsNull() && state.VlanNativeNonELS.IsNull() {
+		if vlanNative, err := tfdata.ConvAtoi64Value(rscData.VlanNativeNonELS.ValueString()); err == nil {
+			rscData.VlanNative = vlanNative
+			rscData.VlanNativeNonELS = types.StringNull()
+		}
+	}
+}
+
+func (rscData *interfacePhysicalData) compatibilityRequirements() []compatibilityRequirement {
+	requirements := make([]compatibilityRequirement, 0)
+	// trunk and vlan_native are set with the syntax of the switching style of device
+	if rscData.TrunkNonELS.ValueBool() {
+		requirements = append(requirements, compatibilityRequirement{
+			path:    path.Root("trunk_non_els"),
+			feature: junos.FeatureSwitchingNonELS,
+		})
+	}
+	if !rscData.VlanNativeNonELS.IsNull() {
+		requirements = append(requirements, compatibilityRequirement{
+			path:    path.Root("vlan_native_non_els"),
+			feature: junos.FeatureSwitchingNonELS,
+		})
+	}
+
+	return requirements
 }
 
 func (rscData *interfacePhysicalData) del(
@@ -2483,7 +2589,7 @@ func (rscData *interfacePhysicalData) del(
 		}
 	}
 
-	if err := junSess.ConfigSet([]string{
+	if err := junSess.ConfigSet(ctx, []string{
 		"delete interfaces " + rscData.Name.ValueString(),
 	}); err != nil {
 		return err
@@ -2498,12 +2604,12 @@ func (rscData *interfacePhysicalData) del(
 			return err
 		}
 		if aggregatedCount == "0" {
-			err = junSess.ConfigSet([]string{"delete chassis aggregated-devices ethernet device-count"})
+			err = junSess.ConfigSet(ctx, []string{"delete chassis aggregated-devices ethernet device-count"})
 			if err != nil {
 				return err
 			}
 		} else {
-			err = junSess.ConfigSet([]string{"set chassis aggregated-devices ethernet device-count " + aggregatedCount})
+			err = junSess.ConfigSet(ctx, []string{"set chassis aggregated-devices ethernet device-count " + aggregatedCount})
 			if err != nil {
 				return err
 			}
@@ -2538,12 +2644,12 @@ func (rscData *interfacePhysicalData) del(
 					return err
 				}
 				if aggregatedCount == "0" {
-					err = junSess.ConfigSet([]string{"delete chassis aggregated-devices ethernet device-count"})
+					err = junSess.ConfigSet(ctx, []string{"delete chassis aggregated-devices ethernet device-count"})
 					if err != nil {
 						return err
 					}
 				} else {
-					err = junSess.ConfigSet([]string{"set chassis aggregated-devices ethernet device-count " + aggregatedCount})
+					err = junSess.ConfigSet(ctx, []string{"set chassis aggregated-devices ethernet device-count " + aggregatedCount})
 					if err != nil {
 						return err
 					}
@@ -2556,7 +2662,7 @@ func (rscData *interfacePhysicalData) del(
 }
 
 func delInterfaceNC(
-	_ context.Context, name, groupInterfaceDelete string, junSess *junos.Session,
+	ctx context.Context, name, groupInterfaceDelete string, junSess *junos.Session,
 ) error {
 	delPrefix := "delete interfaces " + name + " "
 
@@ -2568,16 +2674,16 @@ func delInterfaceNC(
 		configSet = append(configSet, delPrefix+"apply-groups "+groupInterfaceDelete)
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
 
 func findInterfaceAggregatedLastChild(
-	_ context.Context, ae, interFace string, junSess *junos.Session,
+	ctx context.Context, ae, interFace string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"interfaces" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"interfaces"+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return false, err
 	}
@@ -2608,8 +2714,8 @@ func findInterfaceAggregatedCountMax(
 	if err != nil {
 		return "", fmt.Errorf("converting ae interaface '%v' to integer: %w", newAE, err)
 	}
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"interfaces" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"interfaces"+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return "", err
 	}
@@ -39,6 +39,10 @@ func (rsc *interfacePhysicalDisable) junosName() string {
 	return "not configured physical interface"
 }
 
+func (rsc *interfacePhysicalDisable) junosClient() *junos.Client {
+	return rsc.client
+}
+
 func (rsc *interfacePhysicalDisable) Metadata(
 	_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse,
 ) {
@@ -120,6 +124,7 @@ func (rsc *interfacePhysicalDisable) Create(
 
 		plan.fillID()
 		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		defaultResourceWriteFakeSetFile(ctx, rsc, junSess, resp.State, "create", &resp.Diagnostics)
 
 		return
 	}
@@ -137,7 +142,7 @@ func (rsc *interfacePhysicalDisable) Create(
 		return
 	}
 	defer func() {
-		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock())...)
+		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock(ctx))...)
 	}()
 
 	ncInt, emptyInt, err := checkInterfacePhysicalNCEmpty(
@@ -240,14 +245,14 @@ func (rsc *interfacePhysicalDisable) Read(
 	}
 	defer junSess.Close()
 
-	junos.MutexLock()
+	junSess.ReadLock()
 	ncInt, _, err := checkInterfacePhysicalNCEmpty(
 		ctx,
 		state.Name.ValueString(),
 		rsc.client.GroupInterfaceDelete(),
 		junSess,
 	)
-	junos.MutexUnlock()
+	junSess.ReadUnlock()
 	if err != nil {
 		resp.Diagnostics.AddError(tfdiag.ConfigReadErrSummary, err.Error())
 
@@ -41,6 +41,10 @@ func (rsc *interfaceSt0Unit) junosName() string {
 	return "st0 logical interface"
 }
 
+func (rsc *interfaceSt0Unit) junosClient() *junos.Client {
+	return rsc.client
+}
+
 func (rsc *interfaceSt0Unit) Metadata(
 	_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse,
 ) {
@@ -100,16 +104,16 @@ func (rsc *interfaceSt0Unit) Create(
 		return
 	}
 	defer func() {
-		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock())...)
+		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock(ctx))...)
 	}()
 
-	newSt0, err := rsc.searchNewAvailable(junSess)
+	newSt0, err := rsc.searchNewAvailable(ctx, junSess)
 	if err != nil {
 		resp.Diagnostics.AddError("Search Error", err.Error())
 
 		return
 	}
-	if err := junSess.ConfigSet([]string{
+	if err := junSess.ConfigSet(ctx, []string{
 		"set interfaces " + newSt0,
 	}); err != nil {
 		resp.Diagnostics.AddError(tfdiag.ConfigSetErrSummary, err.Error())
@@ -177,14 +181,14 @@ func (rsc *interfaceSt0Unit) Read(
 	}
 	defer junSess.Close()
 
-	junos.MutexLock()
+	junSess.ReadLock()
 	ncInt, emptyInt, setInt, err := checkInterfaceLogicalNCEmpty(
 		ctx,
 		state.ID.ValueString(),
 		rsc.client.GroupInterfaceDelete(),
 		junSess,
 	)
-	junos.MutexUnlock()
+	junSess.ReadUnlock()
 	if err != nil {
 		resp.Diagnostics.AddError(tfdiag.ConfigReadErrSummary, err.Error())
 
@@ -215,7 +219,7 @@ func (rsc *interfaceSt0Unit) Delete(
 	if rsc.client.FakeDeleteAlso() {
 		junSess := rsc.client.NewSessionWithoutNetconf(ctx)
 
-		if err := junSess.ConfigSet([]string{
+		if err := junSess.ConfigSet(ctx, []string{
 			"delete interfaces " + state.ID.ValueString(),
 		}); err != nil {
 			resp.Diagnostics.AddError(tfdiag.ConfigDelErrSummary, err.Error())
@@ -223,6 +227,8 @@ func (rsc *interfaceSt0Unit) Delete(
 			return
 		}
 
+		defaultResourceWriteFakeSetFile(ctx, rsc, junSess, resp.State, "delete", &resp.Diagnostics)
+
 		return
 	}
 
@@ -239,7 +245,7 @@ func (rsc *interfaceSt0Unit) Delete(
 		return
 	}
 	defer func() {
-		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock())...)
+		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock(ctx))...)
 	}()
 
 	ncInt, emptyInt, _, err := checkInterfaceLogicalNCEmpty(
@@ -261,7 +267,7 @@ func (rsc *interfaceSt0Unit) Delete(
 
 		return
 	}
-	if err := junSess.ConfigSet([]string{
+	if err := junSess.ConfigSet(ctx, []string{
 		"delete interfaces " + state.ID.ValueString(),
 	}); err != nil {
 		resp.Diagnostics.AddError(tfdiag.ConfigDelErrSummary, err.Error())
@@ -331,8 +337,8 @@ func (rsc *interfaceSt0Unit) ImportState(
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
-func (rsc *interfaceSt0Unit) searchNewAvailable(junSess *junos.Session) (string, error) {
-	st0, err := junSess.Command("show interfaces st0 terse")
+func (rsc *interfaceSt0Unit) searchNewAvailable(ctx context.Context, junSess *junos.Session) (string, error) {
+	st0, err := junSess.Command(ctx, "show interfaces st0 terse")
 	if err != nil {
 		return "", err
 	}
@@ -27,6 +27,7 @@ var (
 	_ resource.ResourceWithValidateConfig = &lldpInterface{}
 	_ resource.ResourceWithImportState    = &lldpInterface{}
 	_ resource.ResourceWithUpgradeState   = &lldpInterface{}
+	_ resource.ResourceWithIdentity       = &lldpInterface{}
 )
 
 type lldpInterface struct {
@@ -153,6 +154,21 @@ func (rsc *lldpInterface) Schema(
 	}
 }
 
+func (rsc *lldpInterface) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *lldpInterface) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Interface name or `all`.",
+		},
+	}
+}
+
 type lldpInterfaceData struct {
 	ID                      types.String                        `tfsdk:"id"`
 	Name                    types.String                        `tfsdk:"name"`
@@ -337,6 +353,10 @@ func (rsc *lldpInterface) Delete(
 func (rsc *lldpInterface) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data lldpInterfaceData
 
 	var _ resourceDataReadFrom1String = &data
@@ -351,12 +371,12 @@ func (rsc *lldpInterface) ImportState(
 }
 
 func checkLldpInterfaceExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"protocols lldp interface " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"protocols lldp interface "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -376,7 +396,7 @@ func (rscData *lldpInterfaceData) nullID() bool {
 }
 
 func (rscData *lldpInterfaceData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -409,14 +429,14 @@ func (rscData *lldpInterfaceData) set(
 		}
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *lldpInterfaceData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"protocols lldp interface " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"protocols lldp interface "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -459,11 +479,11 @@ func (rscData *lldpInterfaceData) read(
 }
 
 func (rscData *lldpInterfaceData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete protocols lldp interface " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -32,6 +32,7 @@ var (
 	_ resource.ResourceWithValidateConfig = &lldpMedInterface{}
 	_ resource.ResourceWithImportState    = &lldpMedInterface{}
 	_ resource.ResourceWithUpgradeState   = &lldpMedInterface{}
+	_ resource.ResourceWithIdentity       = &lldpMedInterface{}
 )
 
 type lldpMedInterface struct {
@@ -190,6 +191,21 @@ func (rsc *lldpMedInterface) Schema(
 	}
 }
 
+func (rsc *lldpMedInterface) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *lldpMedInterface) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Interface name or `all`.",
+		},
+	}
+}
+
 type lldpMedInterfaceData struct {
 	ID       types.String                   `tfsdk:"id"`
 	Name     types.String                   `tfsdk:"name"`
@@ -480,6 +496,10 @@ func (rsc *lldpMedInterface) Delete(
 func (rsc *lldpMedInterface) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data lldpMedInterfaceData
 
 	var _ resourceDataReadFrom1String = &data
@@ -494,12 +514,12 @@ func (rsc *lldpMedInterface) ImportState(
 }
 
 func checkLldpMedInterfaceExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"protocols lldp-med interface " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"protocols lldp-med interface "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -519,7 +539,7 @@ func (rscData *lldpMedInterfaceData) nullID() bool {
 }
 
 func (rscData *lldpMedInterfaceData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -542,7 +562,7 @@ func (rscData *lldpMedInterfaceData) set(
 		configSet = append(configSet, blockSet...)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (block *lldpMedInterfaceBlockLocation) configSet(
@@ -614,10 +634,10 @@ func (block *lldpMedInterfaceBlockLocation) configSet(
 }
 
 func (rscData *lldpMedInterfaceData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"protocols lldp-med interface " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"protocols lldp-med interface "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -686,11 +706,11 @@ func (block *lldpMedInterfaceBlockLocation) read(itemTrim string) (err error) {
 }
 
 func (rscData *lldpMedInterfaceData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete protocols lldp-med interface " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -31,6 +31,7 @@ var (
 	_ resource.ResourceWithConfigure      = &mstp{}
 	_ resource.ResourceWithValidateConfig = &mstp{}
 	_ resource.ResourceWithImportState    = &mstp{}
+	_ resource.ResourceWithIdentity       = &mstp{}
 )
 
 type mstp struct {
@@ -234,6 +235,22 @@ func (rsc *mstp) Schema(
 }
 
 //nolint:lll
+func (rsc *mstp) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *mstp) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:         "routing_instance",
+			description:  "Routing instance for mstp protocol if not root level.",
+			defaultValue: junos.DefaultW,
+		},
+	}
+}
+
 type mstpData struct {
 	ID                                           types.String        `tfsdk:"id"`
 	RoutingInstance                              types.String        `tfsdk:"routing_instance"`
@@ -370,17 +387,17 @@ func (rsc *mstp) Read(
 	}
 	defer junSess.Close()
 
-	junos.MutexLock()
+	junSess.ReadLock()
 	if v := state.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
 		instanceExists, err := checkRoutingInstanceExists(ctx, v, junSess)
 		if err != nil {
-			junos.MutexUnlock()
+			junSess.ReadUnlock()
 			resp.Diagnostics.AddError(tfdiag.ConfigReadErrSummary, err.Error())
 
 			return
 		}
 		if !instanceExists {
-			junos.MutexUnlock()
+			junSess.ReadUnlock()
 			resp.State.RemoveResource(ctx)
 
 			return
@@ -388,7 +405,7 @@ func (rsc *mstp) Read(
 	}
 
 	err = data.read(ctx, state.RoutingInstance.ValueString(), junSess)
-	junos.MutexUnlock()
+	junSess.ReadUnlock()
 	if err != nil {
 		resp.Diagnostics.AddError(tfdiag.ConfigReadErrSummary, err.Error())
 
@@ -402,6 +419,7 @@ func (rsc *mstp) Read(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
 }
 
 func (rsc *mstp) Update(
@@ -443,6 +461,10 @@ func (rsc *mstp) Delete(
 func (rsc *mstp) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	junSess, err := rsc.junosClient().StartNewSession(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
@@ -484,6 +506,7 @@ func (rsc *mstp) ImportState(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
 }
 
 func (rscData *mstpData) fillID() {
@@ -499,7 +522,7 @@ func (rscData *mstpData) nullID() bool {
 }
 
 func (rscData *mstpData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -573,18 +596,18 @@ func (rscData *mstpData) set(
 		}
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *mstpData) read(
-	_ context.Context, routingInstance string, junSess *junos.Session,
+	ctx context.Context, routingInstance string, junSess *junos.Session,
 ) error {
 	showPrefix := junos.CmdShowConfig
 	if routingInstance != "" && routingInstance != junos.DefaultW {
 		showPrefix += junos.RoutingInstancesWS + routingInstance + " "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"protocols mstp" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"protocols mstp"+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -673,7 +696,7 @@ func (rscData *mstpData) read(
 }
 
 func (rscData *mstpData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	delPrefix := junos.DeleteLS
 	if v := rscData.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
@@ -703,5 +726,5 @@ func (rscData *mstpData) del(
 		configSet = append(configSet, delPrefix+"system-id "+block.ID.ValueString())
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -30,6 +30,7 @@ var (
 	_ resource.ResourceWithConfigure      = &mstpInterface{}
 	_ resource.ResourceWithValidateConfig = &mstpInterface{}
 	_ resource.ResourceWithImportState    = &mstpInterface{}
+	_ resource.ResourceWithIdentity       = &mstpInterface{}
 )
 
 type mstpInterface struct {
@@ -173,6 +174,26 @@ func (rsc *mstpInterface) Schema(
 	}
 }
 
+func (rsc *mstpInterface) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *mstpInterface) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Interface name or `all`.",
+		},
+		{
+			name:         "routing_instance",
+			description:  "Routing instance for mstp protocol if not root level.",
+			defaultValue: junos.DefaultW,
+		},
+	}
+}
+
 type mstpInterfaceData struct {
 	ID                     types.String `tfsdk:"id"`
 	Name                   types.String `tfsdk:"name"`
@@ -372,6 +393,10 @@ func (rsc *mstpInterface) Delete(
 func (rsc *mstpInterface) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data mstpInterfaceData
 
 	var _ resourceDataReadFrom2String = &data
@@ -387,7 +412,7 @@ func (rsc *mstpInterface) ImportState(
 }
 
 func checkMstpInterfaceExists(
-	_ context.Context, name, routingInstance string, junSess *junos.Session,
+	ctx context.Context, name, routingInstance string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
@@ -395,8 +420,8 @@ func checkMstpInterfaceExists(
 	if routingInstance != "" && routingInstance != junos.DefaultW {
 		showPrefix += junos.RoutingInstancesWS + routingInstance + " "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"protocols mstp interface " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"protocols mstp interface "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -420,7 +445,7 @@ func (rscData *mstpInterfaceData) nullID() bool {
 }
 
 func (rscData *mstpInterfaceData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -460,18 +485,18 @@ func (rscData *mstpInterfaceData) set(
 			utils.ConvI64toa(rscData.Priority.ValueInt64()))
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *mstpInterfaceData) read(
-	_ context.Context, name, routingInstance string, junSess *junos.Session,
+	ctx context.Context, name, routingInstance string, junSess *junos.Session,
 ) error {
 	showPrefix := junos.CmdShowConfig
 	if routingInstance != "" && routingInstance != junos.DefaultW {
 		showPrefix += junos.RoutingInstancesWS + routingInstance + " "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"protocols mstp interface " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"protocols mstp interface "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -522,7 +547,7 @@ func (rscData *mstpInterfaceData) read(
 }
 
 func (rscData *mstpInterfaceData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	delPrefix := junos.DeleteLS
 	if v := rscData.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
@@ -533,5 +558,5 @@ func (rscData *mstpInterfaceData) del(
 		delPrefix + "protocols mstp interface " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -33,6 +33,7 @@ var (
 	_ resource.ResourceWithConfigure      = &mstpMsti{}
 	_ resource.ResourceWithValidateConfig = &mstpMsti{}
 	_ resource.ResourceWithImportState    = &mstpMsti{}
+	_ resource.ResourceWithIdentity       = &mstpMsti{}
 )
 
 type mstpMsti struct {
@@ -185,6 +186,26 @@ func (rsc *mstpMsti) Schema(
 	}
 }
 
+func (rsc *mstpMsti) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *mstpMsti) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "msti_id",
+			description: "MSTI identifier.",
+		},
+		{
+			name:         "routing_instance",
+			description:  "Routing instance for mstp protocol if not root level.",
+			defaultValue: junos.DefaultW,
+		},
+	}
+}
+
 type mstpMstiData struct {
 	ID                   types.String             `tfsdk:"id"`
 	MstiID               types.Int64              `tfsdk:"msti_id"`
@@ -435,6 +456,10 @@ func (rsc *mstpMsti) Delete(
 func (rsc *mstpMsti) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data mstpMstiData
 
 	var _ resourceDataReadFrom1Int1String = &data
@@ -450,7 +475,7 @@ func (rsc *mstpMsti) ImportState(
 }
 
 func checkMstpMstiExists(
-	_ context.Context, mstiID int64, routingInstance string, junSess *junos.Session,
+	ctx context.Context, mstiID int64, routingInstance string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
@@ -458,8 +483,8 @@ func checkMstpMstiExists(
 	if routingInstance != "" && routingInstance != junos.DefaultW {
 		showPrefix += junos.RoutingInstancesWS + routingInstance + " "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"protocols mstp msti " + utils.ConvI64toa(mstiID) + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"protocols mstp msti "+utils.ConvI64toa(mstiID)+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -483,7 +508,7 @@ func (rscData *mstpMstiData) nullID() bool {
 }
 
 func (rscData *mstpMstiData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -530,18 +555,18 @@ func (rscData *mstpMstiData) set(
 		}
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *mstpMstiData) read(
-	_ context.Context, mstiID int64, routingInstance string, junSess *junos.Session,
+	ctx context.Context, mstiID int64, routingInstance string, junSess *junos.Session,
 ) error {
 	showPrefix := junos.CmdShowConfig
 	if routingInstance != "" && routingInstance != junos.DefaultW {
 		showPrefix += junos.RoutingInstancesWS + routingInstance + " "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"protocols mstp msti " + utils.ConvI64toa(mstiID) + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"protocols mstp msti "+utils.ConvI64toa(mstiID)+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -595,7 +620,7 @@ func (rscData *mstpMstiData) read(
 }
 
 func (rscData *mstpMstiData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	delPrefix := junos.DeleteLS
 	if v := rscData.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
@@ -606,5 +631,5 @@ func (rscData *mstpMstiData) del(
 		delPrefix + "protocols mstp msti " + utils.ConvI64toa(rscData.MstiID.ValueInt64()),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
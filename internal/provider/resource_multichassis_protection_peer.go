@@ -27,6 +27,7 @@ var (
 	_ resource.Resource                = &multichassisProtectionPeer{}
 	_ resource.ResourceWithConfigure   = &multichassisProtectionPeer{}
 	_ resource.ResourceWithImportState = &multichassisProtectionPeer{}
+	_ resource.ResourceWithIdentity    = &multichassisProtectionPeer{}
 )
 
 type multichassisProtectionPeer struct {
@@ -113,6 +114,21 @@ func (rsc *multichassisProtectionPeer) Schema(
 	}
 }
 
+func (rsc *multichassisProtectionPeer) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *multichassisProtectionPeer) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "ip_address",
+			description: "IP address for this peer.",
+		},
+	}
+}
+
 type multichassisProtectionPeerData struct {
 	ID           types.String `tfsdk:"id"`
 	IPAddress    types.String `tfsdk:"ip_address"`
@@ -243,6 +259,10 @@ func (rsc *multichassisProtectionPeer) Delete(
 func (rsc *multichassisProtectionPeer) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data multichassisProtectionPeerData
 
 	var _ resourceDataReadFrom1String = &data
@@ -257,12 +277,12 @@ func (rsc *multichassisProtectionPeer) ImportState(
 }
 
 func checkMultichassisProtectionPeerExists(
-	_ context.Context, ipAddress string, junSess *junos.Session,
+	ctx context.Context, ipAddress string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"multi-chassis multi-chassis-protection " + ipAddress + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"multi-chassis multi-chassis-protection "+ipAddress+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -282,7 +302,7 @@ func (rscData *multichassisProtectionPeerData) nullID() bool {
 }
 
 func (rscData *multichassisProtectionPeerData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -297,14 +317,14 @@ func (rscData *multichassisProtectionPeerData) set(
 			utils.ConvI64toa(rscData.IclDownDelay.ValueInt64()))
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *multichassisProtectionPeerData) read(
-	_ context.Context, ipAddress string, junSess *junos.Session,
+	ctx context.Context, ipAddress string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"multi-chassis multi-chassis-protection " + ipAddress + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"multi-chassis multi-chassis-protection "+ipAddress+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -335,11 +355,11 @@ func (rscData *multichassisProtectionPeerData) read(
 }
 
 func (rscData *multichassisProtectionPeerData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete multi-chassis multi-chassis-protection " + rscData.IPAddress.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
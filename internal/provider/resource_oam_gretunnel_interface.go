@@ -29,6 +29,7 @@ var (
 	_ resource.ResourceWithConfigure      = &oamGretunnelInterface{}
 	_ resource.ResourceWithValidateConfig = &oamGretunnelInterface{}
 	_ resource.ResourceWithImportState    = &oamGretunnelInterface{}
+	_ resource.ResourceWithIdentity       = &oamGretunnelInterface{}
 )
 
 type oamGretunnelInterface struct {
@@ -118,6 +119,21 @@ func (rsc *oamGretunnelInterface) Schema(
 	}
 }
 
+func (rsc *oamGretunnelInterface) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *oamGretunnelInterface) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Name of interface.",
+		},
+	}
+}
+
 type oamGretunnelInterfaceData struct {
 	ID            types.String `tfsdk:"id"`
 	Name          types.String `tfsdk:"name"`
@@ -269,6 +285,10 @@ func (rsc *oamGretunnelInterface) Delete(
 func (rsc *oamGretunnelInterface) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data oamGretunnelInterfaceData
 
 	var _ resourceDataReadFrom1String = &data
@@ -283,12 +303,12 @@ func (rsc *oamGretunnelInterface) ImportState(
 }
 
 func checkOamGretunnelInterfaceExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"protocols oam gre-tunnel interface " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"protocols oam gre-tunnel interface "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -308,7 +328,7 @@ func (rscData *oamGretunnelInterfaceData) nullID() bool {
 }
 
 func (rscData *oamGretunnelInterfaceData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -326,14 +346,14 @@ func (rscData *oamGretunnelInterfaceData) set(
 			utils.ConvI64toa(rscData.KeepaliveTime.ValueInt64()))
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *oamGretunnelInterfaceData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"protocols oam gre-tunnel interface " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"protocols oam gre-tunnel interface "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -367,11 +387,11 @@ func (rscData *oamGretunnelInterfaceData) read(
 }
 
 func (rscData *oamGretunnelInterfaceData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete protocols oam gre-tunnel interface " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -35,6 +35,7 @@ var (
 	_ resource.ResourceWithValidateConfig = &ospf{}
 	_ resource.ResourceWithImportState    = &ospf{}
 	_ resource.ResourceWithUpgradeState   = &ospf{}
+	_ resource.ResourceWithIdentity       = &ospf{}
 )
 
 type ospf struct {
@@ -426,6 +427,26 @@ func (rsc *ospf) Schema(
 	}
 }
 
+func (rsc *ospf) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *ospf) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "version",
+			description: "Version of ospf.",
+		},
+		{
+			name:         "routing_instance",
+			description:  "Routing instance for ospf protocol if not root level.",
+			defaultValue: junos.DefaultW,
+		},
+	}
+}
+
 type ospfData struct {
 	ID                           types.String                 `tfsdk:"id"`
 	Version                      types.String                 `tfsdk:"version"`
@@ -655,17 +676,17 @@ func (rsc *ospf) Read(
 	}
 	defer junSess.Close()
 
-	junos.MutexLock()
+	junSess.ReadLock()
 	if v := state.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
 		instanceExists, err := checkRoutingInstanceExists(ctx, v, junSess)
 		if err != nil {
-			junos.MutexUnlock()
+			junSess.ReadUnlock()
 			resp.Diagnostics.AddError(tfdiag.ConfigReadErrSummary, err.Error())
 
 			return
 		}
 		if !instanceExists {
-			junos.MutexUnlock()
+			junSess.ReadUnlock()
 			resp.State.RemoveResource(ctx)
 
 			return
@@ -673,7 +694,7 @@ func (rsc *ospf) Read(
 	}
 
 	err = data.read(ctx, state.Version.ValueString(), state.RoutingInstance.ValueString(), junSess)
-	junos.MutexUnlock()
+	junSess.ReadUnlock()
 	if err != nil {
 		resp.Diagnostics.AddError(tfdiag.ConfigReadErrSummary, err.Error())
 
@@ -687,6 +708,7 @@ func (rsc *ospf) Read(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
 }
 
 func (rsc *ospf) Update(
@@ -728,6 +750,10 @@ func (rsc *ospf) Delete(
 func (rsc *ospf) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	junSess, err := rsc.junosClient().StartNewSession(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
@@ -787,6 +813,7 @@ func (rsc *ospf) ImportState(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
 }
 
 func (rscData *ospfData) fillID() {
@@ -802,7 +829,7 @@ func (rscData *ospfData) nullID() bool {
 }
 
 func (rscData *ospfData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -906,7 +933,7 @@ func (rscData *ospfData) set(
 		configSet = append(configSet, rscData.SpfOptions.configSet(setPrefix)...)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (block *ospfBlockDatabaseProtection) configSet(setPrefix string) []string {
@@ -1024,7 +1051,7 @@ func (block *ospfBlockSpfOptions) configSet(setPrefix string) []string {
 }
 
 func (rscData *ospfData) read(
-	_ context.Context, version, routingInstance string, junSess *junos.Session,
+	ctx context.Context, version, routingInstance string, junSess *junos.Session,
 ) error {
 	ospfVersion := junos.OspfV2
 	if version == "v3" {
@@ -1034,8 +1061,8 @@ func (rscData *ospfData) read(
 	if routingInstance != "" && routingInstance != junos.DefaultW {
 		showPrefix += junos.RoutingInstancesWS + routingInstance + " "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"protocols " + ospfVersion + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"protocols "+ospfVersion+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -1252,7 +1279,7 @@ func (block *ospfBlockSpfOptions) read(itemTrim string) (err error) {
 }
 
 func (rscData *ospfData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	ospfVersion := junos.OspfV2
 	if rscData.Version.ValueString() == "v3" {
@@ -1286,5 +1313,5 @@ func (rscData *ospfData) del(
 		delPrefix + "spf-options",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
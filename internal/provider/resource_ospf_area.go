@@ -36,6 +36,7 @@ var (
 	_ resource.ResourceWithValidateConfig = &ospfArea{}
 	_ resource.ResourceWithImportState    = &ospfArea{}
 	_ resource.ResourceWithUpgradeState   = &ospfArea{}
+	_ resource.ResourceWithIdentity       = &ospfArea{}
 )
 
 type ospfArea struct {
@@ -895,6 +896,35 @@ func (rsc *ospfArea) Schema(
 	}
 }
 
+func (rsc *ospfArea) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *ospfArea) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "area_id",
+			description: "Area ID.",
+		},
+		{
+			name:        "version",
+			description: "Version of ospf.",
+		},
+		{
+			name:          "realm",
+			description:   "OSPFv3 realm configuration.",
+			omitEmptyInID: true,
+		},
+		{
+			name:         "routing_instance",
+			description:  "Routing instance for ospf area.",
+			defaultValue: junos.DefaultW,
+		},
+	}
+}
+
 type ospfAreaData struct {
 	ID                               types.String               `tfsdk:"id"`
 	AreaID                           types.String               `tfsdk:"area_id"`
@@ -1652,6 +1682,10 @@ func (rsc *ospfArea) Delete(
 func (rsc *ospfArea) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	junSess, err := rsc.client.StartNewSession(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
@@ -1696,10 +1730,11 @@ func (rsc *ospfArea) ImportState(
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
 }
 
 func checkOspfAreaExists(
-	_ context.Context, areaID, version, realm, routingInstance string, junSess *junos.Session,
+	ctx context.Context, areaID, version, realm, routingInstance string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
@@ -1718,8 +1753,8 @@ func checkOspfAreaExists(
 	if realm != "" {
 		showPrefix += "realm " + realm + " "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"area " + areaID + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"area "+areaID+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -1756,7 +1791,7 @@ func (rscData *ospfAreaData) nullID() bool {
 }
 
 func (rscData *ospfAreaData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -1857,7 +1892,7 @@ func (rscData *ospfAreaData) set(
 		configSet = append(configSet, block.configSet(setPrefix)...)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (block *ospfAreaBlockInterface) configSet(
@@ -2241,7 +2276,7 @@ func (block *ospfAreaBlockVirtualLink) configSet(setPrefix string) []string {
 }
 
 func (rscData *ospfAreaData) read(
-	_ context.Context, areaID, version, realm, routingInstance string, junSess *junos.Session,
+	ctx context.Context, areaID, version, realm, routingInstance string, junSess *junos.Session,
 ) error {
 	showPrefix := junos.CmdShowConfig
 	if routingInstance != "" && routingInstance != junos.DefaultW {
@@ -2258,8 +2293,8 @@ func (rscData *ospfAreaData) read(
 	if realm != "" {
 		showPrefix += "realm " + realm + " "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"area " + areaID + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"area "+areaID+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -2671,7 +2706,7 @@ func (block *ospfAreaBlockNssa) read(itemTrim string) (err error) {
 }
 
 func (rscData *ospfAreaData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	delPrefix := junos.DeleteLS
 	if v := rscData.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
@@ -2693,5 +2728,5 @@ func (rscData *ospfAreaData) del(
 		delPrefix + "area " + rscData.AreaID.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -24,6 +24,7 @@ var (
 	_ resource.ResourceWithConfigure      = &policyoptionsASPath{}
 	_ resource.ResourceWithValidateConfig = &policyoptionsASPath{}
 	_ resource.ResourceWithImportState    = &policyoptionsASPath{}
+	_ resource.ResourceWithIdentity       = &policyoptionsASPath{}
 )
 
 type policyoptionsASPath struct {
@@ -111,6 +112,21 @@ func (rsc *policyoptionsASPath) Schema(
 	}
 }
 
+func (rsc *policyoptionsASPath) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *policyoptionsASPath) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Name to identify AS path regular expression.",
+		},
+	}
+}
+
 type policyoptionsASPathData struct {
 	ID        types.String `tfsdk:"id"`
 	Name      types.String `tfsdk:"name"`
@@ -260,6 +276,10 @@ func (rsc *policyoptionsASPath) Delete(
 func (rsc *policyoptionsASPath) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data policyoptionsASPathData
 
 	var _ resourceDataReadFrom1String = &data
@@ -274,12 +294,12 @@ func (rsc *policyoptionsASPath) ImportState(
 }
 
 func checkPolicyoptionsAsPathExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"policy-options as-path \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"policy-options as-path \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -299,7 +319,7 @@ func (rscData *policyoptionsASPathData) nullID() bool {
 }
 
 func (rscData *policyoptionsASPathData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -313,14 +333,14 @@ func (rscData *policyoptionsASPathData) set(
 		configSet = append(configSet, setPrefix+"\""+v+"\"")
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *policyoptionsASPathData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"policy-options as-path \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"policy-options as-path \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -348,11 +368,11 @@ func (rscData *policyoptionsASPathData) read(
 }
 
 func (rscData *policyoptionsASPathData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete policy-options as-path \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
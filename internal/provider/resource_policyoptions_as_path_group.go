@@ -27,6 +27,7 @@ var (
 	_ resource.ResourceWithConfigure      = &policyoptionsASPathGroup{}
 	_ resource.ResourceWithValidateConfig = &policyoptionsASPathGroup{}
 	_ resource.ResourceWithImportState    = &policyoptionsASPathGroup{}
+	_ resource.ResourceWithIdentity       = &policyoptionsASPathGroup{}
 )
 
 type policyoptionsASPathGroup struct {
@@ -131,6 +132,21 @@ func (rsc *policyoptionsASPathGroup) Schema(
 	}
 }
 
+func (rsc *policyoptionsASPathGroup) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *policyoptionsASPathGroup) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Name to identify AS path group.",
+		},
+	}
+}
+
 type policyoptionsASPathGroupData struct {
 	ID        types.String                          `tfsdk:"id"`
 	Name      types.String                          `tfsdk:"name"`
@@ -317,6 +333,10 @@ func (rsc *policyoptionsASPathGroup) Delete(
 func (rsc *policyoptionsASPathGroup) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data policyoptionsASPathGroupData
 
 	var _ resourceDataReadFrom1String = &data
@@ -331,12 +351,12 @@ func (rsc *policyoptionsASPathGroup) ImportState(
 }
 
 func checkPolicyoptionsAsPathGroupExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"policy-options as-path-group \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"policy-options as-path-group \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -356,7 +376,7 @@ func (rscData *policyoptionsASPathGroupData) nullID() bool {
 }
 
 func (rscData *policyoptionsASPathGroupData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -380,14 +400,14 @@ func (rscData *policyoptionsASPathGroupData) set(
 		configSet = append(configSet, setPrefix+"dynamic-db")
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *policyoptionsASPathGroupData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"policy-options as-path-group \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"policy-options as-path-group \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -419,11 +439,11 @@ func (rscData *policyoptionsASPathGroupData) read(
 }
 
 func (rscData *policyoptionsASPathGroupData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete policy-options as-path-group \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
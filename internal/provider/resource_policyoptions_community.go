@@ -26,6 +26,7 @@ var (
 	_ resource.ResourceWithConfigure      = &policyoptionsCommunity{}
 	_ resource.ResourceWithValidateConfig = &policyoptionsCommunity{}
 	_ resource.ResourceWithImportState    = &policyoptionsCommunity{}
+	_ resource.ResourceWithIdentity       = &policyoptionsCommunity{}
 )
 
 type policyoptionsCommunity struct {
@@ -125,6 +126,21 @@ func (rsc *policyoptionsCommunity) Schema(
 	}
 }
 
+func (rsc *policyoptionsCommunity) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *policyoptionsCommunity) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Name to identify BGP community.",
+		},
+	}
+}
+
 type policyoptionsCommunityData struct {
 	ID          types.String   `tfsdk:"id"`
 	Name        types.String   `tfsdk:"name"`
@@ -291,6 +307,10 @@ func (rsc *policyoptionsCommunity) Delete(
 func (rsc *policyoptionsCommunity) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data policyoptionsCommunityData
 
 	var _ resourceDataReadFrom1String = &data
@@ -305,12 +325,12 @@ func (rsc *policyoptionsCommunity) ImportState(
 }
 
 func checkPolicyoptionsCommunityExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"policy-options community \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"policy-options community \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -330,7 +350,7 @@ func (rscData *policyoptionsCommunityData) nullID() bool {
 }
 
 func (rscData *policyoptionsCommunityData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -347,14 +367,14 @@ func (rscData *policyoptionsCommunityData) set(
 		configSet = append(configSet, setPrefix+"invert-match")
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *policyoptionsCommunityData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"policy-options community \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"policy-options community \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -385,11 +405,11 @@ func (rscData *policyoptionsCommunityData) read(
 }
 
 func (rscData *policyoptionsCommunityData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete policy-options community \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
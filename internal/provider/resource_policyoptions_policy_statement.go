@@ -32,9 +32,11 @@ import (
 var (
 	_ resource.Resource                   = &policyoptionsPolicyStatement{}
 	_ resource.ResourceWithConfigure      = &policyoptionsPolicyStatement{}
+	_ resource.ResourceWithModifyPlan     = &policyoptionsPolicyStatement{}
 	_ resource.ResourceWithValidateConfig = &policyoptionsPolicyStatement{}
 	_ resource.ResourceWithImportState    = &policyoptionsPolicyStatement{}
 	_ resource.ResourceWithUpgradeState   = &policyoptionsPolicyStatement{}
+	_ resource.ResourceWithIdentity       = &policyoptionsPolicyStatement{}
 )
 
 type policyoptionsPolicyStatement struct {
@@ -187,6 +189,21 @@ func (rsc *policyoptionsPolicyStatement) Schema(
 	}
 }
 
+func (rsc *policyoptionsPolicyStatement) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *policyoptionsPolicyStatement) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Name to identify the policy.",
+		},
+	}
+}
+
 type policyoptionsPolicyStatementData struct {
 	ID                           types.String                            `tfsdk:"id"`
 	Name                         types.String                            `tfsdk:"name"`
@@ -1678,6 +1695,12 @@ func (rsc *policyoptionsPolicyStatement) ValidateConfig(
 	}
 }
 
+func (rsc *policyoptionsPolicyStatement) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	defaultResourceModifyPlanAddPlanned(ctx, rsc, &policyoptionsPolicyStatementData{}, req, resp)
+}
+
 func (rsc *policyoptionsPolicyStatement) Create(
 	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse,
 ) {
@@ -1805,6 +1828,10 @@ func (rsc *policyoptionsPolicyStatement) Delete(
 func (rsc *policyoptionsPolicyStatement) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data policyoptionsPolicyStatementData
 
 	var _ resourceDataReadFrom1String = &data
@@ -1822,12 +1849,12 @@ func (rsc *policyoptionsPolicyStatement) ImportState(
 }
 
 func checkPolicyoptionsPolicyStatementExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"policy-options policy-statement \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"policy-options policy-statement \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -1847,7 +1874,7 @@ func (rscData *policyoptionsPolicyStatementData) nullID() bool {
 }
 
 func (rscData *policyoptionsPolicyStatementData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -1936,7 +1963,7 @@ func (rscData *policyoptionsPolicyStatementData) set(
 		)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (block *policyoptionsPolicyStatementBlockFrom) configSet(
@@ -2255,10 +2282,10 @@ func (block *policyoptionsPolicyStatementBlockThen) configSet(
 }
 
 func (rscData *policyoptionsPolicyStatementData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"policy-options policy-statement \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"policy-options policy-statement \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -2330,8 +2357,8 @@ func (rscData *policyoptionsPolicyStatementData) read(
 		}
 	}
 
-	showConfigForwardingTableExport, err := junSess.Command(junos.CmdShowConfig +
-		junos.RoutingOptionsWS + "forwarding-table export" + junos.PipeDisplaySetRelative)
+	showConfigForwardingTableExport, err := junSess.Command(ctx, junos.CmdShowConfig+
+		junos.RoutingOptionsWS+"forwarding-table export"+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -2644,7 +2671,7 @@ func (block *policyoptionsPolicyStatementBlockThen) read(itemTrim string) (err e
 }
 
 func (rscData *policyoptionsPolicyStatementData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete policy-options policy-statement \"" + rscData.Name.ValueString() + "\"",
@@ -2655,5 +2682,5 @@ func (rscData *policyoptionsPolicyStatementData) del(
 		)
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
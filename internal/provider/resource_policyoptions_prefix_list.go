@@ -26,6 +26,7 @@ var (
 	_ resource.Resource                = &policyoptionsPrefixList{}
 	_ resource.ResourceWithConfigure   = &policyoptionsPrefixList{}
 	_ resource.ResourceWithImportState = &policyoptionsPrefixList{}
+	_ resource.ResourceWithIdentity    = &policyoptionsPrefixList{}
 )
 
 type policyoptionsPrefixList struct {
@@ -125,6 +126,21 @@ func (rsc *policyoptionsPrefixList) Schema(
 	}
 }
 
+func (rsc *policyoptionsPrefixList) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *policyoptionsPrefixList) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Prefix list name.",
+		},
+	}
+}
+
 type policyoptionsPrefixListData struct {
 	ID        types.String   `tfsdk:"id"`
 	Name      types.String   `tfsdk:"name"`
@@ -256,6 +272,10 @@ func (rsc *policyoptionsPrefixList) Delete(
 func (rsc *policyoptionsPrefixList) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data policyoptionsPrefixListData
 
 	var _ resourceDataReadFrom1String = &data
@@ -270,12 +290,12 @@ func (rsc *policyoptionsPrefixList) ImportState(
 }
 
 func checkPolicyoptionsPrefixListExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"policy-options prefix-list \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"policy-options prefix-list \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -295,7 +315,7 @@ func (rscData *policyoptionsPrefixListData) nullID() bool {
 }
 
 func (rscData *policyoptionsPrefixListData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -313,14 +333,14 @@ func (rscData *policyoptionsPrefixListData) set(
 		configSet = append(configSet, setPrefix+v.ValueString())
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *policyoptionsPrefixListData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"policy-options prefix-list \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"policy-options prefix-list \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -350,11 +370,11 @@ func (rscData *policyoptionsPrefixListData) read(
 }
 
 func (rscData *policyoptionsPrefixListData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete policy-options prefix-list \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -29,6 +29,7 @@ var (
 	_ resource.ResourceWithConfigure      = &ribGroup{}
 	_ resource.ResourceWithValidateConfig = &ribGroup{}
 	_ resource.ResourceWithImportState    = &ribGroup{}
+	_ resource.ResourceWithIdentity       = &ribGroup{}
 )
 
 type ribGroup struct {
@@ -141,6 +142,21 @@ func (rsc *ribGroup) Schema(
 	}
 }
 
+func (rsc *ribGroup) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *ribGroup) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "The name of rib group.",
+		},
+	}
+}
+
 type ribGroupData struct {
 	ID           types.String   `tfsdk:"id"            tfdata:"skip_isempty"`
 	Name         types.String   `tfsdk:"name"          tfdata:"skip_isempty"`
@@ -306,6 +322,10 @@ func (rsc *ribGroup) Delete(
 func (rsc *ribGroup) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data ribGroupData
 
 	var _ resourceDataReadFrom1String = &data
@@ -320,12 +340,12 @@ func (rsc *ribGroup) ImportState(
 }
 
 func checkRibGroupExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		junos.RoutingOptionsWS + "rib-groups \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		junos.RoutingOptionsWS+"rib-groups \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -345,7 +365,7 @@ func (rscData *ribGroupData) nullID() bool {
 }
 
 func (rscData *ribGroupData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -367,14 +387,14 @@ func (rscData *ribGroupData) set(
 		configSet = append(configSet, setPrefix+"export-rib "+v)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *ribGroupData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		junos.RoutingOptionsWS + "rib-groups \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		junos.RoutingOptionsWS+"rib-groups \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -406,11 +426,11 @@ func (rscData *ribGroupData) read(
 }
 
 func (rscData *ribGroupData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete routing-options rib-groups \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -29,8 +29,10 @@ import (
 var (
 	_ resource.Resource                   = &routingInstance{}
 	_ resource.ResourceWithConfigure      = &routingInstance{}
+	_ resource.ResourceWithModifyPlan     = &routingInstance{}
 	_ resource.ResourceWithValidateConfig = &routingInstance{}
 	_ resource.ResourceWithImportState    = &routingInstance{}
+	_ resource.ResourceWithIdentity       = &routingInstance{}
 )
 
 type routingInstance struct {
@@ -289,6 +291,21 @@ func (rsc *routingInstance) Schema(
 	}
 }
 
+func (rsc *routingInstance) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *routingInstance) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "The name of routing instance.",
+		},
+	}
+}
+
 type routingInstanceData struct {
 	ID                      types.String   `tfsdk:"id"`
 	Name                    types.String   `tfsdk:"name"`
@@ -377,6 +394,12 @@ func (rsc *routingInstance) ValidateConfig(
 	}
 }
 
+func (rsc *routingInstance) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	defaultResourceModifyPlanAddPlanned(ctx, rsc, &routingInstanceData{}, req, resp)
+}
+
 func (rsc *routingInstance) Create(
 	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse,
 ) {
@@ -525,6 +548,10 @@ func (rsc *routingInstance) Delete(
 func (rsc *routingInstance) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data routingInstanceData
 
 	var _ resourceDataReadFrom1String = &data
@@ -539,12 +566,12 @@ func (rsc *routingInstance) ImportState(
 }
 
 func checkRoutingInstanceExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		junos.RoutingInstancesWS + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		junos.RoutingInstancesWS+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -564,7 +591,7 @@ func (rscData *routingInstanceData) nullID() bool {
 }
 
 func (rscData *routingInstanceData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -629,14 +656,14 @@ func (rscData *routingInstanceData) set(
 		configSet = append(configSet, setPrefix+"vtep-source-interface "+v)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *routingInstanceData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		junos.RoutingInstancesWS + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		junos.RoutingInstancesWS+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -699,7 +726,7 @@ func (rscData *routingInstanceData) read(
 }
 
 func (rscData *routingInstanceData) delOpts(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	delPrefix := junos.DeleteLS + junos.RoutingInstancesWS + rscData.Name.ValueString() + " "
 
@@ -725,15 +752,15 @@ func (rscData *routingInstanceData) delOpts(
 		)
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *routingInstanceData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		junos.DeleteLS + junos.RoutingInstancesWS + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -32,6 +32,7 @@ var (
 	_ resource.ResourceWithConfigure      = &rstp{}
 	_ resource.ResourceWithValidateConfig = &rstp{}
 	_ resource.ResourceWithImportState    = &rstp{}
+	_ resource.ResourceWithIdentity       = &rstp{}
 )
 
 type rstp struct {
@@ -227,6 +228,22 @@ func (rsc *rstp) Schema(
 }
 
 //nolint:lll
+func (rsc *rstp) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *rstp) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:         "routing_instance",
+			description:  "Routing instance for rstp protocol if not root level.",
+			defaultValue: junos.DefaultW,
+		},
+	}
+}
+
 type rstpData struct {
 	ID                                           types.String        `tfsdk:"id"`
 	RoutingInstance                              types.String        `tfsdk:"routing_instance"`
@@ -414,17 +431,17 @@ func (rsc *rstp) Read(
 	}
 	defer junSess.Close()
 
-	junos.MutexLock()
+	junSess.ReadLock()
 	if v := state.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
 		instanceExists, err := checkRoutingInstanceExists(ctx, v, junSess)
 		if err != nil {
-			junos.MutexUnlock()
+			junSess.ReadUnlock()
 			resp.Diagnostics.AddError(tfdiag.ConfigReadErrSummary, err.Error())
 
 			return
 		}
 		if !instanceExists {
-			junos.MutexUnlock()
+			junSess.ReadUnlock()
 			resp.State.RemoveResource(ctx)
 
 			return
@@ -432,7 +449,7 @@ func (rsc *rstp) Read(
 	}
 
 	err = data.read(ctx, state.RoutingInstance.ValueString(), junSess)
-	junos.MutexUnlock()
+	junSess.ReadUnlock()
 	if err != nil {
 		resp.Diagnostics.AddError(tfdiag.ConfigReadErrSummary, err.Error())
 
@@ -446,6 +463,7 @@ func (rsc *rstp) Read(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
 }
 
 func (rsc *rstp) Update(
@@ -487,6 +505,10 @@ func (rsc *rstp) Delete(
 func (rsc *rstp) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	junSess, err := rsc.junosClient().StartNewSession(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
@@ -529,6 +551,7 @@ func (rsc *rstp) ImportState(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
 }
 
 func (rscData *rstpData) fillID() {
@@ -544,7 +567,7 @@ func (rscData *rstpData) nullID() bool {
 }
 
 func (rscData *rstpData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -614,18 +637,18 @@ func (rscData *rstpData) set(
 		}
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *rstpData) read(
-	_ context.Context, routingInstance string, junSess *junos.Session,
+	ctx context.Context, routingInstance string, junSess *junos.Session,
 ) error {
 	showPrefix := junos.CmdShowConfig
 	if routingInstance != "" && routingInstance != junos.DefaultW {
 		showPrefix += junos.RoutingInstancesWS + routingInstance + " "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"protocols rstp" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"protocols rstp"+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -709,7 +732,7 @@ func (rscData *rstpData) read(
 }
 
 func (rscData *rstpData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	delPrefix := junos.DeleteLS
 	if v := rscData.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
@@ -738,5 +761,5 @@ func (rscData *rstpData) del(
 		configSet = append(configSet, delPrefix+"system-id "+block.ID.ValueString())
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
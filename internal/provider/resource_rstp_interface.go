@@ -30,6 +30,7 @@ var (
 	_ resource.ResourceWithConfigure      = &rstpInterface{}
 	_ resource.ResourceWithValidateConfig = &rstpInterface{}
 	_ resource.ResourceWithImportState    = &rstpInterface{}
+	_ resource.ResourceWithIdentity       = &rstpInterface{}
 )
 
 type rstpInterface struct {
@@ -173,6 +174,26 @@ func (rsc *rstpInterface) Schema(
 	}
 }
 
+func (rsc *rstpInterface) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *rstpInterface) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Interface name or `all`.",
+		},
+		{
+			name:         "routing_instance",
+			description:  "Routing instance for rstp protocol if not root level.",
+			defaultValue: junos.DefaultW,
+		},
+	}
+}
+
 type rstpInterfaceData struct {
 	ID                     types.String `tfsdk:"id"`
 	Name                   types.String `tfsdk:"name"`
@@ -372,6 +393,10 @@ func (rsc *rstpInterface) Delete(
 func (rsc *rstpInterface) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data rstpInterfaceData
 
 	var _ resourceDataReadFrom2String = &data
@@ -387,7 +412,7 @@ func (rsc *rstpInterface) ImportState(
 }
 
 func checkRstpInterfaceExists(
-	_ context.Context, name, routingInstance string, junSess *junos.Session,
+	ctx context.Context, name, routingInstance string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
@@ -395,8 +420,8 @@ func checkRstpInterfaceExists(
 	if routingInstance != "" && routingInstance != junos.DefaultW {
 		showPrefix += junos.RoutingInstancesWS + routingInstance + " "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"protocols rstp interface " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"protocols rstp interface "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -420,7 +445,7 @@ func (rscData *rstpInterfaceData) nullID() bool {
 }
 
 func (rscData *rstpInterfaceData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -460,18 +485,18 @@ func (rscData *rstpInterfaceData) set(
 			utils.ConvI64toa(rscData.Priority.ValueInt64()))
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *rstpInterfaceData) read(
-	_ context.Context, name, routingInstance string, junSess *junos.Session,
+	ctx context.Context, name, routingInstance string, junSess *junos.Session,
 ) error {
 	showPrefix := junos.CmdShowConfig
 	if routingInstance != "" && routingInstance != junos.DefaultW {
 		showPrefix += junos.RoutingInstancesWS + routingInstance + " "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"protocols rstp interface " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"protocols rstp interface "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -522,7 +547,7 @@ func (rscData *rstpInterfaceData) read(
 }
 
 func (rscData *rstpInterfaceData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	delPrefix := junos.DeleteLS
 	if v := rscData.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
@@ -533,5 +558,5 @@ func (rscData *rstpInterfaceData) del(
 		delPrefix + "protocols rstp interface " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -29,8 +29,10 @@ import (
 var (
 	_ resource.Resource                   = &securityAddressBook{}
 	_ resource.ResourceWithConfigure      = &securityAddressBook{}
+	_ resource.ResourceWithModifyPlan     = &securityAddressBook{}
 	_ resource.ResourceWithValidateConfig = &securityAddressBook{}
 	_ resource.ResourceWithImportState    = &securityAddressBook{}
+	_ resource.ResourceWithIdentity       = &securityAddressBook{}
 )
 
 type securityAddressBook struct {
@@ -116,6 +118,21 @@ func (rsc *securityAddressBook) Schema(
 	}
 }
 
+func (rsc *securityAddressBook) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityAddressBook) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "The name of address book.",
+		},
+	}
+}
+
 type securityAddressBookData struct {
 	ID              types.String                              `tfsdk:"id"`
 	Name            types.String                              `tfsdk:"name"`
@@ -580,6 +597,12 @@ func (rsc *securityAddressBook) ValidateConfig(
 	}
 }
 
+func (rsc *securityAddressBook) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	defaultResourceModifyPlanAddPlanned(ctx, rsc, &securityAddressBookData{}, req, resp)
+}
+
 func (rsc *securityAddressBook) Create(
 	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse,
 ) {
@@ -711,6 +734,10 @@ func (rsc *securityAddressBook) Delete(
 func (rsc *securityAddressBook) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityAddressBookData
 
 	var _ resourceDataReadFrom1String = &data
@@ -725,12 +752,12 @@ func (rsc *securityAddressBook) ImportState(
 }
 
 func checkSecurityAddressBookExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security address-book \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security address-book \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -750,7 +777,7 @@ func (rscData *securityAddressBookData) nullID() bool {
 }
 
 func (rscData *securityAddressBookData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -854,14 +881,14 @@ func (rscData *securityAddressBookData) set(
 		}
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *securityAddressBookData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security address-book \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security address-book \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -973,11 +1000,11 @@ func (rscData *securityAddressBookData) read(
 }
 
 func (rscData *securityAddressBookData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security address-book \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -19,6 +19,7 @@ var (
 	_ resource.ResourceWithConfigure      = &securityAddressBookOrdered{}
 	_ resource.ResourceWithValidateConfig = &securityAddressBookOrdered{}
 	_ resource.ResourceWithImportState    = &securityAddressBookOrdered{}
+	_ resource.ResourceWithIdentity       = &securityAddressBookOrdered{}
 )
 
 type securityAddressBookOrdered struct {
@@ -104,6 +105,16 @@ func (rsc *securityAddressBookOrdered) Schema(
 	}
 }
 
+func (rsc *securityAddressBookOrdered) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityAddressBookOrdered) identityAttributes() []resourceIdentityAttribute {
+	return (&securityAddressBook{}).identityAttributes()
+}
+
 type securityAddressBookOrderedConfig struct {
 	ID              types.String `tfsdk:"id"`
 	Name            types.String `tfsdk:"name"`
@@ -419,6 +430,10 @@ func (rsc *securityAddressBookOrdered) Delete(
 func (rsc *securityAddressBookOrdered) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityAddressBookData
 
 	var _ resourceDataReadFrom1String = &data
@@ -31,6 +31,7 @@ var (
 	_ resource.ResourceWithConfigure      = &securityAuthenticationKeyChain{}
 	_ resource.ResourceWithValidateConfig = &securityAuthenticationKeyChain{}
 	_ resource.ResourceWithImportState    = &securityAuthenticationKeyChain{}
+	_ resource.ResourceWithIdentity       = &securityAuthenticationKeyChain{}
 )
 
 type securityAuthenticationKeyChain struct {
@@ -210,6 +211,21 @@ func (rsc *securityAuthenticationKeyChain) Schema(
 	}
 }
 
+func (rsc *securityAuthenticationKeyChain) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityAuthenticationKeyChain) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Name of authentication key chain.",
+		},
+	}
+}
+
 type securityAuthenticationKeyChainData struct {
 	ID          types.String                             `tfsdk:"id"`
 	Name        types.String                             `tfsdk:"name"`
@@ -400,6 +416,10 @@ func (rsc *securityAuthenticationKeyChain) Delete(
 func (rsc *securityAuthenticationKeyChain) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityAuthenticationKeyChainData
 
 	var _ resourceDataReadFrom1String = &data
@@ -414,12 +434,12 @@ func (rsc *securityAuthenticationKeyChain) ImportState(
 }
 
 func checkSecurityAuthenticationKeyChainExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security authentication-key-chains key-chain \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security authentication-key-chains key-chain \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -439,7 +459,7 @@ func (rscData *securityAuthenticationKeyChainData) nullID() bool {
 }
 
 func (rscData *securityAuthenticationKeyChainData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -465,7 +485,7 @@ func (rscData *securityAuthenticationKeyChainData) set(
 		configSet = append(configSet, block.configSet(setPrefix)...)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (block *securityAuthenticationKeyChainBlockKey) configSet(setPrefix string) []string {
@@ -503,10 +523,10 @@ func (block *securityAuthenticationKeyChainBlockKey) configSet(setPrefix string)
 }
 
 func (rscData *securityAuthenticationKeyChainData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security authentication-key-chains key-chain \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security authentication-key-chains key-chain \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -587,11 +607,11 @@ func (block *securityAuthenticationKeyChainBlockKey) read(
 }
 
 func (rscData *securityAuthenticationKeyChainData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security authentication-key-chains key-chain \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
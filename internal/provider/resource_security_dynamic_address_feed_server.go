@@ -29,6 +29,7 @@ var (
 	_ resource.ResourceWithConfigure      = &securityDynamicAddressFeedServer{}
 	_ resource.ResourceWithValidateConfig = &securityDynamicAddressFeedServer{}
 	_ resource.ResourceWithImportState    = &securityDynamicAddressFeedServer{}
+	_ resource.ResourceWithIdentity       = &securityDynamicAddressFeedServer{}
 )
 
 type securityDynamicAddressFeedServer struct {
@@ -203,6 +204,21 @@ func (rsc *securityDynamicAddressFeedServer) Schema(
 }
 
 //nolint:lll
+func (rsc *securityDynamicAddressFeedServer) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityDynamicAddressFeedServer) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Security dynamic address feed-server name.",
+		},
+	}
+}
+
 type securityDynamicAddressFeedServerData struct {
 	ID                                        types.String                                    `tfsdk:"id"`
 	Name                                      types.String                                    `tfsdk:"name"`
@@ -432,6 +448,10 @@ func (rsc *securityDynamicAddressFeedServer) Delete(
 func (rsc *securityDynamicAddressFeedServer) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityDynamicAddressFeedServerData
 
 	var _ resourceDataReadFrom1String = &data
@@ -446,12 +466,12 @@ func (rsc *securityDynamicAddressFeedServer) ImportState(
 }
 
 func checkSecurityDynamicAddressFeedServerExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security dynamic-address feed-server " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security dynamic-address feed-server "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -471,7 +491,7 @@ func (rscData *securityDynamicAddressFeedServerData) nullID() bool {
 }
 
 func (rscData *securityDynamicAddressFeedServerData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -514,7 +534,7 @@ func (rscData *securityDynamicAddressFeedServerData) set(
 		configSet = append(configSet, block.configSet(setPrefix)...)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (block *securityDynamicAddressFeedServerBlockFeedName) configSet(setPrefix string) []string {
@@ -540,10 +560,10 @@ func (block *securityDynamicAddressFeedServerBlockFeedName) configSet(setPrefix
 }
 
 func (rscData *securityDynamicAddressFeedServerData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security dynamic-address feed-server " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security dynamic-address feed-server "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -611,11 +631,11 @@ func (block *securityDynamicAddressFeedServerBlockFeedName) read(itemTrim string
 }
 
 func (rscData *securityDynamicAddressFeedServerData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security dynamic-address feed-server " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
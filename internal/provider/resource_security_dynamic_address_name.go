@@ -30,6 +30,7 @@ var (
 	_ resource.ResourceWithValidateConfig = &securityDynamicAddressName{}
 	_ resource.ResourceWithImportState    = &securityDynamicAddressName{}
 	_ resource.ResourceWithUpgradeState   = &securityDynamicAddressName{}
+	_ resource.ResourceWithIdentity       = &securityDynamicAddressName{}
 )
 
 type securityDynamicAddressName struct {
@@ -186,6 +187,21 @@ func (rsc *securityDynamicAddressName) Schema(
 	}
 }
 
+func (rsc *securityDynamicAddressName) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityDynamicAddressName) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Security dynamic address name.",
+		},
+	}
+}
+
 type securityDynamicAddressNameData struct {
 	ID              types.String                                    `tfsdk:"id"`
 	Name            types.String                                    `tfsdk:"name"`
@@ -420,6 +436,10 @@ func (rsc *securityDynamicAddressName) Delete(
 func (rsc *securityDynamicAddressName) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityDynamicAddressNameData
 
 	var _ resourceDataReadFrom1String = &data
@@ -434,12 +454,12 @@ func (rsc *securityDynamicAddressName) ImportState(
 }
 
 func checkSecurityDynamicAddressNameExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security dynamic-address address-name " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security dynamic-address address-name "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -459,7 +479,7 @@ func (rscData *securityDynamicAddressNameData) nullID() bool {
 }
 
 func (rscData *securityDynamicAddressNameData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -484,7 +504,7 @@ func (rscData *securityDynamicAddressNameData) set(
 		configSet = append(configSet, blockSet...)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (block *securityDynamicAddressNameBlockProfileCategory) configSet(
@@ -523,10 +543,10 @@ func (block *securityDynamicAddressNameBlockProfileCategory) configSet(
 }
 
 func (rscData *securityDynamicAddressNameData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security dynamic-address address-name " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security dynamic-address address-name "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -582,11 +602,11 @@ func (block *securityDynamicAddressNameBlockProfileCategory) read(itemTrim strin
 }
 
 func (rscData *securityDynamicAddressNameData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security dynamic-address address-name " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
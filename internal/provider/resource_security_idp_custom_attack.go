@@ -35,6 +35,7 @@ var (
 	_ resource.ResourceWithValidateConfig = &securityIdpCustomAttack{}
 	_ resource.ResourceWithImportState    = &securityIdpCustomAttack{}
 	_ resource.ResourceWithUpgradeState   = &securityIdpCustomAttack{}
+	_ resource.ResourceWithIdentity       = &securityIdpCustomAttack{}
 )
 
 type securityIdpCustomAttack struct {
@@ -245,6 +246,21 @@ func (rsc *securityIdpCustomAttack) Schema(
 	}
 }
 
+func (rsc *securityIdpCustomAttack) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityIdpCustomAttack) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Custom attack name",
+		},
+	}
+}
+
 type securityIdpCustomAttackData struct {
 	ID                  types.String                                     `tfsdk:"id"`
 	Name                types.String                                     `tfsdk:"name"`
@@ -2865,6 +2881,10 @@ func (rsc *securityIdpCustomAttack) Delete(
 func (rsc *securityIdpCustomAttack) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityIdpCustomAttackData
 
 	var _ resourceDataReadFrom1String = &data
@@ -2879,12 +2899,12 @@ func (rsc *securityIdpCustomAttack) ImportState(
 }
 
 func checkSecurityIdpCustomAttackExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security idp custom-attack \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security idp custom-attack \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -2904,7 +2924,7 @@ func (rscData *securityIdpCustomAttackData) nullID() bool {
 }
 
 func (rscData *securityIdpCustomAttackData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -2942,7 +2962,7 @@ func (rscData *securityIdpCustomAttackData) set(
 		configSet = append(configSet, blockSet...)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (block *securityIdpCustomAttackBlockAttackTypeAnomaly) configSet(setPrefix string) []string {
@@ -3532,10 +3552,10 @@ func (block *securityIdpCustomAttackBlockAttackTypeSignatureBlockProtocolUDP) co
 }
 
 func (rscData *securityIdpCustomAttackData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security idp custom-attack \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security idp custom-attack \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -3938,11 +3958,11 @@ func (block *securityIdpCustomAttackBlockAttackTypeSignatureBlockProtocolUDP) re
 }
 
 func (rscData *securityIdpCustomAttackData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security idp custom-attack \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
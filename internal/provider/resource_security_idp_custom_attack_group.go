@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &securityIdpCustomAttackGroup{}
 	_ resource.ResourceWithConfigure   = &securityIdpCustomAttackGroup{}
 	_ resource.ResourceWithImportState = &securityIdpCustomAttackGroup{}
+	_ resource.ResourceWithIdentity    = &securityIdpCustomAttackGroup{}
 )
 
 type securityIdpCustomAttackGroup struct {
@@ -110,6 +111,21 @@ func (rsc *securityIdpCustomAttackGroup) Schema(
 	}
 }
 
+func (rsc *securityIdpCustomAttackGroup) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityIdpCustomAttackGroup) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Custom attack group name.",
+		},
+	}
+}
+
 type securityIdpCustomAttackGroupData struct {
 	ID     types.String   `tfsdk:"id"`
 	Name   types.String   `tfsdk:"name"`
@@ -247,6 +263,10 @@ func (rsc *securityIdpCustomAttackGroup) Delete(
 func (rsc *securityIdpCustomAttackGroup) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityIdpCustomAttackGroupData
 
 	var _ resourceDataReadFrom1String = &data
@@ -261,12 +281,12 @@ func (rsc *securityIdpCustomAttackGroup) ImportState(
 }
 
 func checkSecurityIdpCustomAttackGroupExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security idp custom-attack-group \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security idp custom-attack-group \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -286,7 +306,7 @@ func (rscData *securityIdpCustomAttackGroupData) nullID() bool {
 }
 
 func (rscData *securityIdpCustomAttackGroupData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -299,14 +319,14 @@ func (rscData *securityIdpCustomAttackGroupData) set(
 		configSet = append(configSet, setPrefix+"group-members \""+v.ValueString()+"\"")
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *securityIdpCustomAttackGroupData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security idp custom-attack-group \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security idp custom-attack-group \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -331,11 +351,11 @@ func (rscData *securityIdpCustomAttackGroupData) read(
 }
 
 func (rscData *securityIdpCustomAttackGroupData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security idp custom-attack-group \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
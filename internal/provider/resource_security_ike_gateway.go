@@ -33,6 +33,7 @@ var (
 	_ resource.ResourceWithValidateConfig = &securityIkeGateway{}
 	_ resource.ResourceWithImportState    = &securityIkeGateway{}
 	_ resource.ResourceWithUpgradeState   = &securityIkeGateway{}
+	_ resource.ResourceWithIdentity       = &securityIkeGateway{}
 )
 
 type securityIkeGateway struct {
@@ -374,6 +375,21 @@ func (rsc *securityIkeGateway) Schema(
 	}
 }
 
+func (rsc *securityIkeGateway) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityIkeGateway) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Label for the remote (peer) gateway.",
+		},
+	}
+}
+
 type securityIkeGatewayData struct {
 	ID                types.String                              `tfsdk:"id"`
 	Name              types.String                              `tfsdk:"name"`
@@ -784,6 +800,10 @@ func (rsc *securityIkeGateway) Delete(
 func (rsc *securityIkeGateway) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityIkeGatewayData
 
 	var _ resourceDataReadFrom1String = &data
@@ -798,12 +818,12 @@ func (rsc *securityIkeGateway) ImportState(
 }
 
 func checkSecurityIkeGatewayExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security ike gateway \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security ike gateway \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -823,7 +843,7 @@ func (rscData *securityIkeGatewayData) nullID() bool {
 }
 
 func (rscData *securityIkeGatewayData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -950,14 +970,14 @@ func (rscData *securityIkeGatewayData) set(
 		configSet = append(configSet, setPrefix+"version "+v)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *securityIkeGatewayData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security ike gateway \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security ike gateway \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -1096,11 +1116,11 @@ func (rscData *securityIkeGatewayData) read(
 }
 
 func (rscData *securityIkeGatewayData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security ike gateway \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -31,6 +31,7 @@ var (
 	_ resource.ResourceWithConfigure      = &securityIkePolicy{}
 	_ resource.ResourceWithValidateConfig = &securityIkePolicy{}
 	_ resource.ResourceWithImportState    = &securityIkePolicy{}
+	_ resource.ResourceWithIdentity       = &securityIkePolicy{}
 )
 
 type securityIkePolicy struct {
@@ -173,6 +174,21 @@ func (rsc *securityIkePolicy) Schema(
 	}
 }
 
+func (rsc *securityIkePolicy) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityIkePolicy) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "The name of IKE policy.",
+		},
+	}
+}
+
 type securityIkePolicyData struct {
 	ID               types.String   `tfsdk:"id"`
 	Name             types.String   `tfsdk:"name"`
@@ -347,6 +363,10 @@ func (rsc *securityIkePolicy) Delete(
 func (rsc *securityIkePolicy) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityIkePolicyData
 
 	var _ resourceDataReadFrom1String = &data
@@ -361,12 +381,12 @@ func (rsc *securityIkePolicy) ImportState(
 }
 
 func checkSecurityIkePolicyExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security ike policy \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security ike policy \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -386,7 +406,7 @@ func (rscData *securityIkePolicyData) nullID() bool {
 }
 
 func (rscData *securityIkePolicyData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -420,14 +440,14 @@ func (rscData *securityIkePolicyData) set(
 			utils.ConvI64toa(rscData.ReauthFrequency.ValueInt64()))
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *securityIkePolicyData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security ike policy \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security ike policy \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -474,11 +494,11 @@ func (rscData *securityIkePolicyData) read(
 }
 
 func (rscData *securityIkePolicyData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security ike policy \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
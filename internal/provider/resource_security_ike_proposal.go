@@ -28,6 +28,7 @@ var (
 	_ resource.Resource                = &securityIkeProposal{}
 	_ resource.ResourceWithConfigure   = &securityIkeProposal{}
 	_ resource.ResourceWithImportState = &securityIkeProposal{}
+	_ resource.ResourceWithIdentity    = &securityIkeProposal{}
 )
 
 type securityIkeProposal struct {
@@ -149,6 +150,21 @@ func (rsc *securityIkeProposal) Schema(
 	}
 }
 
+func (rsc *securityIkeProposal) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityIkeProposal) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "The name of IKE proposal.",
+		},
+	}
+}
+
 type securityIkeProposalData struct {
 	ID                      types.String `tfsdk:"id"`
 	Name                    types.String `tfsdk:"name"`
@@ -283,6 +299,10 @@ func (rsc *securityIkeProposal) Delete(
 func (rsc *securityIkeProposal) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityIkeProposalData
 
 	var _ resourceDataReadFrom1String = &data
@@ -297,12 +317,12 @@ func (rsc *securityIkeProposal) ImportState(
 }
 
 func checkSecurityIkeProposalExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security ike proposal \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security ike proposal \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -322,7 +342,7 @@ func (rscData *securityIkeProposalData) nullID() bool {
 }
 
 func (rscData *securityIkeProposalData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -349,14 +369,14 @@ func (rscData *securityIkeProposalData) set(
 			utils.ConvI64toa(rscData.LifetimeSeconds.ValueInt64()))
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *securityIkeProposalData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security ike proposal \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security ike proposal \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -395,11 +415,11 @@ func (rscData *securityIkeProposalData) read(
 }
 
 func (rscData *securityIkeProposalData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security ike proposal \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
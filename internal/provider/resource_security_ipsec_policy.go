@@ -26,6 +26,7 @@ var (
 	_ resource.ResourceWithConfigure      = &securityIpsecPolicy{}
 	_ resource.ResourceWithValidateConfig = &securityIpsecPolicy{}
 	_ resource.ResourceWithImportState    = &securityIpsecPolicy{}
+	_ resource.ResourceWithIdentity       = &securityIpsecPolicy{}
 )
 
 type securityIpsecPolicy struct {
@@ -142,6 +143,21 @@ func (rsc *securityIpsecPolicy) Schema(
 	}
 }
 
+func (rsc *securityIpsecPolicy) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityIpsecPolicy) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "The name of IPSec policy.",
+		},
+	}
+}
+
 type securityIpsecPolicyData struct {
 	ID          types.String   `tfsdk:"id"`
 	Name        types.String   `tfsdk:"name"`
@@ -302,6 +318,10 @@ func (rsc *securityIpsecPolicy) Delete(
 func (rsc *securityIpsecPolicy) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityIpsecPolicyData
 
 	var _ resourceDataReadFrom1String = &data
@@ -316,12 +336,12 @@ func (rsc *securityIpsecPolicy) ImportState(
 }
 
 func checkSecurityIpsecPolicyExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security ipsec policy " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security ipsec policy "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -341,7 +361,7 @@ func (rscData *securityIpsecPolicyData) nullID() bool {
 }
 
 func (rscData *securityIpsecPolicyData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -361,14 +381,14 @@ func (rscData *securityIpsecPolicyData) set(
 		configSet = append(configSet, setPrefix+"proposal-set "+v)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *securityIpsecPolicyData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security ipsec policy " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security ipsec policy "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -400,11 +420,11 @@ func (rscData *securityIpsecPolicyData) read(
 }
 
 func (rscData *securityIpsecPolicyData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security ipsec policy " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -27,6 +27,7 @@ var (
 	_ resource.Resource                = &securityIpsecProposal{}
 	_ resource.ResourceWithConfigure   = &securityIpsecProposal{}
 	_ resource.ResourceWithImportState = &securityIpsecProposal{}
+	_ resource.ResourceWithIdentity    = &securityIpsecProposal{}
 )
 
 type securityIpsecProposal struct {
@@ -144,6 +145,21 @@ func (rsc *securityIpsecProposal) Schema(
 	}
 }
 
+func (rsc *securityIpsecProposal) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityIpsecProposal) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "The name of IPSec proposal.",
+		},
+	}
+}
+
 type securityIpsecProposalData struct {
 	ID                      types.String `tfsdk:"id"`
 	Name                    types.String `tfsdk:"name"`
@@ -278,6 +294,10 @@ func (rsc *securityIpsecProposal) Delete(
 func (rsc *securityIpsecProposal) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityIpsecProposalData
 
 	var _ resourceDataReadFrom1String = &data
@@ -292,12 +312,12 @@ func (rsc *securityIpsecProposal) ImportState(
 }
 
 func checkSecurityIpsecProposalExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security ipsec proposal " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security ipsec proposal "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -317,7 +337,7 @@ func (rscData *securityIpsecProposalData) nullID() bool {
 }
 
 func (rscData *securityIpsecProposalData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -345,14 +365,14 @@ func (rscData *securityIpsecProposalData) set(
 		configSet = append(configSet, setPrefix+"protocol "+v)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *securityIpsecProposalData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security ipsec proposal " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security ipsec proposal "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -394,11 +414,11 @@ func (rscData *securityIpsecProposalData) read(
 }
 
 func (rscData *securityIpsecProposalData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security ipsec proposal " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -35,6 +35,7 @@ var (
 	_ resource.ResourceWithValidateConfig = &securityIpsecVpn{}
 	_ resource.ResourceWithImportState    = &securityIpsecVpn{}
 	_ resource.ResourceWithUpgradeState   = &securityIpsecVpn{}
+	_ resource.ResourceWithIdentity       = &securityIpsecVpn{}
 )
 
 type securityIpsecVpn struct {
@@ -377,6 +378,21 @@ func (rsc *securityIpsecVpn) Schema(
 	}
 }
 
+func (rsc *securityIpsecVpn) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityIpsecVpn) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "The name of vpn.",
+		},
+	}
+}
+
 type securityIpsecVpnData struct {
 	ID                     types.String                           `tfsdk:"id"`
 	Name                   types.String                           `tfsdk:"name"`
@@ -846,6 +862,10 @@ func (rsc *securityIpsecVpn) Delete(
 func (rsc *securityIpsecVpn) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityIpsecVpnData
 
 	var _ resourceDataReadFrom1String = &data
@@ -860,12 +880,12 @@ func (rsc *securityIpsecVpn) ImportState(
 }
 
 func checkSecurityIpsecVpnExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security ipsec vpn \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security ipsec vpn \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -885,7 +905,7 @@ func (rscData *securityIpsecVpnData) nullID() bool {
 }
 
 func (rscData *securityIpsecVpnData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -999,14 +1019,14 @@ func (rscData *securityIpsecVpnData) set(
 		}
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *securityIpsecVpnData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security ipsec vpn \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security ipsec vpn \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -1138,11 +1158,11 @@ func (rscData *securityIpsecVpnData) read(
 }
 
 func (rscData *securityIpsecVpnData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security ipsec vpn \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
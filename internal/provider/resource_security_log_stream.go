@@ -32,6 +32,7 @@ var (
 	_ resource.ResourceWithValidateConfig = &securityLogStream{}
 	_ resource.ResourceWithImportState    = &securityLogStream{}
 	_ resource.ResourceWithUpgradeState   = &securityLogStream{}
+	_ resource.ResourceWithIdentity       = &securityLogStream{}
 )
 
 type securityLogStream struct {
@@ -251,6 +252,21 @@ func (rsc *securityLogStream) Schema(
 	}
 }
 
+func (rsc *securityLogStream) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityLogStream) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Name of security log stream.",
+		},
+	}
+}
+
 type securityLogStreamData struct {
 	ID                 types.String                     `tfsdk:"id"`
 	Name               types.String                     `tfsdk:"name"`
@@ -487,6 +503,10 @@ func (rsc *securityLogStream) Delete(
 func (rsc *securityLogStream) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityLogStreamData
 
 	var _ resourceDataReadFrom1String = &data
@@ -501,12 +521,12 @@ func (rsc *securityLogStream) ImportState(
 }
 
 func checkSecurityLogStreamExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security log stream " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security log stream "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -526,7 +546,7 @@ func (rscData *securityLogStreamData) nullID() bool {
 }
 
 func (rscData *securityLogStreamData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -594,14 +614,14 @@ func (rscData *securityLogStreamData) set(
 		}
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *securityLogStreamData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security log stream " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security log stream "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -688,11 +708,11 @@ func (rscData *securityLogStreamData) read(
 }
 
 func (rscData *securityLogStreamData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security log stream " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -32,6 +32,7 @@ var (
 	_ resource.ResourceWithConfigure      = &securityNatDestination{}
 	_ resource.ResourceWithValidateConfig = &securityNatDestination{}
 	_ resource.ResourceWithImportState    = &securityNatDestination{}
+	_ resource.ResourceWithIdentity       = &securityNatDestination{}
 	_ resource.ResourceWithUpgradeState   = &securityNatDestination{}
 )
 
@@ -286,6 +287,21 @@ func (rsc *securityNatDestination) Schema(
 	}
 }
 
+func (rsc *securityNatDestination) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityNatDestination) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Destination nat rule-set name.",
+		},
+	}
+}
+
 type securityNatDestinationData struct {
 	ID          types.String                      `tfsdk:"id"`
 	Name        types.String                      `tfsdk:"name"`
@@ -540,6 +556,10 @@ func (rsc *securityNatDestination) Delete(
 func (rsc *securityNatDestination) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityNatDestinationData
 
 	var _ resourceDataReadFrom1String = &data
@@ -554,12 +574,12 @@ func (rsc *securityNatDestination) ImportState(
 }
 
 func checkSecurityNatDestinationExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security nat destination rule-set " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security nat destination rule-set "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -579,7 +599,7 @@ func (rscData *securityNatDestinationData) nullID() bool {
 }
 
 func (rscData *securityNatDestinationData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -663,14 +683,14 @@ func (rscData *securityNatDestinationData) set(
 		}
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *securityNatDestinationData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security nat destination rule-set " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security nat destination rule-set "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -751,11 +771,11 @@ func (rscData *securityNatDestinationData) read(
 }
 
 func (rscData *securityNatDestinationData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security nat destination rule-set " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -28,6 +28,7 @@ var (
 	_ resource.ResourceWithConfigure      = &securityNatDestinationPool{}
 	_ resource.ResourceWithValidateConfig = &securityNatDestinationPool{}
 	_ resource.ResourceWithImportState    = &securityNatDestinationPool{}
+	_ resource.ResourceWithIdentity       = &securityNatDestinationPool{}
 )
 
 type securityNatDestinationPool struct {
@@ -137,6 +138,21 @@ func (rsc *securityNatDestinationPool) Schema(
 	}
 }
 
+func (rsc *securityNatDestinationPool) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityNatDestinationPool) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Pool name.",
+		},
+	}
+}
+
 type securityNatDestinationPoolData struct {
 	ID              types.String `tfsdk:"id"`
 	Name            types.String `tfsdk:"name"`
@@ -297,6 +313,10 @@ func (rsc *securityNatDestinationPool) Delete(
 func (rsc *securityNatDestinationPool) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityNatDestinationPoolData
 
 	var _ resourceDataReadFrom1String = &data
@@ -311,12 +331,12 @@ func (rsc *securityNatDestinationPool) ImportState(
 }
 
 func checkSecurityNatDestinationPoolExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security nat destination pool " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security nat destination pool "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -336,7 +356,7 @@ func (rscData *securityNatDestinationPoolData) nullID() bool {
 }
 
 func (rscData *securityNatDestinationPoolData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -359,14 +379,14 @@ func (rscData *securityNatDestinationPoolData) set(
 		configSet = append(configSet, setPrefix+"routing-instance "+v)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *securityNatDestinationPoolData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security nat destination pool " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security nat destination pool "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -404,11 +424,11 @@ func (rscData *securityNatDestinationPoolData) read(
 }
 
 func (rscData *securityNatDestinationPoolData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security nat destination pool " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
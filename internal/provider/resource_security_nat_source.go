@@ -32,6 +32,7 @@ var (
 	_ resource.ResourceWithConfigure      = &securityNatSource{}
 	_ resource.ResourceWithValidateConfig = &securityNatSource{}
 	_ resource.ResourceWithImportState    = &securityNatSource{}
+	_ resource.ResourceWithIdentity       = &securityNatSource{}
 	_ resource.ResourceWithUpgradeState   = &securityNatSource{}
 )
 
@@ -356,6 +357,21 @@ func (rsc *securityNatSource) Schema(
 	}
 }
 
+func (rsc *securityNatSource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityNatSource) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Source nat rule-set name.",
+		},
+	}
+}
+
 type securityNatSourceData struct {
 	ID          types.String                  `tfsdk:"id"`
 	Name        types.String                  `tfsdk:"name"`
@@ -634,6 +650,10 @@ func (rsc *securityNatSource) Delete(
 func (rsc *securityNatSource) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityNatSourceData
 
 	var _ resourceDataReadFrom1String = &data
@@ -648,12 +668,12 @@ func (rsc *securityNatSource) ImportState(
 }
 
 func checkSecurityNatSourceExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security nat source rule-set " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security nat source rule-set "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -673,7 +693,7 @@ func (rscData *securityNatSourceData) nullID() bool {
 }
 
 func (rscData *securityNatSourceData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -779,14 +799,14 @@ func (rscData *securityNatSourceData) set(
 		}
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *securityNatSourceData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security nat source rule-set " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security nat source rule-set "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -892,11 +912,11 @@ func (rscData *securityNatSourceData) read(
 }
 
 func (rscData *securityNatSourceData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security nat source rule-set " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
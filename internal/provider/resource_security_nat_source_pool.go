@@ -29,6 +29,7 @@ var (
 	_ resource.ResourceWithConfigure      = &securityNatSourcePool{}
 	_ resource.ResourceWithValidateConfig = &securityNatSourcePool{}
 	_ resource.ResourceWithImportState    = &securityNatSourcePool{}
+	_ resource.ResourceWithIdentity       = &securityNatSourcePool{}
 )
 
 type securityNatSourcePool struct {
@@ -171,6 +172,21 @@ func (rsc *securityNatSourcePool) Schema(
 	}
 }
 
+func (rsc *securityNatSourcePool) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityNatSourcePool) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Pool name.",
+		},
+	}
+}
+
 type securityNatSourcePoolData struct {
 	ID                                 types.String   `tfsdk:"id"`
 	Name                               types.String   `tfsdk:"name"`
@@ -373,6 +389,10 @@ func (rsc *securityNatSourcePool) Delete(
 func (rsc *securityNatSourcePool) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityNatSourcePoolData
 
 	var _ resourceDataReadFrom1String = &data
@@ -387,12 +407,12 @@ func (rsc *securityNatSourcePool) ImportState(
 }
 
 func checkSecurityNatSourcePoolExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security nat source pool " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security nat source pool "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -412,7 +432,7 @@ func (rscData *securityNatSourcePoolData) nullID() bool {
 }
 
 func (rscData *securityNatSourcePoolData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -455,14 +475,14 @@ func (rscData *securityNatSourcePoolData) set(
 		configSet = append(configSet, setPrefix+"routing-instance "+v)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *securityNatSourcePoolData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security nat source pool " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security nat source pool "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -515,11 +535,11 @@ func (rscData *securityNatSourcePoolData) read(
 }
 
 func (rscData *securityNatSourcePoolData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security nat source pool " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
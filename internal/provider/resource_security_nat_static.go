@@ -33,6 +33,7 @@ var (
 	_ resource.ResourceWithConfigure      = &securityNatStatic{}
 	_ resource.ResourceWithValidateConfig = &securityNatStatic{}
 	_ resource.ResourceWithImportState    = &securityNatStatic{}
+	_ resource.ResourceWithIdentity       = &securityNatStatic{}
 	_ resource.ResourceWithUpgradeState   = &securityNatStatic{}
 )
 
@@ -305,6 +306,21 @@ func (rsc *securityNatStatic) Schema(
 	}
 }
 
+func (rsc *securityNatStatic) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityNatStatic) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Static nat rule-set name.",
+		},
+	}
+}
+
 type securityNatStaticData struct {
 	ID                   types.String                 `tfsdk:"id"`
 	Name                 types.String                 `tfsdk:"name"`
@@ -656,6 +672,9 @@ func (rsc *securityNatStatic) Update(
 		}
 
 		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		defaultResourceWriteFakeSetFile(ctx, rsc, junSess, resp.State, "update", &resp.Diagnostics)
+		resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
+		resp.Diagnostics.Append(defaultResourceAddManaged(ctx, rsc, resp.State)...)
 
 		return
 	}
@@ -673,7 +692,7 @@ func (rsc *securityNatStatic) Update(
 		return
 	}
 	defer func() {
-		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock())...)
+		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock(ctx))...)
 	}()
 
 	var delErr error
@@ -705,6 +724,8 @@ func (rsc *securityNatStatic) Update(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
+	resp.Diagnostics.Append(defaultResourceAddManaged(ctx, rsc, resp.State)...)
 }
 
 func (rsc *securityNatStatic) Delete(
@@ -727,6 +748,10 @@ func (rsc *securityNatStatic) Delete(
 func (rsc *securityNatStatic) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	junSess, err := rsc.junosClient().StartNewSession(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
@@ -755,15 +780,17 @@ func (rsc *securityNatStatic) ImportState(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
+	resp.Diagnostics.Append(defaultResourceAddManaged(ctx, rsc, resp.State)...)
 }
 
 func checkSecurityNatStaticExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security nat static rule-set " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security nat static rule-set "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -917,14 +944,14 @@ func (rscData *securityNatStaticData) set(
 		}
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *securityNatStaticData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security nat static rule-set " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security nat static rule-set "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -1024,22 +1051,22 @@ func (rscData *securityNatStaticData) read(
 }
 
 func (rscData *securityNatStaticData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security nat static rule-set " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *securityNatStaticData) delOptsWithoutRules(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security nat static rule-set " + rscData.Name.ValueString() + " description",
 		"delete security nat static rule-set " + rscData.Name.ValueString() + " from",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -35,6 +35,7 @@ var (
 	_ resource.ResourceWithValidateConfig = &securityNatStaticRule{}
 	_ resource.ResourceWithImportState    = &securityNatStaticRule{}
 	_ resource.ResourceWithUpgradeState   = &securityNatStaticRule{}
+	_ resource.ResourceWithIdentity       = &securityNatStaticRule{}
 )
 
 type securityNatStaticRule struct {
@@ -251,6 +252,25 @@ func (rsc *securityNatStaticRule) Schema(
 	}
 }
 
+func (rsc *securityNatStaticRule) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityNatStaticRule) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "rule_set",
+			description: "Static nat rule-set name.",
+		},
+		{
+			name:        "name",
+			description: "Static Rule name.",
+		},
+	}
+}
+
 type securityNatStaticRuleData struct {
 	ID                     types.String                    `tfsdk:"id"`
 	Name                   types.String                    `tfsdk:"name"`
@@ -559,6 +579,10 @@ func (rsc *securityNatStaticRule) Delete(
 func (rsc *securityNatStaticRule) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityNatStaticRuleData
 
 	var _ resourceDataReadFrom2String = &data
@@ -574,12 +598,12 @@ func (rsc *securityNatStaticRule) ImportState(
 }
 
 func checkSecurityNatStaticRuleExists(
-	_ context.Context, ruleSet, name string, junSess *junos.Session,
+	ctx context.Context, ruleSet, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security nat static rule-set " + ruleSet + " rule " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security nat static rule-set "+ruleSet+" rule "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -699,14 +723,14 @@ func (rscData *securityNatStaticRuleData) set(
 		}
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *securityNatStaticRuleData) read(
-	_ context.Context, ruleSet, name string, junSess *junos.Session,
+	ctx context.Context, ruleSet, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security nat static rule-set " + ruleSet + " rule " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security nat static rule-set "+ruleSet+" rule "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -789,11 +813,11 @@ func (rscData *securityNatStaticRuleData) read(
 }
 
 func (rscData *securityNatStaticRuleData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security nat static rule-set " + rscData.RuleSet.ValueString() + " rule " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -30,8 +31,10 @@ import (
 var (
 	_ resource.Resource                   = &securityPolicy{}
 	_ resource.ResourceWithConfigure      = &securityPolicy{}
+	_ resource.ResourceWithModifyPlan     = &securityPolicy{}
 	_ resource.ResourceWithValidateConfig = &securityPolicy{}
 	_ resource.ResourceWithImportState    = &securityPolicy{}
+	_ resource.ResourceWithIdentity       = &securityPolicy{}
 	_ resource.ResourceWithUpgradeState   = &securityPolicy{}
 )
 
@@ -100,6 +103,25 @@ func (rsc *securityPolicy) Schema(
 	}
 }
 
+func (rsc *securityPolicy) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityPolicy) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "from_zone",
+			description: "The name of source zone.",
+		},
+		{
+			name:        "to_zone",
+			description: "The name of destination zone.",
+		},
+	}
+}
+
 type securityPolicyData struct {
 	ID       types.String                `tfsdk:"id"`
 	FromZone types.String                `tfsdk:"from_zone"`
@@ -558,6 +580,63 @@ func (rsc *securityPolicy) ValidateConfig(
 	}
 }
 
+func (rsc *securityPolicy) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config securityPolicyConfig
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaultResourceModifyPlanCheckReferences(ctx, rsc, &config, &resp.Diagnostics)
+}
+
+func (config *securityPolicyConfig) references(ctx context.Context) []configReference {
+	references := newConfigReferences(path.Root("from_zone"), referenceSecurityZone, config.FromZone)
+	references = append(references, newConfigReferences(path.Root("to_zone"), referenceSecurityZone,
+		config.ToZone)...)
+
+	var configPolicy []securityPolicyBlockPolicyConfig
+	if diags := config.Policy.ElementsAs(ctx, &configPolicy, false); diags.HasError() {
+		return references
+	}
+	for i, block := range configPolicy {
+		references = append(references, securityPolicyAddressReferences(
+			path.Root("policy").AtListIndex(i).AtName("match_source_address"),
+			config.FromZone, block.MatchSourceAddress,
+		)...)
+		references = append(references, securityPolicyAddressReferences(
+			path.Root("policy").AtListIndex(i).AtName("match_destination_address"),
+			config.ToZone, block.MatchDestinationAddress,
+		)...)
+		references = append(references, newConfigReferences(
+			path.Root("policy").AtListIndex(i).AtName("match_application"),
+			referenceApplication, block.MatchApplication,
+		)...)
+	}
+
+	return references
+}
+
+func securityPolicyAddressReferences(
+	attrPath path.Path, zone types.String, values attr.Value,
+) []configReference {
+	if zone.IsUnknown() {
+		return nil
+	}
+	references := newConfigReferences(attrPath, referenceAddress, values)
+	for i := range references {
+		references[i].zone = zone.ValueString()
+	}
+
+	return references
+}
+
 func (rsc *securityPolicy) Create(
 	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse,
 ) {
@@ -691,6 +770,9 @@ func (rsc *securityPolicy) Update(
 		}
 
 		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		defaultResourceWriteFakeSetFile(ctx, rsc, junSess, resp.State, "update", &resp.Diagnostics)
+		resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
+		resp.Diagnostics.Append(defaultResourceAddManaged(ctx, rsc, resp.State)...)
 
 		return
 	}
@@ -708,9 +790,12 @@ func (rsc *securityPolicy) Update(
 		return
 	}
 	defer func() {
-		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock())...)
+		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock(ctx))...)
 	}()
 
+	if !defaultResourceCheckCompatibility(&plan, junSess, &resp.Diagnostics) {
+		return
+	}
 	listLinesToPairPolicy, err := readSecurityPolicyTunnelPairPolicyLines(
 		ctx,
 		state.FromZone.ValueString(),
@@ -736,7 +821,7 @@ func (rsc *securityPolicy) Update(
 
 		return
 	}
-	if err := junSess.ConfigSet(listLinesToPairPolicy); err != nil {
+	if err := junSess.ConfigSet(ctx, listLinesToPairPolicy); err != nil {
 		resp.Diagnostics.AddError(tfdiag.ConfigSetErrSummary, err.Error())
 
 		return
@@ -750,6 +835,8 @@ func (rsc *securityPolicy) Update(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
+	resp.Diagnostics.Append(defaultResourceAddManaged(ctx, rsc, resp.State)...)
 }
 
 func (rsc *securityPolicy) Delete(
@@ -772,6 +859,10 @@ func (rsc *securityPolicy) Delete(
 func (rsc *securityPolicy) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityPolicyData
 
 	var _ resourceDataReadFrom2String = &data
@@ -787,12 +878,12 @@ func (rsc *securityPolicy) ImportState(
 }
 
 func checkSecurityPolicyExists(
-	_ context.Context, fromZone, toZone string, junSess *junos.Session,
+	ctx context.Context, fromZone, toZone string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security policies from-zone " + fromZone + " to-zone " + toZone + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security policies from-zone "+fromZone+" to-zone "+toZone+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -812,7 +903,7 @@ func (rscData *securityPolicyData) nullID() bool {
 }
 
 func (rscData *securityPolicyData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -909,7 +1000,7 @@ func (rscData *securityPolicyData) set(
 		}
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (block *securityPolicyBlockPolicyBlockPermitApplicationServices) configSet(
@@ -973,10 +1064,10 @@ func (block *securityPolicyBlockPolicyBlockPermitApplicationServices) configSet(
 }
 
 func (rscData *securityPolicyData) read(
-	_ context.Context, fromZone, toZone string, junSess *junos.Session,
+	ctx context.Context, fromZone, toZone string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security policies from-zone " + fromZone + " to-zone " + toZone + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security policies from-zone "+fromZone+" to-zone "+toZone+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -1103,13 +1194,13 @@ func (block *securityPolicyBlockPolicyBlockPermitApplicationServices) read(
 }
 
 func readSecurityPolicyTunnelPairPolicyLines(
-	_ context.Context, fromZone, toZone string, junSess *junos.Session,
+	ctx context.Context, fromZone, toZone string, junSess *junos.Session,
 ) (
 	[]string, error,
 ) {
 	listLines := make([]string, 0, 100)
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security policies from-zone " + fromZone + " to-zone " + toZone + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security policies from-zone "+fromZone+" to-zone "+toZone+junos.PipeDisplaySet)
 	if err != nil {
 		return listLines, err
 	}
@@ -1130,12 +1221,26 @@ func readSecurityPolicyTunnelPairPolicyLines(
 	return listLines, nil
 }
 
+func (rscData *securityPolicyData) compatibilityRequirements() []compatibilityRequirement {
+	requirements := make([]compatibilityRequirement, 0)
+	for i, block := range rscData.Policy {
+		if len(block.MatchDynamicApplication) > 0 {
+			requirements = append(requirements, compatibilityRequirement{
+				path:    path.Root("policy").AtListIndex(i).AtName("match_dynamic_application"),
+				feature: junos.FeatureSecurityPolicyMatchDynamicApplication,
+			})
+		}
+	}
+
+	return requirements
+}
+
 func (rscData *securityPolicyData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security policies from-zone " + rscData.FromZone.ValueString() + " to-zone " + rscData.ToZone.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
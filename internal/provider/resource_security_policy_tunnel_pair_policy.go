@@ -24,6 +24,7 @@ var (
 	_ resource.Resource                = &securityPolicyTunnelPairPolicy{}
 	_ resource.ResourceWithConfigure   = &securityPolicyTunnelPairPolicy{}
 	_ resource.ResourceWithImportState = &securityPolicyTunnelPairPolicy{}
+	_ resource.ResourceWithIdentity    = &securityPolicyTunnelPairPolicy{}
 )
 
 type securityPolicyTunnelPairPolicy struct {
@@ -132,6 +133,33 @@ func (rsc *securityPolicyTunnelPairPolicy) Schema(
 	}
 }
 
+func (rsc *securityPolicyTunnelPairPolicy) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityPolicyTunnelPairPolicy) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "zone_a",
+			description: "The name of first zone.",
+		},
+		{
+			name:        "policy_a_to_b",
+			description: "The name of policy when from zone zone_a to zone zone_b.",
+		},
+		{
+			name:        "zone_b",
+			description: "The name of second zone.",
+		},
+		{
+			name:        "policy_b_to_a",
+			description: "The name of policy when from zone zone_b to zone zone_a.",
+		},
+	}
+}
+
 type securityPolicyTunnelPairPolicyData struct {
 	ID         types.String `tfsdk:"id"`
 	ZoneA      types.String `tfsdk:"zone_a"`
@@ -321,6 +349,10 @@ func (rsc *securityPolicyTunnelPairPolicy) Delete(
 func (rsc *securityPolicyTunnelPairPolicy) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityPolicyTunnelPairPolicyData
 
 	var _ resourceDataReadFrom4String = &data
@@ -340,19 +372,19 @@ func (rsc *securityPolicyTunnelPairPolicy) ImportState(
 }
 
 func checkSecurityPolicyTunnelPairPolicyExists(
-	_ context.Context, zoneA, policyAtoB, zoneB, policyBtoA string, junSess *junos.Session,
+	ctx context.Context, zoneA, policyAtoB, zoneB, policyBtoA string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfigPairAtoB, err := junSess.Command(junos.CmdShowConfig +
-		"security policies from-zone " + zoneA + " to-zone " + zoneB + " policy " + policyAtoB +
-		" then permit tunnel pair-policy" + junos.PipeDisplaySet)
+	showConfigPairAtoB, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security policies from-zone "+zoneA+" to-zone "+zoneB+" policy "+policyAtoB+
+		" then permit tunnel pair-policy"+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
-	showConfigPairBtoA, err := junSess.Command(junos.CmdShowConfig +
-		"security policies from-zone " + zoneB + " to-zone " + zoneA + " policy " + policyBtoA +
-		" then permit tunnel pair-policy" + junos.PipeDisplaySet)
+	showConfigPairBtoA, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security policies from-zone "+zoneB+" to-zone "+zoneA+" policy "+policyBtoA+
+		" then permit tunnel pair-policy"+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -377,7 +409,7 @@ func (rscData *securityPolicyTunnelPairPolicyData) nullID() bool {
 }
 
 func (rscData *securityPolicyTunnelPairPolicyData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -392,15 +424,15 @@ func (rscData *securityPolicyTunnelPairPolicyData) set(
 		" policy "+rscData.PolicyBtoA.ValueString()+
 		" then permit tunnel pair-policy "+rscData.PolicyAtoB.ValueString())
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *securityPolicyTunnelPairPolicyData) read(
-	_ context.Context, zoneA, policyAtoB, zoneB, policyBtoA string, junSess *junos.Session,
+	ctx context.Context, zoneA, policyAtoB, zoneB, policyBtoA string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security policies from-zone " + zoneA + " to-zone " + zoneB + " policy " + policyAtoB +
-		" then permit tunnel pair-policy" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security policies from-zone "+zoneA+" to-zone "+zoneB+" policy "+policyAtoB+
+		" then permit tunnel pair-policy"+junos.PipeDisplaySet)
 	if err != nil {
 		return err
 	}
@@ -422,9 +454,9 @@ func (rscData *securityPolicyTunnelPairPolicyData) read(
 			}
 		}
 	}
-	showConfig, err = junSess.Command(junos.CmdShowConfig +
-		"security policies from-zone " + zoneB + " to-zone " + zoneA + " policy " + policyBtoA +
-		" then permit tunnel pair-policy" + junos.PipeDisplaySet)
+	showConfig, err = junSess.Command(ctx, junos.CmdShowConfig+
+		"security policies from-zone "+zoneB+" to-zone "+zoneA+" policy "+policyBtoA+
+		" then permit tunnel pair-policy"+junos.PipeDisplaySet)
 	if err != nil {
 		return err
 	}
@@ -451,7 +483,7 @@ func (rscData *securityPolicyTunnelPairPolicyData) read(
 }
 
 func (rscData *securityPolicyTunnelPairPolicyData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security policies" +
@@ -464,5 +496,5 @@ func (rscData *securityPolicyTunnelPairPolicyData) del(
 			" then permit tunnel pair-policy " + rscData.PolicyAtoB.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -21,6 +21,7 @@ var (
 	_ resource.ResourceWithConfigure      = &securityPolicyUnordered{}
 	_ resource.ResourceWithValidateConfig = &securityPolicyUnordered{}
 	_ resource.ResourceWithImportState    = &securityPolicyUnordered{}
+	_ resource.ResourceWithIdentity       = &securityPolicyUnordered{}
 )
 
 type securityPolicyUnordered struct {
@@ -87,6 +88,16 @@ func (rsc *securityPolicyUnordered) Schema(
 	}
 }
 
+func (rsc *securityPolicyUnordered) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityPolicyUnordered) identityAttributes() []resourceIdentityAttribute {
+	return (&securityPolicy{}).identityAttributes()
+}
+
 type securityPolicyUnorderedConfig struct {
 	ID       types.String `tfsdk:"id"`
 	FromZone types.String `tfsdk:"from_zone"`
@@ -306,6 +317,9 @@ func (rsc *securityPolicyUnordered) Update(
 		}
 
 		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		defaultResourceWriteFakeSetFile(ctx, rsc, junSess, resp.State, "update", &resp.Diagnostics)
+		resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
+		resp.Diagnostics.Append(defaultResourceAddManaged(ctx, rsc, resp.State)...)
 
 		return
 	}
@@ -323,7 +337,7 @@ func (rsc *securityPolicyUnordered) Update(
 		return
 	}
 	defer func() {
-		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock())...)
+		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock(ctx))...)
 	}()
 
 	listLinesToPairPolicy, err := readSecurityPolicyTunnelPairPolicyLines(
@@ -351,7 +365,7 @@ func (rsc *securityPolicyUnordered) Update(
 
 		return
 	}
-	if err := junSess.ConfigSet(listLinesToPairPolicy); err != nil {
+	if err := junSess.ConfigSet(ctx, listLinesToPairPolicy); err != nil {
 		resp.Diagnostics.AddError(tfdiag.ConfigSetErrSummary, err.Error())
 
 		return
@@ -365,6 +379,8 @@ func (rsc *securityPolicyUnordered) Update(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
+	resp.Diagnostics.Append(defaultResourceAddManaged(ctx, rsc, resp.State)...)
 }
 
 func (rsc *securityPolicyUnordered) Delete(
@@ -387,6 +403,10 @@ func (rsc *securityPolicyUnordered) Delete(
 func (rsc *securityPolicyUnordered) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityPolicyData
 
 	var _ resourceDataReadFrom2String = &data
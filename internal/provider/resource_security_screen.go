@@ -35,6 +35,7 @@ var (
 	_ resource.ResourceWithValidateConfig = &securityScreen{}
 	_ resource.ResourceWithImportState    = &securityScreen{}
 	_ resource.ResourceWithUpgradeState   = &securityScreen{}
+	_ resource.ResourceWithIdentity       = &securityScreen{}
 )
 
 type securityScreen struct {
@@ -930,6 +931,21 @@ func (rsc *securityScreen) Schema(
 	}
 }
 
+func (rsc *securityScreen) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityScreen) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "The name of screen.",
+		},
+	}
+}
+
 type securityScreenData struct {
 	ID               types.String                     `tfsdk:"id"                 tfdata:"skip_isempty"`
 	Name             types.String                     `tfsdk:"name"               tfdata:"skip_isempty"`
@@ -1536,6 +1552,10 @@ func (rsc *securityScreen) Delete(
 func (rsc *securityScreen) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityScreenData
 
 	var _ resourceDataReadFrom1String = &data
@@ -1550,12 +1570,12 @@ func (rsc *securityScreen) ImportState(
 }
 
 func checkSecurityScreenExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security screen ids-option \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security screen ids-option \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -1575,7 +1595,7 @@ func (rscData *securityScreenData) nullID() bool {
 }
 
 func (rscData *securityScreenData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -1653,7 +1673,7 @@ func (rscData *securityScreenData) set(
 		configSet = append(configSet, rscData.UDP.configSet(setPrefix)...)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (block *securityScreenBlockAggregation) configSet(setPrefix string) []string {
@@ -2156,10 +2176,10 @@ func (block *securityScreenBlockUDP) configSet(setPrefix string) []string {
 }
 
 func (rscData *securityScreenData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security screen ids-option \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security screen ids-option \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -2626,11 +2646,11 @@ func (block *securityScreenBlockUDP) read(itemTrim string) (err error) {
 }
 
 func (rscData *securityScreenData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security screen ids-option \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
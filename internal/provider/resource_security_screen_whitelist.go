@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &securityScreenWhitelist{}
 	_ resource.ResourceWithConfigure   = &securityScreenWhitelist{}
 	_ resource.ResourceWithImportState = &securityScreenWhitelist{}
+	_ resource.ResourceWithIdentity    = &securityScreenWhitelist{}
 )
 
 type securityScreenWhitelist struct {
@@ -109,6 +110,21 @@ func (rsc *securityScreenWhitelist) Schema(
 	}
 }
 
+func (rsc *securityScreenWhitelist) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityScreenWhitelist) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "White-list name.",
+		},
+	}
+}
+
 type securityScreenWhitelistData struct {
 	ID      types.String   `tfsdk:"id"`
 	Name    types.String   `tfsdk:"name"`
@@ -254,6 +270,10 @@ func (rsc *securityScreenWhitelist) Delete(
 func (rsc *securityScreenWhitelist) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityScreenWhitelistData
 
 	var _ resourceDataReadFrom1String = &data
@@ -268,12 +288,12 @@ func (rsc *securityScreenWhitelist) ImportState(
 }
 
 func checkSecurityScreenWhitelistExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security screen white-list " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security screen white-list "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -293,7 +313,7 @@ func (rscData *securityScreenWhitelistData) nullID() bool {
 }
 
 func (rscData *securityScreenWhitelistData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -304,14 +324,14 @@ func (rscData *securityScreenWhitelistData) set(
 		configSet = append(configSet, setPrefix+"address "+v.ValueString())
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *securityScreenWhitelistData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security screen white-list " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security screen white-list "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -336,11 +356,11 @@ func (rscData *securityScreenWhitelistData) read(
 }
 
 func (rscData *securityScreenWhitelistData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security screen white-list " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -26,6 +26,7 @@ var (
 	_ resource.ResourceWithConfigure      = &securityUtmCustomMessage{}
 	_ resource.ResourceWithValidateConfig = &securityUtmCustomMessage{}
 	_ resource.ResourceWithImportState    = &securityUtmCustomMessage{}
+	_ resource.ResourceWithIdentity       = &securityUtmCustomMessage{}
 )
 
 type securityUtmCustomMessage struct {
@@ -124,6 +125,21 @@ func (rsc *securityUtmCustomMessage) Schema(
 	}
 }
 
+func (rsc *securityUtmCustomMessage) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityUtmCustomMessage) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "The name of security utm custom-object custom-message.",
+		},
+	}
+}
+
 type securityUtmCustomMessageData struct {
 	ID             types.String `tfsdk:"id"`
 	Name           types.String `tfsdk:"name"`
@@ -346,6 +362,10 @@ func (rsc *securityUtmCustomMessage) Delete(
 func (rsc *securityUtmCustomMessage) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityUtmCustomMessageData
 
 	var _ resourceDataReadFrom1String = &data
@@ -360,12 +380,12 @@ func (rsc *securityUtmCustomMessage) ImportState(
 }
 
 func checkSecurityUtmCustomMessageExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security utm custom-objects custom-message " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security utm custom-objects custom-message "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -385,7 +405,7 @@ func (rscData *securityUtmCustomMessageData) nullID() bool {
 }
 
 func (rscData *securityUtmCustomMessageData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -401,14 +421,14 @@ func (rscData *securityUtmCustomMessageData) set(
 		configSet = append(configSet, setPrefix+"custom-page-file \""+v+"\"")
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *securityUtmCustomMessageData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security utm custom-objects custom-message " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security utm custom-objects custom-message "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -438,11 +458,11 @@ func (rscData *securityUtmCustomMessageData) read(
 }
 
 func (rscData *securityUtmCustomMessageData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security utm custom-objects custom-message " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
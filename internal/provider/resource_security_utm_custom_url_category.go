@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &securityUtmCustomURLCategory{}
 	_ resource.ResourceWithConfigure   = &securityUtmCustomURLCategory{}
 	_ resource.ResourceWithImportState = &securityUtmCustomURLCategory{}
+	_ resource.ResourceWithIdentity    = &securityUtmCustomURLCategory{}
 )
 
 type securityUtmCustomURLCategory struct {
@@ -110,6 +111,21 @@ func (rsc *securityUtmCustomURLCategory) Schema(
 	}
 }
 
+func (rsc *securityUtmCustomURLCategory) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityUtmCustomURLCategory) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "The name of security utm custom-object custom-url-category.",
+		},
+	}
+}
+
 type securityUtmCustomURLCategoryData struct {
 	ID    types.String   `tfsdk:"id"`
 	Name  types.String   `tfsdk:"name"`
@@ -247,6 +263,10 @@ func (rsc *securityUtmCustomURLCategory) Delete(
 func (rsc *securityUtmCustomURLCategory) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityUtmCustomURLCategoryData
 
 	var _ resourceDataReadFrom1String = &data
@@ -261,12 +281,12 @@ func (rsc *securityUtmCustomURLCategory) ImportState(
 }
 
 func checkSecurityUtmCustomURLCategoryExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security utm custom-objects custom-url-category " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security utm custom-objects custom-url-category "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -286,7 +306,7 @@ func (rscData *securityUtmCustomURLCategoryData) nullID() bool {
 }
 
 func (rscData *securityUtmCustomURLCategoryData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -297,14 +317,14 @@ func (rscData *securityUtmCustomURLCategoryData) set(
 		configSet = append(configSet, setPrefix+"value \""+v.ValueString()+"\"")
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *securityUtmCustomURLCategoryData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security utm custom-objects custom-url-category " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security utm custom-objects custom-url-category "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -329,11 +349,11 @@ func (rscData *securityUtmCustomURLCategoryData) read(
 }
 
 func (rscData *securityUtmCustomURLCategoryData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security utm custom-objects custom-url-category " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &securityUtmCustomURLPattern{}
 	_ resource.ResourceWithConfigure   = &securityUtmCustomURLPattern{}
 	_ resource.ResourceWithImportState = &securityUtmCustomURLPattern{}
+	_ resource.ResourceWithIdentity    = &securityUtmCustomURLPattern{}
 )
 
 type securityUtmCustomURLPattern struct {
@@ -110,6 +111,21 @@ func (rsc *securityUtmCustomURLPattern) Schema(
 	}
 }
 
+func (rsc *securityUtmCustomURLPattern) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityUtmCustomURLPattern) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "The name of security utm custom-object url-pattern.",
+		},
+	}
+}
+
 type securityUtmCustomURLPatternData struct {
 	ID    types.String   `tfsdk:"id"`
 	Name  types.String   `tfsdk:"name"`
@@ -247,6 +263,10 @@ func (rsc *securityUtmCustomURLPattern) Delete(
 func (rsc *securityUtmCustomURLPattern) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityUtmCustomURLPatternData
 
 	var _ resourceDataReadFrom1String = &data
@@ -261,12 +281,12 @@ func (rsc *securityUtmCustomURLPattern) ImportState(
 }
 
 func checkSecurityUtmCustomURLPatternExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security utm custom-objects url-pattern " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security utm custom-objects url-pattern "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -286,7 +306,7 @@ func (rscData *securityUtmCustomURLPatternData) nullID() bool {
 }
 
 func (rscData *securityUtmCustomURLPatternData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -297,14 +317,14 @@ func (rscData *securityUtmCustomURLPatternData) set(
 		configSet = append(configSet, setPrefix+"value \""+v.ValueString()+"\"")
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *securityUtmCustomURLPatternData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security utm custom-objects url-pattern " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security utm custom-objects url-pattern "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -329,11 +349,11 @@ func (rscData *securityUtmCustomURLPatternData) read(
 }
 
 func (rscData *securityUtmCustomURLPatternData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security utm custom-objects url-pattern " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -33,6 +33,7 @@ var (
 	_ resource.ResourceWithValidateConfig = &securityUtmPolicy{}
 	_ resource.ResourceWithImportState    = &securityUtmPolicy{}
 	_ resource.ResourceWithUpgradeState   = &securityUtmPolicy{}
+	_ resource.ResourceWithIdentity       = &securityUtmPolicy{}
 )
 
 type securityUtmPolicy struct {
@@ -370,6 +371,21 @@ func (rsc *securityUtmPolicy) Schema(
 }
 
 //nolint:lll
+func (rsc *securityUtmPolicy) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityUtmPolicy) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "The name of security utm utm-policy.",
+		},
+	}
+}
+
 type securityUtmPolicyData struct {
 	ID                       types.String                                    `tfsdk:"id"                          tfdata:"skip_isempty"`
 	Name                     types.String                                    `tfsdk:"name"                        tfdata:"skip_isempty"`
@@ -761,6 +777,10 @@ func (rsc *securityUtmPolicy) Delete(
 func (rsc *securityUtmPolicy) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityUtmPolicyData
 
 	var _ resourceDataReadFrom1String = &data
@@ -775,12 +795,12 @@ func (rsc *securityUtmPolicy) ImportState(
 }
 
 func checkSecurityUtmPolicyExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security utm utm-policy \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security utm utm-policy \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -800,7 +820,7 @@ func (rscData *securityUtmPolicyData) nullID() bool {
 }
 
 func (rscData *securityUtmPolicyData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -862,7 +882,7 @@ func (rscData *securityUtmPolicyData) set(
 		configSet = append(configSet, rscData.TrafficSessionsPerClient.configSet(setPrefix)...)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (block *securityUtmPolicyBlockProtocolProfile) configSet(setPrefix string) []string {
@@ -1012,10 +1032,10 @@ func (block *securityUtmPolicyBlockTrafficSessionsPerClient) configSet(setPrefix
 }
 
 func (rscData *securityUtmPolicyData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security utm utm-policy \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security utm utm-policy \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -1145,11 +1165,11 @@ func (block *securityUtmPolicyBlockTrafficSessionsPerClient) read(itemTrim strin
 }
 
 func (rscData *securityUtmPolicyData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security utm utm-policy \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
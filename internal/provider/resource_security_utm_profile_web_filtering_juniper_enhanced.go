@@ -32,6 +32,7 @@ var (
 	_ resource.ResourceWithValidateConfig = &securityUtmProfileWebFilteringJuniperEnhanced{}
 	_ resource.ResourceWithImportState    = &securityUtmProfileWebFilteringJuniperEnhanced{}
 	_ resource.ResourceWithUpgradeState   = &securityUtmProfileWebFilteringJuniperEnhanced{}
+	_ resource.ResourceWithIdentity       = &securityUtmProfileWebFilteringJuniperEnhanced{}
 )
 
 type securityUtmProfileWebFilteringJuniperEnhanced struct {
@@ -274,6 +275,21 @@ func (rsc *securityUtmProfileWebFilteringJuniperEnhanced) Schema(
 }
 
 //nolint:lll
+func (rsc *securityUtmProfileWebFilteringJuniperEnhanced) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityUtmProfileWebFilteringJuniperEnhanced) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "The name of security utm feature-profile web-filtering juniper-enhanced profile.",
+		},
+	}
+}
+
 type securityUtmProfileWebFilteringJuniperEnhancedData struct {
 	ID                      types.String                                                         `tfsdk:"id"                        tfdata:"skip_isempty"`
 	Name                    types.String                                                         `tfsdk:"name"                      tfdata:"skip_isempty"`
@@ -573,6 +589,10 @@ func (rsc *securityUtmProfileWebFilteringJuniperEnhanced) Delete(
 func (rsc *securityUtmProfileWebFilteringJuniperEnhanced) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityUtmProfileWebFilteringJuniperEnhancedData
 
 	var _ resourceDataReadFrom1String = &data
@@ -587,12 +607,12 @@ func (rsc *securityUtmProfileWebFilteringJuniperEnhanced) ImportState(
 }
 
 func checkSecurityUtmProfileWebFilteringJuniperEnhancedExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security utm feature-profile web-filtering juniper-enhanced profile \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security utm feature-profile web-filtering juniper-enhanced profile \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -612,7 +632,7 @@ func (rscData *securityUtmProfileWebFilteringJuniperEnhancedData) nullID() bool
 }
 
 func (rscData *securityUtmProfileWebFilteringJuniperEnhancedData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -681,7 +701,7 @@ func (rscData *securityUtmProfileWebFilteringJuniperEnhancedData) set(
 		configSet = append(configSet, setPrefix+"site-reputation-action "+siteReputation+" "+block.Action.ValueString())
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (block *securityUtmProfileWebFilteringJuniperEnhancedBlockMessage) configSet(setPrefix string) []string {
@@ -732,10 +752,10 @@ func (block *securityUtmProfileWebFilteringJuniperEnhancedBlockCategory) configS
 }
 
 func (rscData *securityUtmProfileWebFilteringJuniperEnhancedData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security utm feature-profile web-filtering juniper-enhanced profile \"" + name + "\"" +
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security utm feature-profile web-filtering juniper-enhanced profile \""+name+"\""+
 		junos.PipeDisplaySetRelative,
 	)
 	if err != nil {
@@ -843,11 +863,11 @@ func (block *securityUtmProfileWebFilteringJuniperEnhancedBlockCategory) read(it
 }
 
 func (rscData *securityUtmProfileWebFilteringJuniperEnhancedData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security utm feature-profile web-filtering juniper-enhanced profile \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -31,6 +31,7 @@ var (
 	_ resource.ResourceWithValidateConfig = &securityUtmProfileWebFilteringJuniperLocal{}
 	_ resource.ResourceWithImportState    = &securityUtmProfileWebFilteringJuniperLocal{}
 	_ resource.ResourceWithUpgradeState   = &securityUtmProfileWebFilteringJuniperLocal{}
+	_ resource.ResourceWithIdentity       = &securityUtmProfileWebFilteringJuniperLocal{}
 )
 
 type securityUtmProfileWebFilteringJuniperLocal struct {
@@ -146,6 +147,21 @@ func (rsc *securityUtmProfileWebFilteringJuniperLocal) Schema(
 }
 
 //nolint:lll
+func (rsc *securityUtmProfileWebFilteringJuniperLocal) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityUtmProfileWebFilteringJuniperLocal) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "The name of security utm feature-profile web-filtering juniper-local profile.",
+		},
+	}
+}
+
 type securityUtmProfileWebFilteringJuniperLocalData struct {
 	ID                 types.String                                         `tfsdk:"id"                   tfdata:"skip_isempty"`
 	Name               types.String                                         `tfsdk:"name"                 tfdata:"skip_isempty"`
@@ -354,6 +370,10 @@ func (rsc *securityUtmProfileWebFilteringJuniperLocal) Delete(
 func (rsc *securityUtmProfileWebFilteringJuniperLocal) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityUtmProfileWebFilteringJuniperLocalData
 
 	var _ resourceDataReadFrom1String = &data
@@ -368,12 +388,12 @@ func (rsc *securityUtmProfileWebFilteringJuniperLocal) ImportState(
 }
 
 func checkSecurityUtmProfileWebFilteringJuniperLocalExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security utm feature-profile web-filtering juniper-local profile \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security utm feature-profile web-filtering juniper-local profile \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -393,7 +413,7 @@ func (rscData *securityUtmProfileWebFilteringJuniperLocalData) nullID() bool {
 }
 
 func (rscData *securityUtmProfileWebFilteringJuniperLocalData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -438,14 +458,14 @@ func (rscData *securityUtmProfileWebFilteringJuniperLocalData) set(
 		configSet = append(configSet, rscData.FallbackSettings.configSet(setPrefix)...)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *securityUtmProfileWebFilteringJuniperLocalData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security utm feature-profile web-filtering juniper-local profile \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security utm feature-profile web-filtering juniper-local profile \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -497,11 +517,11 @@ func (rscData *securityUtmProfileWebFilteringJuniperLocalData) read(
 }
 
 func (rscData *securityUtmProfileWebFilteringJuniperLocalData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security utm feature-profile web-filtering juniper-local profile \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
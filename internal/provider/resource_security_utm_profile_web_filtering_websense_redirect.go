@@ -32,6 +32,7 @@ var (
 	_ resource.ResourceWithValidateConfig = &securityUtmProfileWebFilteringWebsenseRedirect{}
 	_ resource.ResourceWithImportState    = &securityUtmProfileWebFilteringWebsenseRedirect{}
 	_ resource.ResourceWithUpgradeState   = &securityUtmProfileWebFilteringWebsenseRedirect{}
+	_ resource.ResourceWithIdentity       = &securityUtmProfileWebFilteringWebsenseRedirect{}
 )
 
 type securityUtmProfileWebFilteringWebsenseRedirect struct {
@@ -193,6 +194,21 @@ func (rsc *securityUtmProfileWebFilteringWebsenseRedirect) Schema(
 }
 
 //nolint:lll
+func (rsc *securityUtmProfileWebFilteringWebsenseRedirect) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityUtmProfileWebFilteringWebsenseRedirect) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "The name of security utm feature-profile web-filtering websense-redirect profile.",
+		},
+	}
+}
+
 type securityUtmProfileWebFilteringWebsenseRedirectData struct {
 	ID                 types.String                                               `tfsdk:"id"                   tfdata:"skip_isempty"`
 	Name               types.String                                               `tfsdk:"name"                 tfdata:"skip_isempty"`
@@ -416,6 +432,10 @@ func (rsc *securityUtmProfileWebFilteringWebsenseRedirect) Delete(
 func (rsc *securityUtmProfileWebFilteringWebsenseRedirect) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityUtmProfileWebFilteringWebsenseRedirectData
 
 	var _ resourceDataReadFrom1String = &data
@@ -430,12 +450,12 @@ func (rsc *securityUtmProfileWebFilteringWebsenseRedirect) ImportState(
 }
 
 func checkSecurityUtmProfileWebFilteringWebsenseRedirectExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security utm feature-profile web-filtering websense-redirect profile \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security utm feature-profile web-filtering websense-redirect profile \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -455,7 +475,7 @@ func (rscData *securityUtmProfileWebFilteringWebsenseRedirectData) nullID() bool
 }
 
 func (rscData *securityUtmProfileWebFilteringWebsenseRedirectData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -507,7 +527,7 @@ func (rscData *securityUtmProfileWebFilteringWebsenseRedirectData) set(
 		configSet = append(configSet, rscData.Server.configSet(setPrefix)...)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (block *securityUtmProfileWebFilteringWebsenseRedirectBlockServer) configSet(setPrefix string) []string {
@@ -534,10 +554,10 @@ func (block *securityUtmProfileWebFilteringWebsenseRedirectBlockServer) configSe
 }
 
 func (rscData *securityUtmProfileWebFilteringWebsenseRedirectData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security utm feature-profile web-filtering websense-redirect profile \"" + name + "\"" +
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security utm feature-profile web-filtering websense-redirect profile \""+name+"\""+
 		junos.PipeDisplaySetRelative,
 	)
 	if err != nil {
@@ -621,11 +641,11 @@ func (block *securityUtmProfileWebFilteringWebsenseRedirectBlockServer) read(ite
 }
 
 func (rscData *securityUtmProfileWebFilteringWebsenseRedirectData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security utm feature-profile web-filtering websense-redirect profile \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
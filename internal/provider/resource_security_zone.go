@@ -26,8 +26,10 @@ import (
 var (
 	_ resource.Resource                   = &securityZone{}
 	_ resource.ResourceWithConfigure      = &securityZone{}
+	_ resource.ResourceWithModifyPlan     = &securityZone{}
 	_ resource.ResourceWithValidateConfig = &securityZone{}
 	_ resource.ResourceWithImportState    = &securityZone{}
+	_ resource.ResourceWithIdentity       = &securityZone{}
 )
 
 type securityZone struct {
@@ -113,6 +115,21 @@ func (rsc *securityZone) Schema(
 	}
 }
 
+func (rsc *securityZone) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityZone) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "The name of security zone.",
+		},
+	}
+}
+
 type securityZoneData struct {
 	ID                               types.String                           `tfsdk:"id"`
 	Name                             types.String                           `tfsdk:"name"`
@@ -641,6 +658,12 @@ func (rsc *securityZone) ValidateConfig(
 	}
 }
 
+func (rsc *securityZone) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	defaultResourceModifyPlanAddPlanned(ctx, rsc, &securityZoneData{}, req, resp)
+}
+
 func (rsc *securityZone) Create(
 	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse,
 ) {
@@ -790,6 +813,9 @@ func (rsc *securityZone) Update(
 		}
 
 		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		defaultResourceWriteFakeSetFile(ctx, rsc, junSess, resp.State, "update", &resp.Diagnostics)
+		resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
+		resp.Diagnostics.Append(defaultResourceAddManaged(ctx, rsc, resp.State)...)
 
 		return
 	}
@@ -807,7 +833,7 @@ func (rsc *securityZone) Update(
 		return
 	}
 	defer func() {
-		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock())...)
+		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock(ctx))...)
 	}()
 
 	if err := state.delOpts(ctx, addressBookConfiguredSingly, junSess); err != nil {
@@ -833,6 +859,8 @@ func (rsc *securityZone) Update(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
+	resp.Diagnostics.Append(defaultResourceAddManaged(ctx, rsc, resp.State)...)
 }
 
 func (rsc *securityZone) Delete(
@@ -855,6 +883,10 @@ func (rsc *securityZone) Delete(
 func (rsc *securityZone) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityZoneData
 
 	var _ resourceDataReadFrom1String = &data
@@ -869,12 +901,12 @@ func (rsc *securityZone) ImportState(
 }
 
 func checkSecurityZonesExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security zones security-zone " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security zones security-zone "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -894,7 +926,7 @@ func (rscData *securityZoneData) nullID() bool {
 }
 
 func (rscData *securityZoneData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -1018,14 +1050,14 @@ func (rscData *securityZoneData) set(
 		configSet = append(configSet, setPrefix+"tcp-rst")
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *securityZoneData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security zones security-zone " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security zones security-zone "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -1165,7 +1197,7 @@ func (rscData *securityZoneData) read(
 }
 
 func (rscData *securityZoneData) delOpts(
-	_ context.Context, addressBookSingly bool, junSess *junos.Session,
+	ctx context.Context, addressBookSingly bool, junSess *junos.Session,
 ) error {
 	delPrefix := "delete security zones security-zone " + rscData.Name.ValueString() + " "
 
@@ -1183,15 +1215,15 @@ func (rscData *securityZoneData) delOpts(
 		configSet = append(configSet, delPrefix+"address-book")
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *securityZoneData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security zones security-zone " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
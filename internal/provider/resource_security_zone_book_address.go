@@ -24,8 +24,10 @@ import (
 var (
 	_ resource.Resource                   = &securityZoneBookAddress{}
 	_ resource.ResourceWithConfigure      = &securityZoneBookAddress{}
+	_ resource.ResourceWithModifyPlan     = &securityZoneBookAddress{}
 	_ resource.ResourceWithValidateConfig = &securityZoneBookAddress{}
 	_ resource.ResourceWithImportState    = &securityZoneBookAddress{}
+	_ resource.ResourceWithIdentity       = &securityZoneBookAddress{}
 )
 
 type securityZoneBookAddress struct {
@@ -167,6 +169,25 @@ func (rsc *securityZoneBookAddress) Schema(
 	}
 }
 
+func (rsc *securityZoneBookAddress) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityZoneBookAddress) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "zone",
+			description: "The name of security zone.",
+		},
+		{
+			name:        "name",
+			description: "The name of address.",
+		},
+	}
+}
+
 type securityZoneBookAddressData struct {
 	ID          types.String `tfsdk:"id"`
 	Name        types.String `tfsdk:"name"`
@@ -275,6 +296,12 @@ func (rsc *securityZoneBookAddress) ValidateConfig(
 	}
 }
 
+func (rsc *securityZoneBookAddress) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	defaultResourceModifyPlanAddPlanned(ctx, rsc, &securityZoneBookAddressData{}, req, resp)
+}
+
 func (rsc *securityZoneBookAddress) Create(
 	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse,
 ) {
@@ -443,6 +470,10 @@ func (rsc *securityZoneBookAddress) Delete(
 func (rsc *securityZoneBookAddress) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityZoneBookAddressData
 
 	var _ resourceDataReadFrom2String = &data
@@ -458,12 +489,12 @@ func (rsc *securityZoneBookAddress) ImportState(
 }
 
 func checkSecurityZoneBookAddressExists(
-	_ context.Context, zone, name string, junSess *junos.Session,
+	ctx context.Context, zone, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security zones security-zone " + zone + " address-book address " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security zones security-zone "+zone+" address-book address "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -483,7 +514,7 @@ func (rscData *securityZoneBookAddressData) nullID() bool {
 }
 
 func (rscData *securityZoneBookAddressData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -513,14 +544,14 @@ func (rscData *securityZoneBookAddressData) set(
 		configSet = append(configSet, setPrefix+"wildcard-address "+v)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *securityZoneBookAddressData) read(
-	_ context.Context, zone, name string, junSess *junos.Session,
+	ctx context.Context, zone, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security zones security-zone " + zone + " address-book address " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security zones security-zone "+zone+" address-book address "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -569,12 +600,12 @@ func (rscData *securityZoneBookAddressData) read(
 }
 
 func (rscData *securityZoneBookAddressData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security zones security-zone " + rscData.Zone.ValueString() +
 			" address-book address " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
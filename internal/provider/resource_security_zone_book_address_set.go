@@ -26,8 +26,10 @@ import (
 var (
 	_ resource.Resource                   = &securityZoneBookAddressSet{}
 	_ resource.ResourceWithConfigure      = &securityZoneBookAddressSet{}
+	_ resource.ResourceWithModifyPlan     = &securityZoneBookAddressSet{}
 	_ resource.ResourceWithValidateConfig = &securityZoneBookAddressSet{}
 	_ resource.ResourceWithImportState    = &securityZoneBookAddressSet{}
+	_ resource.ResourceWithIdentity       = &securityZoneBookAddressSet{}
 )
 
 type securityZoneBookAddressSet struct {
@@ -145,6 +147,25 @@ func (rsc *securityZoneBookAddressSet) Schema(
 	}
 }
 
+func (rsc *securityZoneBookAddressSet) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityZoneBookAddressSet) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "zone",
+			description: "The name of security zone.",
+		},
+		{
+			name:        "name",
+			description: "The name of address-set.",
+		},
+	}
+}
+
 type securityZoneBookAddressSetData struct {
 	ID          types.String   `tfsdk:"id"`
 	Name        types.String   `tfsdk:"name"`
@@ -179,6 +200,12 @@ func (rsc *securityZoneBookAddressSet) ValidateConfig(
 	}
 }
 
+func (rsc *securityZoneBookAddressSet) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+) {
+	defaultResourceModifyPlanAddPlanned(ctx, rsc, &securityZoneBookAddressSetData{}, req, resp)
+}
+
 func (rsc *securityZoneBookAddressSet) Create(
 	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse,
 ) {
@@ -347,6 +374,10 @@ func (rsc *securityZoneBookAddressSet) Delete(
 func (rsc *securityZoneBookAddressSet) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityZoneBookAddressSetData
 
 	var _ resourceDataReadFrom2String = &data
@@ -362,12 +393,12 @@ func (rsc *securityZoneBookAddressSet) ImportState(
 }
 
 func checkSecurityZoneBookAddressSetExists(
-	_ context.Context, zone, name string, junSess *junos.Session,
+	ctx context.Context, zone, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security zones security-zone " + zone + " address-book address-set " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security zones security-zone "+zone+" address-book address-set "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -387,7 +418,7 @@ func (rscData *securityZoneBookAddressSetData) nullID() bool {
 }
 
 func (rscData *securityZoneBookAddressSetData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -408,14 +439,14 @@ func (rscData *securityZoneBookAddressSetData) set(
 		configSet = append(configSet, setPrefix+"description \""+v+"\"")
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *securityZoneBookAddressSetData) read(
-	_ context.Context, zone, name string, junSess *junos.Session,
+	ctx context.Context, zone, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"security zones security-zone " + zone + " address-book address-set " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"security zones security-zone "+zone+" address-book address-set "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -446,12 +477,12 @@ func (rscData *securityZoneBookAddressSetData) read(
 }
 
 func (rscData *securityZoneBookAddressSetData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete security zones security-zone " + rscData.Zone.ValueString() +
 			" address-book address-set " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -19,6 +19,7 @@ var (
 	_ resource.ResourceWithConfigure      = &securityZoneOrdered{}
 	_ resource.ResourceWithValidateConfig = &securityZoneOrdered{}
 	_ resource.ResourceWithImportState    = &securityZoneOrdered{}
+	_ resource.ResourceWithIdentity       = &securityZoneOrdered{}
 )
 
 type securityZoneOrdered struct {
@@ -104,6 +105,16 @@ func (rsc *securityZoneOrdered) Schema(
 	}
 }
 
+func (rsc *securityZoneOrdered) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *securityZoneOrdered) identityAttributes() []resourceIdentityAttribute {
+	return (&securityZone{}).identityAttributes()
+}
+
 type securityZoneOrderedConfig struct {
 	ID                               types.String `tfsdk:"id"`
 	Name                             types.String `tfsdk:"name"`
@@ -429,6 +440,9 @@ func (rsc *securityZoneOrdered) Update(
 		}
 
 		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		defaultResourceWriteFakeSetFile(ctx, rsc, junSess, resp.State, "update", &resp.Diagnostics)
+		resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
+		resp.Diagnostics.Append(defaultResourceAddManaged(ctx, rsc, resp.State)...)
 
 		return
 	}
@@ -446,7 +460,7 @@ func (rsc *securityZoneOrdered) Update(
 		return
 	}
 	defer func() {
-		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock())...)
+		resp.Diagnostics.Append(tfdiag.Warns(tfdiag.ConfigUnlockWarnSummary, junSess.ConfigUnlock(ctx))...)
 	}()
 
 	if err := state.delOpts(ctx, addressBookConfiguredSingly, junSess); err != nil {
@@ -472,6 +486,8 @@ func (rsc *securityZoneOrdered) Update(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
+	resp.Diagnostics.Append(defaultResourceAddManaged(ctx, rsc, resp.State)...)
 }
 
 func (rsc *securityZoneOrdered) Delete(
@@ -494,6 +510,10 @@ func (rsc *securityZoneOrdered) Delete(
 func (rsc *securityZoneOrdered) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data securityZoneData
 
 	var _ resourceDataReadFrom1String = &data
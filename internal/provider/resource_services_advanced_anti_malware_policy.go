@@ -28,6 +28,7 @@ var (
 	_ resource.ResourceWithConfigure      = &servicesAdvancedAntiMalwarePolicy{}
 	_ resource.ResourceWithValidateConfig = &servicesAdvancedAntiMalwarePolicy{}
 	_ resource.ResourceWithImportState    = &servicesAdvancedAntiMalwarePolicy{}
+	_ resource.ResourceWithIdentity       = &servicesAdvancedAntiMalwarePolicy{}
 )
 
 type servicesAdvancedAntiMalwarePolicy struct {
@@ -229,6 +230,21 @@ func (rsc *servicesAdvancedAntiMalwarePolicy) Schema(
 	}
 }
 
+func (rsc *servicesAdvancedAntiMalwarePolicy) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *servicesAdvancedAntiMalwarePolicy) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Advanced Anti-malware policy name.",
+		},
+	}
+}
+
 type servicesAdvancedAntiMalwarePolicyData struct {
 	ID                             types.String `tfsdk:"id"                                tfdata:"skip_isempty"`
 	Name                           types.String `tfsdk:"name"                              tfdata:"skip_isempty"`
@@ -544,6 +560,10 @@ func (rsc *servicesAdvancedAntiMalwarePolicy) Delete(
 func (rsc *servicesAdvancedAntiMalwarePolicy) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data servicesAdvancedAntiMalwarePolicyData
 
 	var _ resourceDataReadFrom1String = &data
@@ -558,12 +578,12 @@ func (rsc *servicesAdvancedAntiMalwarePolicy) ImportState(
 }
 
 func checkServicesAdvancedAntiMalwarePolicyExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"services advanced-anti-malware policy \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"services advanced-anti-malware policy \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -583,7 +603,7 @@ func (rscData *servicesAdvancedAntiMalwarePolicyData) nullID() bool {
 }
 
 func (rscData *servicesAdvancedAntiMalwarePolicyData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -647,14 +667,14 @@ func (rscData *servicesAdvancedAntiMalwarePolicyData) set(
 		configSet = append(configSet, setPrefix+"whitelist-notification log")
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *servicesAdvancedAntiMalwarePolicyData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"services advanced-anti-malware policy \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"services advanced-anti-malware policy \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -712,11 +732,11 @@ func (rscData *servicesAdvancedAntiMalwarePolicyData) read(
 }
 
 func (rscData *servicesAdvancedAntiMalwarePolicyData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete services advanced-anti-malware policy \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -33,6 +33,7 @@ var (
 	_ resource.ResourceWithConfigure      = &servicesFlowMonitoringV9Template{}
 	_ resource.ResourceWithValidateConfig = &servicesFlowMonitoringV9Template{}
 	_ resource.ResourceWithImportState    = &servicesFlowMonitoringV9Template{}
+	_ resource.ResourceWithIdentity       = &servicesFlowMonitoringV9Template{}
 )
 
 type servicesFlowMonitoringV9Template struct {
@@ -272,6 +273,21 @@ func (rsc *servicesFlowMonitoringV9Template) Schema(
 	}
 }
 
+func (rsc *servicesFlowMonitoringV9Template) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *servicesFlowMonitoringV9Template) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Name of flow-monitoring version9 template.",
+		},
+	}
+}
+
 type servicesFlowMonitoringV9TemplateData struct {
 	ID                        types.String                                      `tfsdk:"id"`
 	Name                      types.String                                      `tfsdk:"name"`
@@ -492,6 +508,10 @@ func (rsc *servicesFlowMonitoringV9Template) Delete(
 func (rsc *servicesFlowMonitoringV9Template) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data servicesFlowMonitoringV9TemplateData
 
 	var _ resourceDataReadFrom1String = &data
@@ -506,12 +526,12 @@ func (rsc *servicesFlowMonitoringV9Template) ImportState(
 }
 
 func checkServicesFlowMonitoringV9TemplateExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"services flow-monitoring version9 template \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"services flow-monitoring version9 template \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -531,7 +551,7 @@ func (rscData *servicesFlowMonitoringV9TemplateData) nullID() bool {
 }
 
 func (rscData *servicesFlowMonitoringV9TemplateData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -621,14 +641,14 @@ func (rscData *servicesFlowMonitoringV9TemplateData) set(
 		configSet = append(configSet, setPrefix+"tunnel-observation ipv6")
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *servicesFlowMonitoringV9TemplateData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"services flow-monitoring version9 template \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"services flow-monitoring version9 template \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -744,11 +764,11 @@ func (rscData *servicesFlowMonitoringV9TemplateData) read(
 }
 
 func (rscData *servicesFlowMonitoringV9TemplateData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete services flow-monitoring version9 template \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -34,6 +34,7 @@ var (
 	_ resource.ResourceWithValidateConfig = &servicesFlowMonitoringVIPFixTemplate{}
 	_ resource.ResourceWithImportState    = &servicesFlowMonitoringVIPFixTemplate{}
 	_ resource.ResourceWithUpgradeState   = &servicesFlowMonitoringVIPFixTemplate{}
+	_ resource.ResourceWithIdentity       = &servicesFlowMonitoringVIPFixTemplate{}
 )
 
 type servicesFlowMonitoringVIPFixTemplate struct {
@@ -268,6 +269,21 @@ func (rsc *servicesFlowMonitoringVIPFixTemplate) Schema(
 	}
 }
 
+func (rsc *servicesFlowMonitoringVIPFixTemplate) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *servicesFlowMonitoringVIPFixTemplate) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Name of flow-monitoring version-ipfix template.",
+		},
+	}
+}
+
 type servicesFlowMonitoringVIPFixTemplateData struct {
 	ID                        types.String                                          `tfsdk:"id"`
 	Name                      types.String                                          `tfsdk:"name"`
@@ -488,6 +504,10 @@ func (rsc *servicesFlowMonitoringVIPFixTemplate) Delete(
 func (rsc *servicesFlowMonitoringVIPFixTemplate) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data servicesFlowMonitoringVIPFixTemplateData
 
 	var _ resourceDataReadFrom1String = &data
@@ -502,12 +522,12 @@ func (rsc *servicesFlowMonitoringVIPFixTemplate) ImportState(
 }
 
 func checkServicesFlowMonitoringVIPFixTemplateExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"services flow-monitoring version-ipfix template \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"services flow-monitoring version-ipfix template \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -527,7 +547,7 @@ func (rscData *servicesFlowMonitoringVIPFixTemplateData) nullID() bool {
 }
 
 func (rscData *servicesFlowMonitoringVIPFixTemplateData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -617,14 +637,14 @@ func (rscData *servicesFlowMonitoringVIPFixTemplateData) set(
 		configSet = append(configSet, setPrefix+"tunnel-observation ipv6")
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *servicesFlowMonitoringVIPFixTemplateData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"services flow-monitoring version-ipfix template \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"services flow-monitoring version-ipfix template \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -734,11 +754,11 @@ func (rscData *servicesFlowMonitoringVIPFixTemplateData) read(
 }
 
 func (rscData *servicesFlowMonitoringVIPFixTemplateData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete services flow-monitoring version-ipfix template \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -27,6 +27,7 @@ var (
 	_ resource.Resource                = &servicesProxyProfile{}
 	_ resource.ResourceWithConfigure   = &servicesProxyProfile{}
 	_ resource.ResourceWithImportState = &servicesProxyProfile{}
+	_ resource.ResourceWithIdentity    = &servicesProxyProfile{}
 )
 
 type servicesProxyProfile struct {
@@ -114,6 +115,21 @@ func (rsc *servicesProxyProfile) Schema(
 	}
 }
 
+func (rsc *servicesProxyProfile) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *servicesProxyProfile) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Proxy profile name.",
+		},
+	}
+}
+
 type servicesProxyProfileData struct {
 	ID               types.String `tfsdk:"id"`
 	Name             types.String `tfsdk:"name"`
@@ -244,6 +260,10 @@ func (rsc *servicesProxyProfile) Delete(
 func (rsc *servicesProxyProfile) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data servicesProxyProfileData
 
 	var _ resourceDataReadFrom1String = &data
@@ -258,12 +278,12 @@ func (rsc *servicesProxyProfile) ImportState(
 }
 
 func checkServicesProxyProfileExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"services proxy profile \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"services proxy profile \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -283,7 +303,7 @@ func (rscData *servicesProxyProfileData) nullID() bool {
 }
 
 func (rscData *servicesProxyProfileData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -297,14 +317,14 @@ func (rscData *servicesProxyProfileData) set(
 			utils.ConvI64toa(rscData.ProtocolHTTPPort.ValueInt64()))
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *servicesProxyProfileData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"services proxy profile \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"services proxy profile \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -335,11 +355,11 @@ func (rscData *servicesProxyProfileData) read(
 }
 
 func (rscData *servicesProxyProfileData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete services proxy profile \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
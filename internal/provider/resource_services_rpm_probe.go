@@ -34,6 +34,7 @@ var (
 	_ resource.ResourceWithValidateConfig = &servicesRpmProbe{}
 	_ resource.ResourceWithImportState    = &servicesRpmProbe{}
 	_ resource.ResourceWithUpgradeState   = &servicesRpmProbe{}
+	_ resource.ResourceWithIdentity       = &servicesRpmProbe{}
 )
 
 type servicesRpmProbe struct {
@@ -493,6 +494,21 @@ func (rsc *servicesRpmProbe) Schema(
 	}
 }
 
+func (rsc *servicesRpmProbe) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *servicesRpmProbe) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Name of owner.",
+		},
+	}
+}
+
 type servicesRpmProbeData struct {
 	ID             types.String                `tfsdk:"id"`
 	Name           types.String                `tfsdk:"name"`
@@ -1027,6 +1043,10 @@ func (rsc *servicesRpmProbe) Delete(
 func (rsc *servicesRpmProbe) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data servicesRpmProbeData
 
 	var _ resourceDataReadFrom1String = &data
@@ -1041,12 +1061,12 @@ func (rsc *servicesRpmProbe) ImportState(
 }
 
 func checkServicesRpmProbeExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"services rpm probe \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"services rpm probe \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -1066,7 +1086,7 @@ func (rscData *servicesRpmProbeData) nullID() bool {
 }
 
 func (rscData *servicesRpmProbeData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -1095,7 +1115,7 @@ func (rscData *servicesRpmProbeData) set(
 		configSet = append(configSet, blockSet...)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (block *servicesRpmProbeBlockTest) configSet(
@@ -1397,10 +1417,10 @@ func (block *servicesRpmProbeBlockTestBlockThresholds) configSet(setPrefix strin
 }
 
 func (rscData *servicesRpmProbeData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"services rpm probe \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"services rpm probe \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -1566,11 +1586,11 @@ func (block *servicesRpmProbeBlockTestBlockThresholds) read(itemTrim string) (er
 }
 
 func (rscData *servicesRpmProbeData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete services rpm probe \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -28,6 +28,7 @@ var (
 	_ resource.ResourceWithConfigure      = &servicesSecurityIntelligencePolicy{}
 	_ resource.ResourceWithValidateConfig = &servicesSecurityIntelligencePolicy{}
 	_ resource.ResourceWithImportState    = &servicesSecurityIntelligencePolicy{}
+	_ resource.ResourceWithIdentity       = &servicesSecurityIntelligencePolicy{}
 )
 
 type servicesSecurityIntelligencePolicy struct {
@@ -137,6 +138,21 @@ func (rsc *servicesSecurityIntelligencePolicy) Schema(
 	}
 }
 
+func (rsc *servicesSecurityIntelligencePolicy) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *servicesSecurityIntelligencePolicy) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Security intelligence policy name.",
+		},
+	}
+}
+
 type servicesSecurityIntelligencePolicyData struct {
 	ID          types.String                                      `tfsdk:"id"`
 	Name        types.String                                      `tfsdk:"name"`
@@ -317,6 +333,10 @@ func (rsc *servicesSecurityIntelligencePolicy) Delete(
 func (rsc *servicesSecurityIntelligencePolicy) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data servicesSecurityIntelligencePolicyData
 
 	var _ resourceDataReadFrom1String = &data
@@ -331,12 +351,12 @@ func (rsc *servicesSecurityIntelligencePolicy) ImportState(
 }
 
 func checkServicesSecurityIntelligencePolicyExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"services security-intelligence policy \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"services security-intelligence policy \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -356,7 +376,7 @@ func (rscData *servicesSecurityIntelligencePolicyData) nullID() bool {
 }
 
 func (rscData *servicesSecurityIntelligencePolicyData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -379,14 +399,14 @@ func (rscData *servicesSecurityIntelligencePolicyData) set(
 		configSet = append(configSet, setPrefix+name+" \""+block.ProfileName.ValueString()+"\"")
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *servicesSecurityIntelligencePolicyData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"services security-intelligence policy \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"services security-intelligence policy \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -419,11 +439,11 @@ func (rscData *servicesSecurityIntelligencePolicyData) read(
 }
 
 func (rscData *servicesSecurityIntelligencePolicyData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete services security-intelligence policy \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -34,6 +34,7 @@ var (
 	_ resource.ResourceWithValidateConfig = &servicesSecurityIntelligenceProfile{}
 	_ resource.ResourceWithImportState    = &servicesSecurityIntelligenceProfile{}
 	_ resource.ResourceWithUpgradeState   = &servicesSecurityIntelligenceProfile{}
+	_ resource.ResourceWithIdentity       = &servicesSecurityIntelligenceProfile{}
 )
 
 type servicesSecurityIntelligenceProfile struct {
@@ -230,6 +231,21 @@ func (rsc *servicesSecurityIntelligenceProfile) Schema(
 	}
 }
 
+func (rsc *servicesSecurityIntelligenceProfile) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *servicesSecurityIntelligenceProfile) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Security intelligence profile name.",
+		},
+	}
+}
+
 type servicesSecurityIntelligenceProfileData struct {
 	ID              types.String                                             `tfsdk:"id"`
 	Name            types.String                                             `tfsdk:"name"`
@@ -476,6 +492,10 @@ func (rsc *servicesSecurityIntelligenceProfile) Delete(
 func (rsc *servicesSecurityIntelligenceProfile) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data servicesSecurityIntelligenceProfileData
 
 	var _ resourceDataReadFrom1String = &data
@@ -490,12 +510,12 @@ func (rsc *servicesSecurityIntelligenceProfile) ImportState(
 }
 
 func checkServicesSecurityIntelligenceProfileExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"services security-intelligence profile \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"services security-intelligence profile \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -515,7 +535,7 @@ func (rscData *servicesSecurityIntelligenceProfileData) nullID() bool {
 }
 
 func (rscData *servicesSecurityIntelligenceProfileData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -543,7 +563,7 @@ func (rscData *servicesSecurityIntelligenceProfileData) set(
 		configSet = append(configSet, rscData.DefaultRuleThen.configSet(setPrefix)...)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (block *servicesSecurityIntelligenceProfileBlockRule) configSet(setPrefix string) []string {
@@ -586,10 +606,10 @@ func (block *servicesSecurityIntelligenceProfileBlockDefaultRuleThen) configSet(
 }
 
 func (rscData *servicesSecurityIntelligenceProfileData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"services security-intelligence profile \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"services security-intelligence profile \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -669,11 +689,11 @@ func (block *servicesSecurityIntelligenceProfileBlockDefaultRuleThen) read(itemT
 }
 
 func (rscData *servicesSecurityIntelligenceProfileData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete services security-intelligence profile \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -30,6 +30,7 @@ var (
 	_ resource.ResourceWithValidateConfig = &servicesSSLInitiationProfile{}
 	_ resource.ResourceWithImportState    = &servicesSSLInitiationProfile{}
 	_ resource.ResourceWithUpgradeState   = &servicesSSLInitiationProfile{}
+	_ resource.ResourceWithIdentity       = &servicesSSLInitiationProfile{}
 )
 
 type servicesSSLInitiationProfile struct {
@@ -204,6 +205,21 @@ func (rsc *servicesSSLInitiationProfile) Schema(
 	}
 }
 
+func (rsc *servicesSSLInitiationProfile) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *servicesSSLInitiationProfile) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Profile name (Profile identifier).",
+		},
+	}
+}
+
 type servicesSSLInitiationProfileData struct {
 	ID                 types.String                              `tfsdk:"id"`
 	Name               types.String                              `tfsdk:"name"`
@@ -384,6 +400,10 @@ func (rsc *servicesSSLInitiationProfile) Delete(
 func (rsc *servicesSSLInitiationProfile) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data servicesSSLInitiationProfileData
 
 	var _ resourceDataReadFrom1String = &data
@@ -398,12 +418,12 @@ func (rsc *servicesSSLInitiationProfile) ImportState(
 }
 
 func checkServicesSSLInitiationProfileExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"services ssl initiation profile \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"services ssl initiation profile \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -423,7 +443,7 @@ func (rscData *servicesSSLInitiationProfileData) nullID() bool {
 }
 
 func (rscData *servicesSSLInitiationProfileData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -474,14 +494,14 @@ func (rscData *servicesSSLInitiationProfileData) set(
 		}
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *servicesSSLInitiationProfileData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"services ssl initiation profile \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"services ssl initiation profile \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -534,11 +554,11 @@ func (rscData *servicesSSLInitiationProfileData) read(
 }
 
 func (rscData *servicesSSLInitiationProfileData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete services ssl initiation profile \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
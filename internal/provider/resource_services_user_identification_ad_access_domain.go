@@ -32,6 +32,7 @@ var (
 	_ resource.ResourceWithValidateConfig = &servicesUserIdentificationADAccessDomain{}
 	_ resource.ResourceWithImportState    = &servicesUserIdentificationADAccessDomain{}
 	_ resource.ResourceWithUpgradeState   = &servicesUserIdentificationADAccessDomain{}
+	_ resource.ResourceWithIdentity       = &servicesUserIdentificationADAccessDomain{}
 )
 
 type servicesUserIdentificationADAccessDomain struct {
@@ -229,6 +230,21 @@ func (rsc *servicesUserIdentificationADAccessDomain) Schema(
 }
 
 //nolint:lll
+func (rsc *servicesUserIdentificationADAccessDomain) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *servicesUserIdentificationADAccessDomain) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Domain name.",
+		},
+	}
+}
+
 type servicesUserIdentificationADAccessDomainData struct {
 	ID                        types.String                                                            `tfsdk:"id"`
 	Name                      types.String                                                            `tfsdk:"name"`
@@ -446,6 +462,10 @@ func (rsc *servicesUserIdentificationADAccessDomain) Delete(
 func (rsc *servicesUserIdentificationADAccessDomain) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data servicesUserIdentificationADAccessDomainData
 
 	var _ resourceDataReadFrom1String = &data
@@ -460,12 +480,12 @@ func (rsc *servicesUserIdentificationADAccessDomain) ImportState(
 }
 
 func checkServicesUserIdentADAccessDomainExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"services user-identification active-directory-access domain " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"services user-identification active-directory-access domain "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -485,7 +505,7 @@ func (rscData *servicesUserIdentificationADAccessDomainData) nullID() bool {
 }
 
 func (rscData *servicesUserIdentificationADAccessDomainData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -514,7 +534,7 @@ func (rscData *servicesUserIdentificationADAccessDomainData) set(
 		configSet = append(configSet, rscData.UserGroupMappingLdap.configSet(setPrefix)...)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (block *servicesUserIdentificationADAccessDomainBlockIPUserMappingDiscoveryWmi) configSet(
@@ -563,10 +583,10 @@ func (block *servicesUserIdentificationADAccessDomainBlockUserGroupMappingLdap)
 }
 
 func (rscData *servicesUserIdentificationADAccessDomainData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"services user-identification active-directory-access domain " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"services user-identification active-directory-access domain "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -656,11 +676,11 @@ func (block *servicesUserIdentificationADAccessDomainBlockUserGroupMappingLdap)
 }
 
 func (rscData *servicesUserIdentificationADAccessDomainData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete services user-identification active-directory-access domain " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
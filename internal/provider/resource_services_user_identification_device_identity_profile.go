@@ -29,6 +29,7 @@ var (
 	_ resource.ResourceWithConfigure      = &servicesUserIdentificationDeviceIdentityProfile{}
 	_ resource.ResourceWithValidateConfig = &servicesUserIdentificationDeviceIdentityProfile{}
 	_ resource.ResourceWithImportState    = &servicesUserIdentificationDeviceIdentityProfile{}
+	_ resource.ResourceWithIdentity       = &servicesUserIdentificationDeviceIdentityProfile{}
 )
 
 type servicesUserIdentificationDeviceIdentityProfile struct {
@@ -143,6 +144,21 @@ func (rsc *servicesUserIdentificationDeviceIdentityProfile) Schema(
 	}
 }
 
+func (rsc *servicesUserIdentificationDeviceIdentityProfile) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *servicesUserIdentificationDeviceIdentityProfile) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "End-user-profile profile-name.",
+		},
+	}
+}
+
 type servicesUserIdentificationDeviceIdentityProfileData struct {
 	ID        types.String                                                    `tfsdk:"id"`
 	Name      types.String                                                    `tfsdk:"name"`
@@ -326,6 +342,10 @@ func (rsc *servicesUserIdentificationDeviceIdentityProfile) Delete(
 func (rsc *servicesUserIdentificationDeviceIdentityProfile) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data servicesUserIdentificationDeviceIdentityProfileData
 
 	var _ resourceDataReadFrom1String = &data
@@ -340,12 +360,12 @@ func (rsc *servicesUserIdentificationDeviceIdentityProfile) ImportState(
 }
 
 func checkServicesUserIdentDeviceIdentityProfileExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"services user-identification device-information end-user-profile profile-name " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"services user-identification device-information end-user-profile profile-name "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -365,7 +385,7 @@ func (rscData *servicesUserIdentificationDeviceIdentityProfileData) nullID() boo
 }
 
 func (rscData *servicesUserIdentificationDeviceIdentityProfileData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -390,14 +410,14 @@ func (rscData *servicesUserIdentificationDeviceIdentityProfileData) set(
 		}
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *servicesUserIdentificationDeviceIdentityProfileData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"services user-identification device-information end-user-profile profile-name " + name +
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"services user-identification device-information end-user-profile profile-name "+name+
 		junos.PipeDisplaySetRelative,
 	)
 	if err != nil {
@@ -434,11 +454,11 @@ func (rscData *servicesUserIdentificationDeviceIdentityProfileData) read(
 }
 
 func (rscData *servicesUserIdentificationDeviceIdentityProfileData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete services user-identification device-information end-user-profile profile-name " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
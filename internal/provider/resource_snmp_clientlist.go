@@ -24,6 +24,7 @@ var (
 	_ resource.Resource                = &snmpClientlist{}
 	_ resource.ResourceWithConfigure   = &snmpClientlist{}
 	_ resource.ResourceWithImportState = &snmpClientlist{}
+	_ resource.ResourceWithIdentity    = &snmpClientlist{}
 )
 
 type snmpClientlist struct {
@@ -108,6 +109,21 @@ func (rsc *snmpClientlist) Schema(
 	}
 }
 
+func (rsc *snmpClientlist) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *snmpClientlist) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "The name of snmp client-list.",
+		},
+	}
+}
+
 type snmpClientlistData struct {
 	ID     types.String   `tfsdk:"id"`
 	Name   types.String   `tfsdk:"name"`
@@ -245,6 +261,10 @@ func (rsc *snmpClientlist) Delete(
 func (rsc *snmpClientlist) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data snmpClientlistData
 
 	var _ resourceDataReadFrom1String = &data
@@ -259,12 +279,12 @@ func (rsc *snmpClientlist) ImportState(
 }
 
 func checkSnmpClientlistExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"snmp client-list \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"snmp client-list \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -284,7 +304,7 @@ func (rscData *snmpClientlistData) nullID() bool {
 }
 
 func (rscData *snmpClientlistData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -297,14 +317,14 @@ func (rscData *snmpClientlistData) set(
 		configSet = append(configSet, setPrefix+v.ValueString())
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *snmpClientlistData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"snmp client-list \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"snmp client-list \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -329,11 +349,11 @@ func (rscData *snmpClientlistData) read(
 }
 
 func (rscData *snmpClientlistData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete snmp client-list \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
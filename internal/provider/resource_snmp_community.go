@@ -29,6 +29,7 @@ var (
 	_ resource.ResourceWithConfigure      = &snmpCommunity{}
 	_ resource.ResourceWithValidateConfig = &snmpCommunity{}
 	_ resource.ResourceWithImportState    = &snmpCommunity{}
+	_ resource.ResourceWithIdentity       = &snmpCommunity{}
 )
 
 type snmpCommunity struct {
@@ -181,6 +182,21 @@ func (rsc *snmpCommunity) Schema(
 	}
 }
 
+func (rsc *snmpCommunity) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *snmpCommunity) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "The name of snmp community.",
+		},
+	}
+}
+
 type snmpCommunityData struct {
 	ID                     types.String                        `tfsdk:"id"`
 	Name                   types.String                        `tfsdk:"name"`
@@ -407,6 +423,10 @@ func (rsc *snmpCommunity) Delete(
 func (rsc *snmpCommunity) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data snmpCommunityData
 
 	var _ resourceDataReadFrom1String = &data
@@ -421,12 +441,12 @@ func (rsc *snmpCommunity) ImportState(
 }
 
 func checkSnmpCommunityExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"snmp community \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"snmp community \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -446,7 +466,7 @@ func (rscData *snmpCommunityData) nullID() bool {
 }
 
 func (rscData *snmpCommunityData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -495,14 +515,14 @@ func (rscData *snmpCommunityData) set(
 		}
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *snmpCommunityData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"snmp community \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"snmp community \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -549,11 +569,11 @@ func (rscData *snmpCommunityData) read(
 }
 
 func (rscData *snmpCommunityData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete snmp community \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -24,6 +24,7 @@ var (
 	_ resource.Resource                = &snmpV3Community{}
 	_ resource.ResourceWithConfigure   = &snmpV3Community{}
 	_ resource.ResourceWithImportState = &snmpV3Community{}
+	_ resource.ResourceWithIdentity    = &snmpV3Community{}
 )
 
 type snmpV3Community struct {
@@ -128,6 +129,21 @@ func (rsc *snmpV3Community) Schema(
 	}
 }
 
+func (rsc *snmpV3Community) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *snmpV3Community) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "community_index",
+			description: "Unique index value in this community table entry.",
+		},
+	}
+}
+
 type snmpV3CommunityData struct {
 	ID             types.String `tfsdk:"id"`
 	CommunityIndex types.String `tfsdk:"community_index"`
@@ -268,6 +284,10 @@ func (rsc *snmpV3Community) Delete(
 func (rsc *snmpV3Community) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data snmpV3CommunityData
 
 	var _ resourceDataReadFrom1String = &data
@@ -282,12 +302,12 @@ func (rsc *snmpV3Community) ImportState(
 }
 
 func checkSnmpV3CommunityExists(
-	_ context.Context, communityIndex string, junSess *junos.Session,
+	ctx context.Context, communityIndex string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"snmp v3 snmp-community \"" + communityIndex + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"snmp v3 snmp-community \""+communityIndex+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -307,7 +327,7 @@ func (rscData *snmpV3CommunityData) nullID() bool {
 }
 
 func (rscData *snmpV3CommunityData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -326,14 +346,14 @@ func (rscData *snmpV3CommunityData) set(
 		configSet = append(configSet, setPrefix+"tag \""+v+"\"")
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *snmpV3CommunityData) read(
-	_ context.Context, communityIndex string, junSess *junos.Session,
+	ctx context.Context, communityIndex string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"snmp v3 snmp-community \"" + communityIndex + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"snmp v3 snmp-community \""+communityIndex+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -368,11 +388,11 @@ func (rscData *snmpV3CommunityData) read(
 }
 
 func (rscData *snmpV3CommunityData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete snmp v3 snmp-community \"" + rscData.CommunityIndex.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -30,6 +30,7 @@ var (
 	_ resource.ResourceWithConfigure      = &snmpV3UsmUser{}
 	_ resource.ResourceWithValidateConfig = &snmpV3UsmUser{}
 	_ resource.ResourceWithImportState    = &snmpV3UsmUser{}
+	_ resource.ResourceWithIdentity       = &snmpV3UsmUser{}
 )
 
 type snmpV3UsmUser struct {
@@ -193,6 +194,30 @@ func (rsc *snmpV3UsmUser) Schema(
 	}
 }
 
+func (rsc *snmpV3UsmUser) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *snmpV3UsmUser) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "engine_type",
+			description: "Local or remote engine user.",
+		},
+		{
+			name:          "engine_id",
+			description:   "Remote engine id.",
+			omitEmptyInID: true,
+		},
+		{
+			name:        "name",
+			description: "The name of snmp v3 USM user.",
+		},
+	}
+}
+
 type snmpV3UsmUserData struct {
 	ID                     types.String `tfsdk:"id"`
 	Name                   types.String `tfsdk:"name"`
@@ -584,6 +609,10 @@ func (rsc *snmpV3UsmUser) Delete(
 func (rsc *snmpV3UsmUser) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	junSess, err := rsc.junosClient().StartNewSession(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
@@ -631,10 +660,11 @@ func (rsc *snmpV3UsmUser) ImportState(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
 }
 
 func checkSnmpV3UsmUserExists(
-	_ context.Context, name, engineType, engineID string, junSess *junos.Session,
+	ctx context.Context, name, engineType, engineID string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
@@ -647,8 +677,8 @@ func checkSnmpV3UsmUserExists(
 	default:
 		return false, fmt.Errorf("can't check config with engine_type %q", engineType)
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"user \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"user \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -677,7 +707,7 @@ func (rscData *snmpV3UsmUserData) nullID() bool {
 }
 
 func (rscData *snmpV3UsmUserData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -743,11 +773,11 @@ func (rscData *snmpV3UsmUserData) set(
 		configSet = append(configSet, setPrefix+"privacy-none")
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *snmpV3UsmUserData) read(
-	_ context.Context, name, engineType, engineID string, junSess *junos.Session,
+	ctx context.Context, name, engineType, engineID string, junSess *junos.Session,
 ) error {
 	showPrefix := junos.CmdShowConfig + "snmp v3 usm "
 	switch engineType {
@@ -759,8 +789,8 @@ func (rscData *snmpV3UsmUserData) read(
 		}
 		showPrefix += "local-engine "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"user \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"user \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -815,8 +845,8 @@ func (rscData *snmpV3UsmUserData) readPrivateToState(
 	default:
 		showPrefix += "local-engine "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"user \"" + rscData.Name.ValueString() + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"user \""+rscData.Name.ValueString()+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -863,7 +893,7 @@ func (rscData *snmpV3UsmUserData) readPrivateToState(
 }
 
 func (rscData *snmpV3UsmUserData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	delPrefix := junos.DeleteLS + "snmp v3 usm "
 	switch v := rscData.EngineType.ValueString(); v {
@@ -879,5 +909,5 @@ func (rscData *snmpV3UsmUserData) del(
 		delPrefix + "user \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -28,6 +28,7 @@ var (
 	_ resource.ResourceWithConfigure      = &snmpV3VacmAccessgroup{}
 	_ resource.ResourceWithValidateConfig = &snmpV3VacmAccessgroup{}
 	_ resource.ResourceWithImportState    = &snmpV3VacmAccessgroup{}
+	_ resource.ResourceWithIdentity       = &snmpV3VacmAccessgroup{}
 )
 
 type snmpV3VacmAccessgroup struct {
@@ -227,6 +228,21 @@ func (rsc *snmpV3VacmAccessgroup) Schema(
 	}
 }
 
+func (rsc *snmpV3VacmAccessgroup) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *snmpV3VacmAccessgroup) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "SNMPv3 VACM group name.",
+		},
+	}
+}
+
 type snmpV3VacmAccessgroupData struct {
 	ID                   types.String                                               `tfsdk:"id"`
 	Name                 types.String                                               `tfsdk:"name"`
@@ -520,6 +536,10 @@ func (rsc *snmpV3VacmAccessgroup) Delete(
 func (rsc *snmpV3VacmAccessgroup) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data snmpV3VacmAccessgroupData
 
 	var _ resourceDataReadFrom1String = &data
@@ -534,12 +554,12 @@ func (rsc *snmpV3VacmAccessgroup) ImportState(
 }
 
 func checkSnmpV3VacmAccessgroupExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"snmp v3 vacm access group \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"snmp v3 vacm access group \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -559,7 +579,7 @@ func (rscData *snmpV3VacmAccessgroupData) nullID() bool {
 }
 
 func (rscData *snmpV3VacmAccessgroupData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -636,14 +656,14 @@ func (rscData *snmpV3VacmAccessgroupData) set(
 		}
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *snmpV3VacmAccessgroupData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"snmp v3 vacm access group \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"snmp v3 vacm access group \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -719,11 +739,11 @@ func (rscData *snmpV3VacmAccessgroupData) read(
 }
 
 func (rscData *snmpV3VacmAccessgroupData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete snmp v3 vacm access group \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
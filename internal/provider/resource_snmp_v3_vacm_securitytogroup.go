@@ -26,6 +26,7 @@ var (
 	_ resource.Resource                = &snmpV3VacmSecuritytogroup{}
 	_ resource.ResourceWithConfigure   = &snmpV3VacmSecuritytogroup{}
 	_ resource.ResourceWithImportState = &snmpV3VacmSecuritytogroup{}
+	_ resource.ResourceWithIdentity    = &snmpV3VacmSecuritytogroup{}
 )
 
 type snmpV3VacmSecuritytogroup struct {
@@ -117,6 +118,25 @@ func (rsc *snmpV3VacmSecuritytogroup) Schema(
 	}
 }
 
+func (rsc *snmpV3VacmSecuritytogroup) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *snmpV3VacmSecuritytogroup) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "model",
+			description: "Security model context for group assignment.",
+		},
+		{
+			name:        "name",
+			description: "Security name to assign to group.",
+		},
+	}
+}
+
 type snmpV3VacmSecuritytogroupData struct {
 	ID    types.String `tfsdk:"id"`
 	Model types.String `tfsdk:"model"`
@@ -274,6 +294,10 @@ func (rsc *snmpV3VacmSecuritytogroup) Delete(
 func (rsc *snmpV3VacmSecuritytogroup) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data snmpV3VacmSecuritytogroupData
 
 	var _ resourceDataReadFrom2String = &data
@@ -289,12 +313,12 @@ func (rsc *snmpV3VacmSecuritytogroup) ImportState(
 }
 
 func checkSnmpV3VacmSecuritytogroupExists(
-	_ context.Context, model, name string, junSess *junos.Session,
+	ctx context.Context, model, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"snmp v3 vacm security-to-group security-model " + model + " security-name \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"snmp v3 vacm security-to-group security-model "+model+" security-name \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -314,7 +338,7 @@ func (rscData *snmpV3VacmSecuritytogroupData) nullID() bool {
 }
 
 func (rscData *snmpV3VacmSecuritytogroupData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -328,14 +352,14 @@ func (rscData *snmpV3VacmSecuritytogroupData) set(
 		return path.Root("group"), errors.New("group must be specified")
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *snmpV3VacmSecuritytogroupData) read(
-	_ context.Context, model, name string, junSess *junos.Session,
+	ctx context.Context, model, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"snmp v3 vacm security-to-group security-model " + model + " security-name \"" + name + "\"" +
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"snmp v3 vacm security-to-group security-model "+model+" security-name \""+name+"\""+
 		junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
@@ -362,12 +386,12 @@ func (rscData *snmpV3VacmSecuritytogroupData) read(
 }
 
 func (rscData *snmpV3VacmSecuritytogroupData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete snmp v3 vacm security-to-group " +
 			"security-model " + rscData.Model.ValueString() + " security-name \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
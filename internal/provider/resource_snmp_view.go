@@ -26,6 +26,7 @@ var (
 	_ resource.ResourceWithConfigure      = &snmpView{}
 	_ resource.ResourceWithValidateConfig = &snmpView{}
 	_ resource.ResourceWithImportState    = &snmpView{}
+	_ resource.ResourceWithIdentity       = &snmpView{}
 )
 
 type snmpView struct {
@@ -124,6 +125,21 @@ func (rsc *snmpView) Schema(
 	}
 }
 
+func (rsc *snmpView) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *snmpView) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "The name of snmp view.",
+		},
+	}
+}
+
 type snmpViewData struct {
 	ID         types.String   `tfsdk:"id"`
 	Name       types.String   `tfsdk:"name"`
@@ -288,6 +304,10 @@ func (rsc *snmpView) Delete(
 func (rsc *snmpView) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data snmpViewData
 
 	var _ resourceDataReadFrom1String = &data
@@ -302,12 +322,12 @@ func (rsc *snmpView) ImportState(
 }
 
 func checkSnmpViewExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"snmp view \"" + name + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"snmp view \""+name+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -327,7 +347,7 @@ func (rscData *snmpViewData) nullID() bool {
 }
 
 func (rscData *snmpViewData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -341,14 +361,14 @@ func (rscData *snmpViewData) set(
 		configSet = append(configSet, setPrefix+"oid \""+v.ValueString()+"\" exclude")
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *snmpViewData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"snmp view \"" + name + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"snmp view \""+name+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -378,11 +398,11 @@ func (rscData *snmpViewData) read(
 }
 
 func (rscData *snmpViewData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete snmp view \"" + rscData.Name.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
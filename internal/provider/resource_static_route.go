@@ -32,6 +32,7 @@ var (
 	_ resource.ResourceWithConfigure      = &staticRoute{}
 	_ resource.ResourceWithValidateConfig = &staticRoute{}
 	_ resource.ResourceWithImportState    = &staticRoute{}
+	_ resource.ResourceWithIdentity       = &staticRoute{}
 )
 
 type staticRoute struct {
@@ -339,6 +340,26 @@ func (rsc *staticRoute) Schema(
 	}
 }
 
+func (rsc *staticRoute) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *staticRoute) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "destination",
+			description: "Destination prefix.",
+		},
+		{
+			name:         "routing_instance",
+			description:  "Routing instance for static route.",
+			defaultValue: junos.DefaultW,
+		},
+	}
+}
+
 type staticRouteData struct {
 	ID                       types.String                       `tfsdk:"id"`
 	Destination              types.String                       `tfsdk:"destination"`
@@ -786,6 +807,10 @@ func (rsc *staticRoute) Delete(
 func (rsc *staticRoute) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data staticRouteData
 
 	var _ resourceDataReadFrom2String = &data
@@ -801,7 +826,7 @@ func (rsc *staticRoute) ImportState(
 }
 
 func checkStaticRouteExists(
-	_ context.Context, destination, routingInstance string, junSess *junos.Session,
+	ctx context.Context, destination, routingInstance string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
@@ -818,8 +843,8 @@ func checkStaticRouteExists(
 			showPrefix += "rib " + routingInstance + ".inet6.0 "
 		}
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"static route " + destination + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"static route "+destination+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -843,7 +868,7 @@ func (rscData *staticRouteData) nullID() bool {
 }
 
 func (rscData *staticRouteData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -957,11 +982,11 @@ func (rscData *staticRouteData) set(
 		configSet = append(configSet, setPrefix+"no-retain")
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *staticRouteData) read(
-	_ context.Context, destination, routingInstance string, junSess *junos.Session,
+	ctx context.Context, destination, routingInstance string, junSess *junos.Session,
 ) error {
 	showPrefix := junos.CmdShowConfig
 	switch routingInstance {
@@ -976,8 +1001,8 @@ func (rscData *staticRouteData) read(
 			showPrefix += "rib " + routingInstance + ".inet6.0 "
 		}
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"static route " + destination + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"static route "+destination+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -1082,7 +1107,7 @@ func (rscData *staticRouteData) read(
 }
 
 func (rscData *staticRouteData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	delPrefix := junos.DeleteLS
 	switch routingInstance := rscData.RoutingInstance.ValueString(); routingInstance {
@@ -1102,5 +1127,5 @@ func (rscData *staticRouteData) del(
 		delPrefix + "static route " + rscData.Destination.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
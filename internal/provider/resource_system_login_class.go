@@ -32,6 +32,7 @@ var (
 	_ resource.ResourceWithConfigure      = &systemLoginClass{}
 	_ resource.ResourceWithValidateConfig = &systemLoginClass{}
 	_ resource.ResourceWithImportState    = &systemLoginClass{}
+	_ resource.ResourceWithIdentity       = &systemLoginClass{}
 )
 
 type systemLoginClass struct {
@@ -367,6 +368,21 @@ func (rsc *systemLoginClass) Schema(
 	}
 }
 
+func (rsc *systemLoginClass) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *systemLoginClass) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "The name of system login class.",
+		},
+	}
+}
+
 type systemLoginClassData struct {
 	ID                        types.String   `tfsdk:"id"                          tfdata:"skip_isempty"`
 	Name                      types.String   `tfsdk:"name"                        tfdata:"skip_isempty"`
@@ -651,6 +667,10 @@ func (rsc *systemLoginClass) Delete(
 func (rsc *systemLoginClass) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data systemLoginClassData
 
 	var _ resourceDataReadFrom1String = &data
@@ -665,12 +685,12 @@ func (rsc *systemLoginClass) ImportState(
 }
 
 func checkSystemLoginClassExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"system login class " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"system login class "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -690,7 +710,7 @@ func (rscData *systemLoginClassData) nullID() bool {
 }
 
 func (rscData *systemLoginClassData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -776,14 +796,14 @@ func (rscData *systemLoginClassData) set(
 		configSet = append(configSet, setPrefix+"tenant \""+v+"\"")
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *systemLoginClassData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"system login class " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"system login class "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -866,11 +886,11 @@ func (rscData *systemLoginClassData) read(
 }
 
 func (rscData *systemLoginClassData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete system login class " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
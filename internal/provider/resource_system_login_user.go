@@ -37,6 +37,7 @@ var (
 	_ resource.ResourceWithValidateConfig = &systemLoginUser{}
 	_ resource.ResourceWithImportState    = &systemLoginUser{}
 	_ resource.ResourceWithUpgradeState   = &systemLoginUser{}
+	_ resource.ResourceWithIdentity       = &systemLoginUser{}
 )
 
 type systemLoginUser struct {
@@ -194,6 +195,21 @@ func (rsc *systemLoginUser) Schema(
 	}
 }
 
+func (rsc *systemLoginUser) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *systemLoginUser) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "The name of system login user.",
+		},
+	}
+}
+
 type systemLoginUserData struct {
 	ID             types.String                        `tfsdk:"id"`
 	Name           types.String                        `tfsdk:"name"`
@@ -506,6 +522,10 @@ func (rsc *systemLoginUser) Delete(
 func (rsc *systemLoginUser) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data systemLoginUserData
 
 	var _ resourceDataReadFrom1String = &data
@@ -520,12 +540,12 @@ func (rsc *systemLoginUser) ImportState(
 }
 
 func checkSystemLoginUserExists(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"system login user " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"system login user "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -545,7 +565,7 @@ func (rscData *systemLoginUserData) nullID() bool {
 }
 
 func (rscData *systemLoginUserData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -599,14 +619,14 @@ func (rscData *systemLoginUserData) set(
 		}
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *systemLoginUserData) read(
-	_ context.Context, name string, junSess *junos.Session,
+	ctx context.Context, name string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"system login user " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"system login user "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -659,7 +679,7 @@ func (rscData *systemLoginUserData) read(
 }
 
 func (rscData *systemLoginUserData) readComputed(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	defer func() {
 		// set unknown to null if still unknown after reading config
@@ -672,8 +692,8 @@ func (rscData *systemLoginUserData) readComputed(
 		return nil
 	}
 
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"system login user " + rscData.Name.ValueString() + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"system login user "+rscData.Name.ValueString()+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -701,8 +721,8 @@ func (rscData *systemLoginUserData) readComputed(
 func (rscData *systemLoginUserData) readPrivateToState(
 	ctx context.Context, junSess *junos.Session, private privateStateSetter,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"system login user " + rscData.Name.ValueString() + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"system login user "+rscData.Name.ValueString()+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -732,11 +752,11 @@ func (rscData *systemLoginUserData) readPrivateToState(
 }
 
 func (rscData *systemLoginUserData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete system login user " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -29,6 +29,7 @@ var (
 	_ resource.ResourceWithConfigure      = &systemNtpServer{}
 	_ resource.ResourceWithValidateConfig = &systemNtpServer{}
 	_ resource.ResourceWithImportState    = &systemNtpServer{}
+	_ resource.ResourceWithIdentity       = &systemNtpServer{}
 )
 
 type systemNtpServer struct {
@@ -163,6 +164,21 @@ func (rsc *systemNtpServer) Schema(
 	}
 }
 
+func (rsc *systemNtpServer) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *systemNtpServer) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "address",
+			description: "Address of server.",
+		},
+	}
+}
+
 type systemNtpServerData struct {
 	ID              types.String             `tfsdk:"id"`
 	Address         types.String             `tfsdk:"address"`
@@ -348,6 +364,10 @@ func (rsc *systemNtpServer) Delete(
 func (rsc *systemNtpServer) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data systemNtpServerData
 
 	var _ resourceDataReadFrom1String = &data
@@ -362,12 +382,12 @@ func (rsc *systemNtpServer) ImportState(
 }
 
 func checkSystemNtpServerExists(
-	_ context.Context, address string, junSess *junos.Session,
+	ctx context.Context, address string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"system ntp server " + address + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"system ntp server "+address+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -387,7 +407,7 @@ func (rscData *systemNtpServerData) nullID() bool {
 }
 
 func (rscData *systemNtpServerData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -425,14 +445,14 @@ func (rscData *systemNtpServerData) set(
 		}
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *systemNtpServerData) read(
-	_ context.Context, address string, junSess *junos.Session,
+	ctx context.Context, address string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"system ntp server " + address + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"system ntp server "+address+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -485,11 +505,11 @@ func (rscData *systemNtpServerData) read(
 }
 
 func (rscData *systemNtpServerData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete system ntp server " + rscData.Address.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
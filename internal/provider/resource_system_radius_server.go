@@ -27,6 +27,7 @@ var (
 	_ resource.Resource                = &systemRadiusServer{}
 	_ resource.ResourceWithConfigure   = &systemRadiusServer{}
 	_ resource.ResourceWithImportState = &systemRadiusServer{}
+	_ resource.ResourceWithIdentity    = &systemRadiusServer{}
 )
 
 type systemRadiusServer struct {
@@ -194,6 +195,21 @@ func (rsc *systemRadiusServer) Schema(
 	}
 }
 
+func (rsc *systemRadiusServer) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *systemRadiusServer) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "address",
+			description: "RADIUS server address.",
+		},
+	}
+}
+
 type systemRadiusServerData struct {
 	ID                      types.String `tfsdk:"id"`
 	Address                 types.String `tfsdk:"address"`
@@ -335,6 +351,10 @@ func (rsc *systemRadiusServer) Delete(
 func (rsc *systemRadiusServer) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data systemRadiusServerData
 
 	var _ resourceDataReadFrom1String = &data
@@ -349,12 +369,12 @@ func (rsc *systemRadiusServer) ImportState(
 }
 
 func checkSystemRadiusServerExists(
-	_ context.Context, address string, junSess *junos.Session,
+	ctx context.Context, address string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"system radius-server " + address + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"system radius-server "+address+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -374,7 +394,7 @@ func (rscData *systemRadiusServerData) nullID() bool {
 }
 
 func (rscData *systemRadiusServerData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -429,14 +449,14 @@ func (rscData *systemRadiusServerData) set(
 			utils.ConvI64toa(rscData.Timeout.ValueInt64()))
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *systemRadiusServerData) read(
-	_ context.Context, address string, junSess *junos.Session,
+	ctx context.Context, address string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"system radius-server " + address + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"system radius-server "+address+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -519,11 +539,11 @@ func (rscData *systemRadiusServerData) read(
 }
 
 func (rscData *systemRadiusServerData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete system radius-server " + rscData.Address.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -34,6 +34,7 @@ var (
 	_ resource.ResourceWithValidateConfig = &systemServicesDhcpLocalserverGroup{}
 	_ resource.ResourceWithImportState    = &systemServicesDhcpLocalserverGroup{}
 	_ resource.ResourceWithUpgradeState   = &systemServicesDhcpLocalserverGroup{}
+	_ resource.ResourceWithIdentity       = &systemServicesDhcpLocalserverGroup{}
 )
 
 type systemServicesDhcpLocalserverGroup struct {
@@ -487,6 +488,31 @@ func (rsc *systemServicesDhcpLocalserverGroup) Schema(
 }
 
 //nolint:lll
+func (rsc *systemServicesDhcpLocalserverGroup) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *systemServicesDhcpLocalserverGroup) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "Group name.",
+		},
+		{
+			name:         "routing_instance",
+			description:  "Routing instance if not root level.",
+			defaultValue: junos.DefaultW,
+		},
+		{
+			name:         "version",
+			description:  "Version for DHCP or DHCPv6.",
+			defaultValue: "v4",
+		},
+	}
+}
+
 type systemServicesDhcpLocalserverGroupData struct {
 	ID                                   types.String                                                `tfsdk:"id"                                       tfdata:"skip_isempty"`
 	Name                                 types.String                                                `tfsdk:"name"                                     tfdata:"skip_isempty"`
@@ -1626,6 +1652,10 @@ func (rsc *systemServicesDhcpLocalserverGroup) Delete(
 func (rsc *systemServicesDhcpLocalserverGroup) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data systemServicesDhcpLocalserverGroupData
 
 	var _ resourceDataReadFrom3String = &data
@@ -1641,7 +1671,7 @@ func (rsc *systemServicesDhcpLocalserverGroup) ImportState(
 }
 
 func checkSystemServicesDhcpLocalserverGroupExists(
-	_ context.Context, name, routingInstance, version string, junSess *junos.Session,
+	ctx context.Context, name, routingInstance, version string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
@@ -1653,8 +1683,8 @@ func checkSystemServicesDhcpLocalserverGroupExists(
 	if version == "v6" {
 		showPrefix += "dhcpv6 "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"group " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"group "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -1685,7 +1715,7 @@ func (rscData *systemServicesDhcpLocalserverGroupData) nullID() bool {
 }
 
 func (rscData *systemServicesDhcpLocalserverGroupData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -1884,7 +1914,7 @@ func (rscData *systemServicesDhcpLocalserverGroupData) set(
 		configSet = append(configSet, rscData.Reconfigure.configSet(setPrefix)...)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (block *systemServicesDhcpLocalserverGroupBlockInterface) configSet(
@@ -2197,7 +2227,7 @@ func (block *systemServicesDhcpLocalserverGroupBlockReconfigure) configSet(setPr
 }
 
 func (rscData *systemServicesDhcpLocalserverGroupData) read(
-	_ context.Context, name, routingInstance, version string, junSess *junos.Session,
+	ctx context.Context, name, routingInstance, version string, junSess *junos.Session,
 ) error {
 	showPrefix := junos.CmdShowConfig
 	if routingInstance != "" && routingInstance != junos.DefaultW {
@@ -2207,8 +2237,8 @@ func (rscData *systemServicesDhcpLocalserverGroupData) read(
 	if version == "v6" {
 		showPrefix += "dhcpv6 "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"group " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"group "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -2535,7 +2565,7 @@ func (block *systemServicesDhcpLocalserverGroupBlockReconfigure) read(itemTrim s
 }
 
 func (rscData *systemServicesDhcpLocalserverGroupData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	delPrefix := junos.DeleteLS
 	if v := rscData.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
@@ -2550,5 +2580,5 @@ func (rscData *systemServicesDhcpLocalserverGroupData) del(
 		delPrefix + "group " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -33,6 +33,7 @@ var (
 	_ resource.ResourceWithValidateConfig = &systemSyslogFile{}
 	_ resource.ResourceWithImportState    = &systemSyslogFile{}
 	_ resource.ResourceWithUpgradeState   = &systemSyslogFile{}
+	_ resource.ResourceWithIdentity       = &systemSyslogFile{}
 )
 
 type systemSyslogFile struct {
@@ -366,6 +367,21 @@ func (rsc *systemSyslogFile) Schema(
 	}
 }
 
+func (rsc *systemSyslogFile) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *systemSyslogFile) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "filename",
+			description: "Name of file in which to log data.",
+		},
+	}
+}
+
 type systemSyslogFileData struct {
 	ID                          types.String                         `tfsdk:"id"`
 	Filename                    types.String                         `tfsdk:"filename"`
@@ -646,6 +662,10 @@ func (rsc *systemSyslogFile) Delete(
 func (rsc *systemSyslogFile) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data systemSyslogFileData
 
 	var _ resourceDataReadFrom1String = &data
@@ -660,12 +680,12 @@ func (rsc *systemSyslogFile) ImportState(
 }
 
 func checkSystemSyslogFileExists(
-	_ context.Context, filename string, junSess *junos.Session,
+	ctx context.Context, filename string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"system syslog file \"" + filename + "\"" + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"system syslog file \""+filename+"\""+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -685,7 +705,7 @@ func (rscData *systemSyslogFileData) nullID() bool {
 }
 
 func (rscData *systemSyslogFileData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -806,14 +826,14 @@ func (rscData *systemSyslogFileData) set(
 		}
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *systemSyslogFileData) read(
-	_ context.Context, filename string, junSess *junos.Session,
+	ctx context.Context, filename string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"system syslog file \"" + filename + "\"" + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"system syslog file \""+filename+"\""+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -944,11 +964,11 @@ func (rscData *systemSyslogFileData) read(
 }
 
 func (rscData *systemSyslogFileData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete system syslog file \"" + rscData.Filename.ValueString() + "\"",
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -30,6 +30,7 @@ var (
 	_ resource.ResourceWithConfigure    = &systemSyslogHost{}
 	_ resource.ResourceWithImportState  = &systemSyslogHost{}
 	_ resource.ResourceWithUpgradeState = &systemSyslogHost{}
+	_ resource.ResourceWithIdentity     = &systemSyslogHost{}
 )
 
 type systemSyslogHost struct {
@@ -296,6 +297,21 @@ func (rsc *systemSyslogHost) Schema(
 	}
 }
 
+func (rsc *systemSyslogHost) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *systemSyslogHost) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "host",
+			description: "Host to be notified.",
+		},
+	}
+}
+
 type systemSyslogHostData struct {
 	ID                          types.String                         `tfsdk:"id"`
 	Host                        types.String                         `tfsdk:"host"`
@@ -453,6 +469,10 @@ func (rsc *systemSyslogHost) Delete(
 func (rsc *systemSyslogHost) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data systemSyslogHostData
 
 	var _ resourceDataReadFrom1String = &data
@@ -467,12 +487,12 @@ func (rsc *systemSyslogHost) ImportState(
 }
 
 func checkSystemSyslogHostExists(
-	_ context.Context, host string, junSess *junos.Session,
+	ctx context.Context, host string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"system syslog host " + host + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"system syslog host "+host+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -492,7 +512,7 @@ func (rscData *systemSyslogHostData) nullID() bool {
 }
 
 func (rscData *systemSyslogHostData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -579,14 +599,14 @@ func (rscData *systemSyslogHostData) set(
 		}
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *systemSyslogHostData) read(
-	_ context.Context, host string, junSess *junos.Session,
+	ctx context.Context, host string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"system syslog host " + host + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"system syslog host "+host+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -669,11 +689,11 @@ func (rscData *systemSyslogHostData) read(
 }
 
 func (rscData *systemSyslogHostData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete system syslog host " + rscData.Host.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
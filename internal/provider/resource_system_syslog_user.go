@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &systemSyslogUser{}
 	_ resource.ResourceWithConfigure   = &systemSyslogUser{}
 	_ resource.ResourceWithImportState = &systemSyslogUser{}
+	_ resource.ResourceWithIdentity    = &systemSyslogUser{}
 )
 
 type systemSyslogUser struct {
@@ -233,6 +234,21 @@ func (rsc *systemSyslogUser) Schema(
 	}
 }
 
+func (rsc *systemSyslogUser) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *systemSyslogUser) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "username",
+			description: "Name of user to notify (or `*` for all).",
+		},
+	}
+}
+
 type systemSyslogUserData struct {
 	ID                          types.String   `tfsdk:"id"`
 	Username                    types.String   `tfsdk:"username"`
@@ -379,6 +395,10 @@ func (rsc *systemSyslogUser) Delete(
 func (rsc *systemSyslogUser) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data systemSyslogUserData
 
 	var _ resourceDataReadFrom1String = &data
@@ -393,12 +413,12 @@ func (rsc *systemSyslogUser) ImportState(
 }
 
 func checkSystemSyslogUserExists(
-	_ context.Context, username string, junSess *junos.Session,
+	ctx context.Context, username string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"system syslog user " + username + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"system syslog user "+username+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -418,7 +438,7 @@ func (rscData *systemSyslogUserData) nullID() bool {
 }
 
 func (rscData *systemSyslogUserData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -480,14 +500,14 @@ func (rscData *systemSyslogUserData) set(
 		configSet = append(configSet, setPrefix+"user "+v)
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *systemSyslogUserData) read(
-	_ context.Context, username string, junSess *junos.Session,
+	ctx context.Context, username string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"system syslog user " + username + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"system syslog user "+username+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -548,11 +568,11 @@ func (rscData *systemSyslogUserData) read(
 }
 
 func (rscData *systemSyslogUserData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete system syslog user " + rscData.Username.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
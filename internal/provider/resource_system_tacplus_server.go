@@ -27,6 +27,7 @@ var (
 	_ resource.Resource                = &systemTacplusServer{}
 	_ resource.ResourceWithConfigure   = &systemTacplusServer{}
 	_ resource.ResourceWithImportState = &systemTacplusServer{}
+	_ resource.ResourceWithIdentity    = &systemTacplusServer{}
 )
 
 type systemTacplusServer struct {
@@ -143,6 +144,21 @@ func (rsc *systemTacplusServer) Schema(
 	}
 }
 
+func (rsc *systemTacplusServer) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *systemTacplusServer) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "address",
+			description: "TACACS+ authentication server address.",
+		},
+	}
+}
+
 type systemTacplusServerData struct {
 	ID               types.String `tfsdk:"id"`
 	Address          types.String `tfsdk:"address"`
@@ -277,6 +293,10 @@ func (rsc *systemTacplusServer) Delete(
 func (rsc *systemTacplusServer) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data systemTacplusServerData
 
 	var _ resourceDataReadFrom1String = &data
@@ -291,12 +311,12 @@ func (rsc *systemTacplusServer) ImportState(
 }
 
 func checkSystemTacplusServerExists(
-	_ context.Context, address string, junSess *junos.Session,
+	ctx context.Context, address string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"system tacplus-server " + address + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"system tacplus-server "+address+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -316,7 +336,7 @@ func (rscData *systemTacplusServerData) nullID() bool {
 }
 
 func (rscData *systemTacplusServerData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -346,14 +366,14 @@ func (rscData *systemTacplusServerData) set(
 			utils.ConvI64toa(rscData.Timeout.ValueInt64()))
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *systemTacplusServerData) read(
-	_ context.Context, address string, junSess *junos.Session,
+	ctx context.Context, address string, junSess *junos.Session,
 ) error {
-	showConfig, err := junSess.Command(junos.CmdShowConfig +
-		"system tacplus-server " + address + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, junos.CmdShowConfig+
+		"system tacplus-server "+address+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -398,11 +418,11 @@ func (rscData *systemTacplusServerData) read(
 }
 
 func (rscData *systemTacplusServerData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	configSet := []string{
 		"delete system tacplus-server " + rscData.Address.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -34,6 +34,7 @@ var (
 	_ resource.ResourceWithConfigure      = &vlan{}
 	_ resource.ResourceWithValidateConfig = &vlan{}
 	_ resource.ResourceWithImportState    = &vlan{}
+	_ resource.ResourceWithIdentity       = &vlan{}
 	_ resource.ResourceWithUpgradeState   = &vlan{}
 )
 
@@ -322,6 +323,26 @@ func (rsc *vlan) Schema(
 	}
 }
 
+func (rsc *vlan) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *vlan) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "The name of VLAN.",
+		},
+		{
+			name:         "routing_instance",
+			description:  "Routing instance for vlan if not root level.",
+			defaultValue: junos.DefaultW,
+		},
+	}
+}
+
 type vlanData struct {
 	ID                  types.String    `tfsdk:"id"                    tfdata:"skip_isempty"`
 	Name                types.String    `tfsdk:"name"                  tfdata:"skip_isempty"`
@@ -609,6 +630,10 @@ func (rsc *vlan) Delete(
 func (rsc *vlan) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	junSess, err := rsc.client.StartNewSession(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(tfdiag.StartSessErrSummary, err.Error())
@@ -643,10 +668,12 @@ func (rsc *vlan) ImportState(
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(defaultResourceIdentitySetFromState(ctx, rsc, resp.State, resp.Identity)...)
+	resp.Diagnostics.Append(defaultResourceAddManaged(ctx, rsc, resp.State)...)
 }
 
 func checkVlanExists(
-	_ context.Context, name, routingInstance string, junSess *junos.Session,
+	ctx context.Context, name, routingInstance string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
@@ -654,8 +681,8 @@ func checkVlanExists(
 	if routingInstance != "" && routingInstance != junos.DefaultW {
 		showPrefix += junos.RoutingInstancesWS + routingInstance + " "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"vlans " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"vlans "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -679,7 +706,7 @@ func (rscData *vlanData) nullID() bool {
 }
 
 func (rscData *vlanData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -769,18 +796,18 @@ func (rscData *vlanData) set(
 		}
 	}
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *vlanData) read(
-	_ context.Context, name, routingInstance string, junSess *junos.Session,
+	ctx context.Context, name, routingInstance string, junSess *junos.Session,
 ) error {
 	showPrefix := junos.CmdShowConfig
 	if routingInstance != "" && routingInstance != junos.DefaultW {
 		showPrefix += junos.RoutingInstancesWS + routingInstance + " "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"vlans " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"vlans "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -840,7 +867,7 @@ func (rscData *vlanData) read(
 					if err != nil {
 						return err
 					}
-					showConfigEvpn, err := junSess.Command(showPrefix + "protocols evpn" + junos.PipeDisplaySetRelative)
+					showConfigEvpn, err := junSess.Command(ctx, showPrefix+"protocols evpn"+junos.PipeDisplaySetRelative)
 					if err != nil {
 						return err
 					}
@@ -886,7 +913,7 @@ func (rscData *vlanData) read(
 }
 
 func (rscData *vlanData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	delPrefix := junos.DeleteLS
 	if v := rscData.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
@@ -901,5 +928,5 @@ func (rscData *vlanData) del(
 			utils.ConvI64toa(rscData.Vxlan.Vni.ValueInt64()))
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
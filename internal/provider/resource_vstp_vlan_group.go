@@ -30,6 +30,7 @@ var (
 	_ resource.ResourceWithConfigure      = &vstpVlanGroup{}
 	_ resource.ResourceWithValidateConfig = &vstpVlanGroup{}
 	_ resource.ResourceWithImportState    = &vstpVlanGroup{}
+	_ resource.ResourceWithIdentity       = &vstpVlanGroup{}
 )
 
 type vstpVlanGroup struct {
@@ -134,6 +135,26 @@ func (rsc *vstpVlanGroup) Schema(
 	}
 }
 
+func (rsc *vstpVlanGroup) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = defaultResourceIdentitySchema(rsc)
+}
+
+func (rsc *vstpVlanGroup) identityAttributes() []resourceIdentityAttribute {
+	return []resourceIdentityAttribute{
+		{
+			name:        "name",
+			description: "VLAN group name.",
+		},
+		{
+			name:         "routing_instance",
+			description:  "Routing instance for vstp protocol if not root level.",
+			defaultValue: junos.DefaultW,
+		},
+	}
+}
+
 type vstpVlanGroupData struct {
 	vstpVlanAttrData
 
@@ -330,6 +351,10 @@ func (rsc *vstpVlanGroup) Delete(
 func (rsc *vstpVlanGroup) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse,
 ) {
+	if !defaultResourceImportStateIDFromIdentity(ctx, rsc, &req, resp) {
+		return
+	}
+
 	var data vstpVlanGroupData
 
 	var _ resourceDataReadFrom2String = &data
@@ -345,7 +370,7 @@ func (rsc *vstpVlanGroup) ImportState(
 }
 
 func checkVstpVlanGroupExists(
-	_ context.Context, name, routingInstance string, junSess *junos.Session,
+	ctx context.Context, name, routingInstance string, junSess *junos.Session,
 ) (
 	bool, error,
 ) {
@@ -353,8 +378,8 @@ func checkVstpVlanGroupExists(
 	if routingInstance != "" && routingInstance != junos.DefaultW {
 		showPrefix += junos.RoutingInstancesWS + routingInstance + " "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"protocols vstp vlan-group group " + name + junos.PipeDisplaySet)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"protocols vstp vlan-group group "+name+junos.PipeDisplaySet)
 	if err != nil {
 		return false, err
 	}
@@ -378,7 +403,7 @@ func (rscData *vstpVlanGroupData) nullID() bool {
 }
 
 func (rscData *vstpVlanGroupData) set(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) (
 	path.Path, error,
 ) {
@@ -394,18 +419,18 @@ func (rscData *vstpVlanGroupData) set(
 	}
 	configSet = append(configSet, rscData.vstpVlanAttrData.configSet(setPrefix)...)
 
-	return path.Empty(), junSess.ConfigSet(configSet)
+	return path.Empty(), junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *vstpVlanGroupData) read(
-	_ context.Context, name, routingInstance string, junSess *junos.Session,
+	ctx context.Context, name, routingInstance string, junSess *junos.Session,
 ) error {
 	showPrefix := junos.CmdShowConfig
 	if routingInstance != "" && routingInstance != junos.DefaultW {
 		showPrefix += junos.RoutingInstancesWS + routingInstance + " "
 	}
-	showConfig, err := junSess.Command(showPrefix +
-		"protocols vstp vlan-group group " + name + junos.PipeDisplaySetRelative)
+	showConfig, err := junSess.Command(ctx, showPrefix+
+		"protocols vstp vlan-group group "+name+junos.PipeDisplaySetRelative)
 	if err != nil {
 		return err
 	}
@@ -440,7 +465,7 @@ func (rscData *vstpVlanGroupData) read(
 }
 
 func (rscData *vstpVlanGroupData) delOpts(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	delPrefix := junos.DeleteLS
 	if v := rscData.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
@@ -455,11 +480,11 @@ func (rscData *vstpVlanGroupData) delOpts(
 		rscData.vstpVlanAttrData.configOptsToDel(delPrefix)...,
 	)
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
 
 func (rscData *vstpVlanGroupData) del(
-	_ context.Context, junSess *junos.Session,
+	ctx context.Context, junSess *junos.Session,
 ) error {
 	delPrefix := junos.DeleteLS
 	if v := rscData.RoutingInstance.ValueString(); v != "" && v != junos.DefaultW {
@@ -470,5 +495,5 @@ func (rscData *vstpVlanGroupData) del(
 		delPrefix + "protocols vstp vlan-group group " + rscData.Name.ValueString(),
 	}
 
-	return junSess.ConfigSet(configSet)
+	return junSess.ConfigSet(ctx, configSet)
 }
@@ -1314,3 +1314,10 @@ func (config *bgpAttrConfig) modifyPlan(
 		}
 	}
 }
+
+func (config *bgpAttrConfig) policyReferences() []configReference {
+	return append(
+		newConfigReferences(path.Root("export"), referencePolicyStatement, config.Export),
+		newConfigReferences(path.Root("import"), referencePolicyStatement, config.Import)...,
+	)
+}
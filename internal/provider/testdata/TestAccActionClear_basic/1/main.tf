@@ -0,0 +1,25 @@
+resource "terraform_data" "trigger" {
+  triggers_replace = "1"
+  lifecycle {
+    action_trigger {
+      events = [before_create]
+      actions = [
+        action.junos_clear.interfaces_statistics,
+        action.junos_clear.arp,
+      ]
+    }
+  }
+}
+
+action "junos_clear" "interfaces_statistics" {
+  config {
+    target    = "interfaces_statistics"
+    interface = var.interface
+  }
+}
+
+action "junos_clear" "arp" {
+  config {
+    target = "arp"
+  }
+}
@@ -0,0 +1,16 @@
+resource "terraform_data" "trigger" {
+  triggers_replace = "2"
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.junos_clear.arp]
+    }
+  }
+}
+
+action "junos_clear" "arp" {
+  config {
+    target = "arp"
+    soft   = "soft"
+  }
+}
@@ -0,0 +1,16 @@
+resource "terraform_data" "trigger" {
+  triggers_replace = "3"
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.junos_clear.interfaces_statistics]
+    }
+  }
+}
+
+action "junos_clear" "interfaces_statistics" {
+  config {
+    target    = "interfaces_statistics"
+    interface = "ge-0/0/0 | save /var/tmp/clear"
+  }
+}
@@ -0,0 +1,18 @@
+resource "terraform_data" "trigger" {
+  triggers_replace = "1"
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.junos_command.show-version]
+    }
+  }
+}
+
+action "junos_command" "show-version" {
+  config {
+    commands = ["show version"]
+    rpcs     = ["<get-software-information/>"]
+    format   = "xml"
+    expect   = "junos"
+  }
+}
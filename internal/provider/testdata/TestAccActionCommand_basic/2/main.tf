@@ -0,0 +1,16 @@
+resource "terraform_data" "trigger" {
+  triggers_replace = "2"
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.junos_command.show-version]
+    }
+  }
+}
+
+action "junos_command" "show-version" {
+  config {
+    commands = ["show version"]
+    expect   = "^testacc_command_unexpected$"
+  }
+}
@@ -0,0 +1,27 @@
+resource "terraform_data" "trigger" {
+  triggers_replace = "1"
+  lifecycle {
+    action_trigger {
+      events = [before_create]
+      actions = [
+        action.junos_file_upload.testacc,
+        action.junos_file_download.testacc,
+      ]
+    }
+  }
+}
+
+action "junos_file_upload" "testacc" {
+  config {
+    source      = var.source_file
+    destination = "/var/tmp/testacc_file_download.txt"
+  }
+}
+
+action "junos_file_download" "testacc" {
+  config {
+    source      = "/var/tmp/testacc_file_download.txt"
+    destination = var.destination_file
+    permissions = "0600"
+  }
+}
@@ -0,0 +1,6 @@
+variable "source_file" {
+  type = string
+}
+variable "destination_file" {
+  type = string
+}
@@ -0,0 +1,17 @@
+resource "terraform_data" "trigger" {
+  triggers_replace = "1"
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.junos_file_upload.testacc]
+    }
+  }
+}
+
+action "junos_file_upload" "testacc" {
+  config {
+    source      = var.source_file
+    destination = "/var/tmp/testacc_file_upload.txt"
+    permissions = "0640"
+  }
+}
@@ -0,0 +1,3 @@
+variable "source_file" {
+  type = string
+}
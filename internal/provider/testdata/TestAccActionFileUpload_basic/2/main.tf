@@ -0,0 +1,16 @@
+resource "terraform_data" "trigger" {
+  triggers_replace = "2"
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.junos_file_upload.testacc]
+    }
+  }
+}
+
+action "junos_file_upload" "testacc" {
+  config {
+    source      = "${var.source_file}.missing"
+    destination = "/var/tmp/testacc_file_upload.txt"
+  }
+}
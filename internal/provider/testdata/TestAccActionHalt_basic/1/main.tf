@@ -0,0 +1,16 @@
+resource "terraform_data" "trigger" {
+  triggers_replace = "1"
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.junos_halt.testacc]
+    }
+  }
+}
+
+action "junos_halt" "testacc" {
+  config {
+    member = 0
+    node   = "local"
+  }
+}
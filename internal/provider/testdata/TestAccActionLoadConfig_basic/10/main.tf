@@ -0,0 +1,3 @@
+data "junos_applications" "testacc" {
+  match_name = "^testacc.*$"
+}
@@ -0,0 +1,26 @@
+resource "terraform_data" "trigger" {
+  triggers_replace = "7"
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.junos_load_config.load-application]
+    }
+  }
+}
+
+action "junos_load_config" "load-application" {
+  config {
+    action       = "set"
+    format       = "text"
+    check_only   = true
+    show_compare = true
+    config       = <<EOT
+set applications application testacc-load-config protocol tcp
+set applications application testacc-load-config destination-port 22
+EOT
+  }
+}
+
+data "junos_applications" "testacc" {
+  match_name = "^testacc.*$"
+}
@@ -0,0 +1,27 @@
+resource "terraform_data" "trigger" {
+  triggers_replace = "8"
+  lifecycle {
+    action_trigger {
+      events = [before_create]
+      actions = [
+        action.junos_load_config.load-application,
+        action.junos_commit_confirm.confirm,
+      ]
+    }
+  }
+}
+
+action "junos_load_config" "load-application" {
+  config {
+    action           = "set"
+    format           = "text"
+    commit_confirmed = 5
+    comment          = "testacc load config"
+    config           = <<EOT
+set applications application testacc-load-config protocol tcp
+set applications application testacc-load-config destination-port 22
+EOT
+  }
+}
+
+action "junos_commit_confirm" "confirm" {}
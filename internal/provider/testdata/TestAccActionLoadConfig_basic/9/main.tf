@@ -0,0 +1,23 @@
+data "junos_applications" "testacc" {
+  match_name = "^testacc.*$"
+}
+
+resource "terraform_data" "trigger" {
+  triggers_replace = "9"
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.junos_load_config.load-application]
+    }
+  }
+}
+
+action "junos_load_config" "load-application" {
+  config {
+    action = "set"
+    format = "text"
+    config = <<EOT
+delete applications application testacc-load-config
+EOT
+  }
+}
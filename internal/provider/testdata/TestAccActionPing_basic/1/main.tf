@@ -0,0 +1,19 @@
+resource "terraform_data" "trigger" {
+  triggers_replace = "1"
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.junos_ping.testacc]
+    }
+  }
+}
+
+action "junos_ping" "testacc" {
+  config {
+    host            = "127.0.0.1"
+    count           = 3
+    size            = 100
+    do_not_fragment = true
+    max_packet_loss = 0
+  }
+}
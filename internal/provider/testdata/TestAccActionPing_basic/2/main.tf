@@ -0,0 +1,16 @@
+resource "terraform_data" "trigger" {
+  triggers_replace = "2"
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.junos_ping.testacc]
+    }
+  }
+}
+
+action "junos_ping" "testacc" {
+  config {
+    host  = "192.0.2.1"
+    count = 2
+  }
+}
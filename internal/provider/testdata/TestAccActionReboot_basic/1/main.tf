@@ -0,0 +1,17 @@
+resource "terraform_data" "trigger" {
+  triggers_replace = "1"
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.junos_reboot.testacc]
+    }
+  }
+}
+
+action "junos_reboot" "testacc" {
+  config {
+    in           = 5
+    wait         = true
+    wait_timeout = 600
+  }
+}
@@ -0,0 +1,5 @@
+data "junos_rescue_config" "testacc" {}
+
+data "junos_rescue_config" "testacc_text" {
+  format = "text"
+}
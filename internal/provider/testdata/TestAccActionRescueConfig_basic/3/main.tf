@@ -0,0 +1,15 @@
+resource "terraform_data" "trigger" {
+  triggers_replace = "3"
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.junos_rescue_config.testacc]
+    }
+  }
+}
+
+action "junos_rescue_config" "testacc" {
+  config {
+    operation = "delete"
+  }
+}
@@ -0,0 +1 @@
+data "junos_rescue_config" "testacc" {}
@@ -0,0 +1,16 @@
+resource "terraform_data" "trigger" {
+  triggers_replace = "1"
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.junos_software_install.testacc]
+    }
+  }
+}
+
+action "junos_software_install" "testacc" {
+  config {
+    package          = "/var/tmp/testacc_software_install.tgz"
+    expected_version = "24.2R1.17"
+  }
+}
@@ -0,0 +1,16 @@
+resource "terraform_data" "trigger" {
+  triggers_replace = "2"
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.junos_software_install.testacc]
+    }
+  }
+}
+
+action "junos_software_install" "testacc" {
+  config {
+    package  = "/var/tmp/testacc_software_install_missing.tgz"
+    validate = false
+  }
+}
@@ -0,0 +1,18 @@
+resource "terraform_data" "trigger" {
+  triggers_replace = "1"
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.junos_traceroute.testacc]
+    }
+  }
+}
+
+action "junos_traceroute" "testacc" {
+  config {
+    host       = "127.0.0.1"
+    ttl        = 5
+    wait       = 2
+    no_resolve = true
+  }
+}
@@ -0,0 +1,13 @@
+data "junos_command" "test_text" {
+  command = "show version"
+}
+
+data "junos_command" "test_json" {
+  rpc    = "<get-software-information/>"
+  format = "json"
+}
+
+data "junos_command" "test_xml" {
+  rpc    = "<get-software-information/>"
+  format = "xml"
+}
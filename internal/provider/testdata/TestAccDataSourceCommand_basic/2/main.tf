@@ -0,0 +1,3 @@
+data "junos_command" "test_request" {
+  command = "request system reboot"
+}
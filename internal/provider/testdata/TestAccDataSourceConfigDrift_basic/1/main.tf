@@ -0,0 +1,34 @@
+resource "junos_policyoptions_prefix_list" "testacc_config_drift" {
+  name   = "testacc_config_drift"
+  prefix = ["192.0.2.0/25"]
+}
+
+resource "junos_interface_physical" "testacc_config_drift" {
+  name        = var.interface
+  description = "testacc_config_drift"
+}
+
+resource "junos_null_load_config" "testacc_config_drift" {
+  depends_on = [
+    junos_interface_physical.testacc_config_drift,
+  ]
+
+  action = "set"
+  config = <<EOT
+set policy-options prefix-list testacc_config_drift_unmanaged 192.0.2.128/25
+set interfaces ${var.interface} unit 100 description testacc_config_drift_unmanaged
+EOT
+}
+
+data "junos_config_drift" "testacc" {
+  depends_on = [
+    junos_policyoptions_prefix_list.testacc_config_drift,
+    junos_interface_physical.testacc_config_drift,
+    junos_null_load_config.testacc_config_drift,
+  ]
+
+  hierarchies = [
+    "policy-options prefix-list",
+    "interfaces ${var.interface}",
+  ]
+}
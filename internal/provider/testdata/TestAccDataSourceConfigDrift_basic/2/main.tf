@@ -0,0 +1,17 @@
+resource "junos_policyoptions_prefix_list" "testacc_config_drift" {
+  name   = "testacc_config_drift"
+  prefix = ["192.0.2.0/25"]
+}
+
+resource "junos_interface_physical" "testacc_config_drift" {
+  name        = var.interface
+  description = "testacc_config_drift"
+}
+
+resource "junos_null_load_config" "testacc_config_drift" {
+  action = "set"
+  config = <<EOT
+delete policy-options prefix-list testacc_config_drift_unmanaged
+delete interfaces ${var.interface} unit 100
+EOT
+}
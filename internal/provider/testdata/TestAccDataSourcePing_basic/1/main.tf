@@ -0,0 +1,8 @@
+data "junos_ping" "testacc" {
+  host  = "127.0.0.1"
+  count = 3
+}
+
+data "junos_ping" "testacc_default" {
+  host = "127.0.0.1"
+}
@@ -0,0 +1,4 @@
+data "junos_traceroute" "testacc" {
+  host       = "127.0.0.1"
+  no_resolve = true
+}
@@ -0,0 +1,3 @@
+resource "junos_security_zone" "testacc_list_zone" {
+  name = "testacc_list_zone"
+}
@@ -0,0 +1,15 @@
+resource "junos_routing_instance" "testacc_list_vlan" {
+  name = "testacc_list_vlan"
+  type = "virtual-switch"
+}
+
+resource "junos_vlan" "testacc_list_vlan" {
+  name    = "testacc_list_vlan"
+  vlan_id = 1000
+}
+
+resource "junos_vlan" "testacc_list_vlan_ri" {
+  name             = "testacc_list_vlan_ri"
+  routing_instance = junos_routing_instance.testacc_list_vlan.name
+  vlan_id          = 1001
+}
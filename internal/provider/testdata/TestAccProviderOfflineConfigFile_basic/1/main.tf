@@ -0,0 +1,4 @@
+resource "junos_policyoptions_prefix_list" "testacc_offline" {
+  name   = "testacc_offline"
+  prefix = ["192.0.2.128/25", "198.51.100.0/24"]
+}
@@ -0,0 +1,4 @@
+resource "junos_policyoptions_prefix_list" "testacc_offline_other" {
+  name   = "testacc_offline_other"
+  prefix = ["198.51.100.0/24"]
+}
@@ -0,0 +1,4 @@
+provider "junos" {
+  offline_config_file    = var.file
+  offline_hardware_model = "vsrx"
+}
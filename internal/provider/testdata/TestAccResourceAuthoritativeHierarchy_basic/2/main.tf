@@ -0,0 +1,18 @@
+resource "junos_policyoptions_as_path" "testacc_authhier" {
+  name = "testacc_authhier"
+  path = "5|12|18"
+}
+
+resource "junos_null_load_config" "testacc_authhier" {
+  action = "set"
+  config = "set policy-options as-path testacc_authhier_unmanaged \"65000 .*\""
+}
+
+resource "junos_authoritative_hierarchy" "testacc" {
+  depends_on = [
+    junos_policyoptions_as_path.testacc_authhier,
+    junos_null_load_config.testacc_authhier,
+  ]
+
+  path = "policy-options as-path"
+}
@@ -0,0 +1,14 @@
+resource "junos_routing_instance" "testacc_bgpneighbor_identity" {
+  name = "testacc_bgpneighbor_identity"
+  as   = "65000"
+}
+resource "junos_bgp_group" "testacc_bgpneighbor_identity" {
+  name             = "testacc_bgpneighbor_identity"
+  routing_instance = junos_routing_instance.testacc_bgpneighbor_identity.name
+}
+resource "junos_bgp_neighbor" "testacc_bgpneighbor_identity" {
+  ip               = "192.0.2.4"
+  routing_instance = junos_routing_instance.testacc_bgpneighbor_identity.name
+  group            = junos_bgp_group.testacc_bgpneighbor_identity.name
+  peer_as          = "65002"
+}
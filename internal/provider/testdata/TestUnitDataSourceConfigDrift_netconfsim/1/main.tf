@@ -0,0 +1,18 @@
+resource "junos_policyoptions_prefix_list" "testacc_config_drift" {
+  name   = "testacc_config_drift"
+  prefix = ["192.0.2.0/25"]
+}
+
+resource "junos_null_load_config" "testacc_config_drift" {
+  action = "set"
+  config = "set policy-options prefix-list testacc_config_drift_unmanaged 192.0.2.128/25"
+}
+
+data "junos_config_drift" "testacc" {
+  depends_on = [
+    junos_policyoptions_prefix_list.testacc_config_drift,
+    junos_null_load_config.testacc_config_drift,
+  ]
+
+  hierarchies = ["policy-options prefix-list"]
+}
@@ -0,0 +1,9 @@
+resource "junos_policyoptions_prefix_list" "testacc_config_drift" {
+  name   = "testacc_config_drift"
+  prefix = ["192.0.2.0/25"]
+}
+
+resource "junos_null_load_config" "testacc_config_drift" {
+  action = "set"
+  config = "delete policy-options prefix-list testacc_config_drift_unmanaged"
+}
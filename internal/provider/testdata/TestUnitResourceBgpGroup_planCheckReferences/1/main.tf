@@ -0,0 +1,4 @@
+resource "junos_bgp_group" "testacc_plan_check" {
+  name   = "testacc_plan_check"
+  export = ["testacc_plan_check_missing"]
+}
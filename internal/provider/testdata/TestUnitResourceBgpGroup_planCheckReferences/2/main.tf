@@ -0,0 +1,10 @@
+resource "junos_policyoptions_policy_statement" "testacc_plan_check" {
+  name = "testacc_plan_check"
+  then {
+    action = "accept"
+  }
+}
+resource "junos_bgp_group" "testacc_plan_check" {
+  name   = "testacc_plan_check"
+  export = [junos_policyoptions_policy_statement.testacc_plan_check.name]
+}
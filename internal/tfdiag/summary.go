@@ -8,6 +8,7 @@ const (
 
 	DuplicateConfigErrSummary = "Duplicate Configuration Error"
 	MissingConfigErrSummary   = "Missing Configuration Error"
+	MissingConfigWarnSummary  = "Missing Configuration Warning"
 	ConflictConfigErrSummary  = "Conflict Configuration Error"
 
 	ConfigLockErrSummary    = "Config Lock Error"
@@ -17,12 +18,26 @@ const (
 	ConfigUnlockWarnSummary = "Config Unlock Warning"
 	ConfigCommitErrSummary  = "Config Commit Error"
 	ConfigCommitWarnSummary = "Config Commit Warning"
+	ConfigDiscardErrSummary = "Config Discard Error"
 
 	NotFoundErrSummary  = "Not Found Error"
 	ReadErrSummary      = "Read Error"
 	PreCheckErrSummary  = "Pre Check Error"
 	PostCheckErrSummary = "Post Check Error"
 
+	CommandErrSummary       = "Command Error"
+	CommandOutputErrSummary = "Command Output Error"
+	FileTransferErrSummary  = "File Transfer Error"
+
+	SoftwareInstallErrSummary  = "Software Install Error"
+	SoftwareInstallWarnSummary = "Software Install Warning"
+	ShutdownErrSummary         = "Shutdown Error"
+	RescueConfigErrSummary     = "Rescue Config Error"
+	ClearErrSummary            = "Clear Error"
+
+	PingErrSummary       = "Ping Error"
+	TracerouteErrSummary = "Traceroute Error"
+
 	ReadPrivateToStateErrSummary = "Read Private To State Error"
 	GetPrivateStateErrSummary    = "Get Private State Error"
 )